@@ -0,0 +1,251 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Breaker
+// ----------------------------------------------------------------------------
+
+/*
+The State method returns the current state of the breaker.
+An open breaker whose OpenTimeout has passed is reported as half-open.
+*/
+func (breaker *Breaker) State() State {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	if breaker.state == StateOpen && !breaker.now().Before(breaker.openedAt.Add(breaker.openTimeout())) {
+		return StateHalfOpen
+	}
+	return breaker.state
+}
+
+// Decide whether a call may proceed. When half-open, the first caller probes with probe,
+// or with breaker.Probe if set; if both are nil, the caller's own call is the trial and
+// trial is returned true so that record can settle the state.
+func (breaker *Breaker) allow(ctx context.Context, probe func(ctx context.Context) error) (trial bool, err error) {
+	breaker.mutex.Lock()
+	now := breaker.now()
+	var change *StateChange
+	switch {
+	case breaker.state == StateClosed:
+		breaker.mutex.Unlock()
+		return false, nil
+	case breaker.state == StateOpen && now.Before(breaker.openedAt.Add(breaker.openTimeout())):
+		err = breaker.openError()
+		breaker.mutex.Unlock()
+		return false, err
+	case breaker.state == StateOpen:
+		change = breaker.transition(StateHalfOpen, nil, now)
+	}
+	if breaker.probing {
+		err = breaker.openError()
+		breaker.mutex.Unlock()
+		breaker.publish(change)
+		return false, err
+	}
+	breaker.probing = true
+	if breaker.Probe != nil {
+		probe = breaker.Probe
+	}
+	breaker.mutex.Unlock()
+	breaker.publish(change)
+
+	if probe == nil {
+		return true, nil
+	}
+	err = probe(ctx)
+	breaker.settle(ctx, err)
+	if breaker.isFailure(err) {
+		breaker.mutex.Lock()
+		defer breaker.mutex.Unlock()
+		return false, breaker.openError()
+	}
+	if err != nil && ctx.Err() != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (breaker *Breaker) failureRatio() float64 {
+	if breaker.FailureRatio > 0 {
+		return breaker.FailureRatio
+	}
+	return DefaultFailureRatio
+}
+
+func (breaker *Breaker) isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	failures := breaker.Errors
+	if len(failures) == 0 {
+		failures = DefaultErrors
+	}
+	for _, failure := range failures {
+		if errors.Is(err, failure) {
+			return true
+		}
+	}
+	return false
+}
+
+func (breaker *Breaker) minimumCalls() int {
+	if breaker.MinimumCalls > 0 {
+		return breaker.MinimumCalls
+	}
+	return DefaultMinimumCalls
+}
+
+func (breaker *Breaker) now() time.Time {
+	if breaker.clock != nil {
+		return breaker.clock()
+	}
+	return time.Now()
+}
+
+// Must be called with the mutex held.
+func (breaker *Breaker) openError() *OpenError {
+	return &OpenError{
+		Cause: breaker.cause,
+		Until: breaker.openedAt.Add(breaker.openTimeout()),
+	}
+}
+
+func (breaker *Breaker) openTimeout() time.Duration {
+	if breaker.OpenTimeout > 0 {
+		return breaker.OpenTimeout
+	}
+	return DefaultOpenTimeout
+}
+
+func (breaker *Breaker) publish(change *StateChange) {
+	if change != nil && breaker.OnStateChange != nil {
+		breaker.OnStateChange(*change)
+	}
+}
+
+// Count the outcome of a call. Calls cancelled by their context are not counted,
+// nor are calls that finish while the breaker is not closed, unless they were the half-open trial.
+func (breaker *Breaker) record(ctx context.Context, trial bool, err error) {
+	if trial {
+		breaker.settle(ctx, err)
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	breaker.mutex.Lock()
+	if breaker.state != StateClosed {
+		breaker.mutex.Unlock()
+		return
+	}
+	now := breaker.now()
+	width := breaker.window() / bucketCount
+	start := now.Truncate(width)
+	current := &breaker.buckets[int(now.UnixNano()/int64(width))%bucketCount]
+	if !current.start.Equal(start) {
+		*current = bucket{start: start}
+	}
+	current.calls++
+	if !breaker.isFailure(err) {
+		breaker.mutex.Unlock()
+		return
+	}
+	current.failures++
+	calls, failures := 0, 0
+	for _, part := range breaker.buckets {
+		if now.Sub(part.start) < breaker.window() {
+			calls += part.calls
+			failures += part.failures
+		}
+	}
+	var change *StateChange
+	if calls >= breaker.minimumCalls() && float64(failures) >= breaker.failureRatio()*float64(calls) {
+		change = breaker.transition(StateOpen, err, now)
+	}
+	breaker.mutex.Unlock()
+	breaker.publish(change)
+}
+
+// Close or reopen the breaker after a half-open probe or trial.
+// A probe cancelled by its context leaves the breaker half-open for the next caller.
+func (breaker *Breaker) settle(ctx context.Context, err error) {
+	breaker.mutex.Lock()
+	breaker.probing = false
+	var change *StateChange
+	switch {
+	case breaker.state != StateHalfOpen:
+	case breaker.isFailure(err):
+		change = breaker.transition(StateOpen, err, breaker.now())
+	case err == nil || ctx.Err() == nil:
+		change = breaker.transition(StateClosed, nil, breaker.now())
+	}
+	breaker.mutex.Unlock()
+	breaker.publish(change)
+}
+
+// Must be called with the mutex held.
+func (breaker *Breaker) transition(to State, err error, now time.Time) *StateChange {
+	change := &StateChange{
+		Err:  err,
+		From: breaker.state,
+		Time: now,
+		To:   to,
+	}
+	breaker.state = to
+	switch to {
+	case StateClosed:
+		breaker.buckets = [bucketCount]bucket{}
+		breaker.cause = nil
+	case StateOpen:
+		breaker.cause = err
+		breaker.openedAt = now
+	case StateHalfOpen:
+	}
+	return change
+}
+
+func (breaker *Breaker) window() time.Duration {
+	if breaker.Window >= minimumWindow {
+		return breaker.Window
+	}
+	return DefaultWindow
+}
+
+// ----------------------------------------------------------------------------
+// Methods - OpenError
+// ----------------------------------------------------------------------------
+
+func (err *OpenError) Error() string {
+	if err.Cause == nil {
+		return ErrOpen.Error()
+	}
+	return ErrOpen.Error() + ": " + err.Cause.Error()
+}
+
+// Is reports whether target is ErrOpen.
+func (err *OpenError) Is(target error) bool {
+	return target == ErrOpen //nolint:errorlint
+}
+
+// Unwrap returns szerror.ErrSzRetryable, so that an *OpenError is classified as retryable.
+func (err *OpenError) Unwrap() error {
+	return szerror.ErrSzRetryable
+}
+
+// ----------------------------------------------------------------------------
+// Methods - State
+// ----------------------------------------------------------------------------
+
+func (state State) String() string {
+	if name, ok := stateNames[state]; ok {
+		return name
+	}
+	return "unknown"
+}
@@ -0,0 +1,363 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errConnectionLost = szerror.New(1007, "SENZ1007E|Database Connection Lost 'test'")
+	errNotFound       = szerror.New(33, "SENZ0033E|Unknown record: dsrc[TEST], record[1]")
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	calls     int
+	err       error
+	mutex     sync.Mutex
+	probes    int
+	probeErr  error
+	destroyed bool
+}
+
+func (engine *mockSzEngine) Destroy(ctx context.Context) error {
+	_ = ctx
+	engine.destroyed = true
+	return nil
+}
+
+func (engine *mockSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	_ = ctx
+	_ = flags
+	result := make(chan senzing.StringFragment, 2)
+	result <- senzing.StringFragment{Value: "{}"}
+	result <- senzing.StringFragment{Error: engine.err}
+	close(result)
+	return result
+}
+
+func (engine *mockSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	engine.probes++
+	return 1, engine.probeErr
+}
+
+func (engine *mockSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordID
+	_ = flags
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	engine.calls++
+	return "{}", engine.err
+}
+
+type mockSzProduct struct {
+	senzing.SzProduct
+	calls int
+	err   error
+}
+
+func (product *mockSzProduct) GetVersion(ctx context.Context) (string, error) {
+	_ = ctx
+	product.calls++
+	return "{}", product.err
+}
+
+type testClock struct {
+	now time.Time
+}
+
+func (clock *testClock) advance(duration time.Duration) {
+	clock.now = clock.now.Add(duration)
+}
+
+func (clock *testClock) time() time.Time {
+	return clock.now
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func newTestBreaker() (*Breaker, *testClock, *[]StateChange) {
+	clock := &testClock{now: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)}
+	changes := &[]StateChange{}
+	breaker := &Breaker{
+		MinimumCalls:  4,
+		OnStateChange: func(change StateChange) { *changes = append(*changes, change) },
+		OpenTimeout:   time.Minute,
+		clock:         clock.time,
+	}
+	return breaker, clock, changes
+}
+
+func getRecords(ctx context.Context, szEngine senzing.SzEngine, count int) error {
+	var err error
+	for i := 0; i < count; i++ {
+		_, err = szEngine.GetRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+	}
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBreaker_Open(test *testing.T) {
+	ctx := context.TODO()
+	breaker, _, changes := newTestBreaker()
+	engine := &mockSzEngine{err: errConnectionLost}
+	szEngine := NewSzEngine(engine, breaker)
+
+	err := getRecords(ctx, szEngine, 3)
+	require.ErrorIs(test, err, szerror.ErrSzDatabaseConnectionLost)
+	assert.Equal(test, StateClosed, breaker.State())
+
+	err = getRecords(ctx, szEngine, 1)
+	require.ErrorIs(test, err, szerror.ErrSzDatabaseConnectionLost)
+	assert.Equal(test, StateOpen, breaker.State())
+	require.Len(test, *changes, 1)
+	assert.Equal(test, StateClosed, (*changes)[0].From)
+	assert.Equal(test, StateOpen, (*changes)[0].To)
+	require.ErrorIs(test, (*changes)[0].Err, szerror.ErrSzDatabaseConnectionLost)
+
+	err = getRecords(ctx, szEngine, 10)
+	require.ErrorIs(test, err, ErrOpen)
+	assert.True(test, szerror.IsRetryable(err))
+	assert.NotErrorIs(test, err, szerror.ErrSzDatabaseConnectionLost)
+	var openError *OpenError
+	require.ErrorAs(test, err, &openError)
+	require.ErrorIs(test, openError.Cause, szerror.ErrSzDatabaseConnectionLost)
+	assert.Contains(test, err.Error(), "Database Connection Lost")
+	assert.Equal(test, 4, engine.calls)
+	assert.Zero(test, engine.probes)
+
+	require.NoError(test, szEngine.Destroy(ctx))
+	assert.True(test, engine.destroyed)
+}
+
+func TestBreaker_Open_ratio(test *testing.T) {
+	ctx := context.TODO()
+	testCases := []struct {
+		name     string
+		failures int
+		others   []error
+		state    State
+	}{
+		{name: "below minimum calls", failures: 3, state: StateClosed},
+		{name: "below ratio", failures: 2, others: []error{nil, nil, nil}, state: StateClosed},
+		{name: "at ratio", failures: 2, others: []error{nil, nil}, state: StateOpen},
+		{name: "other errors are not failures", failures: 1, others: []error{errNotFound, errNotFound, errNotFound, errNotFound}, state: StateClosed},
+		{name: "cancelled calls are not counted", failures: 3, others: []error{context.Canceled}, state: StateClosed},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			breaker, _, _ := newTestBreaker()
+			engine := &mockSzEngine{}
+			szEngine := NewSzEngine(engine, breaker)
+			for _, err := range testCase.others {
+				engine.err = err
+				callCtx := ctx
+				if errors.Is(err, context.Canceled) {
+					cancelled, cancel := context.WithCancel(ctx)
+					cancel()
+					callCtx = cancelled
+				}
+				_ = getRecords(callCtx, szEngine, 1)
+			}
+			engine.err = errConnectionLost
+			_ = getRecords(ctx, szEngine, testCase.failures)
+			assert.Equal(test, testCase.state, breaker.State())
+		})
+	}
+}
+
+func TestBreaker_Open_errors(test *testing.T) {
+	ctx := context.TODO()
+	breaker, _, _ := newTestBreaker()
+	breaker.Errors = []error{szerror.ErrSzNotFound}
+	szEngine := NewSzEngine(&mockSzEngine{err: errNotFound}, breaker)
+	_ = getRecords(ctx, szEngine, 4)
+	assert.Equal(test, StateOpen, breaker.State())
+}
+
+func TestBreaker_Window(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, _ := newTestBreaker()
+	szEngine := NewSzEngine(&mockSzEngine{err: errConnectionLost}, breaker)
+	_ = getRecords(ctx, szEngine, 3)
+	clock.advance(DefaultWindow)
+	_ = getRecords(ctx, szEngine, 3)
+	assert.Equal(test, StateClosed, breaker.State())
+	clock.advance(DefaultWindow / 2)
+	_ = getRecords(ctx, szEngine, 1)
+	assert.Equal(test, StateOpen, breaker.State())
+}
+
+func TestBreaker_HalfOpen(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, changes := newTestBreaker()
+	engine := &mockSzEngine{err: errConnectionLost, probeErr: errConnectionLost}
+	szEngine := NewSzEngine(engine, breaker)
+	_ = getRecords(ctx, szEngine, 4)
+	require.Equal(test, StateOpen, breaker.State())
+
+	clock.advance(time.Minute)
+	assert.Equal(test, StateHalfOpen, breaker.State())
+	err := getRecords(ctx, szEngine, 1)
+	require.ErrorIs(test, err, ErrOpen)
+	assert.Equal(test, 1, engine.probes)
+	assert.Equal(test, 4, engine.calls)
+	assert.Equal(test, StateOpen, breaker.State())
+
+	clock.advance(time.Minute)
+	engine.err = nil
+	engine.probeErr = nil
+	require.NoError(test, getRecords(ctx, szEngine, 1))
+	assert.Equal(test, 2, engine.probes)
+	assert.Equal(test, 5, engine.calls)
+	assert.Equal(test, StateClosed, breaker.State())
+
+	var transitions []string
+	for _, change := range *changes {
+		transitions = append(transitions, change.From.String()+" -> "+change.To.String())
+	}
+	assert.Equal(test, []string{
+		"closed -> open",
+		"open -> half-open",
+		"half-open -> open",
+		"open -> half-open",
+		"half-open -> closed",
+	}, transitions)
+}
+
+func TestBreaker_HalfOpen_probe(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, _ := newTestBreaker()
+	probes := 0
+	breaker.Probe = func(ctx context.Context) error {
+		_ = ctx
+		probes++
+		return nil
+	}
+	engine := &mockSzEngine{err: errConnectionLost}
+	_ = getRecords(ctx, NewSzEngine(engine, breaker), 4)
+	clock.advance(time.Minute)
+
+	product := &mockSzProduct{}
+	_, err := NewSzProduct(product, breaker).GetVersion(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, 1, probes)
+	assert.Zero(test, engine.probes)
+	assert.Equal(test, StateClosed, breaker.State())
+}
+
+func TestBreaker_HalfOpen_trial(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, _ := newTestBreaker()
+	product := &mockSzProduct{err: errConnectionLost}
+	szProduct := NewSzProduct(product, breaker)
+	for i := 0; i < 4; i++ {
+		_, _ = szProduct.GetVersion(ctx)
+	}
+	require.Equal(test, StateOpen, breaker.State())
+
+	clock.advance(time.Minute)
+	_, err := szProduct.GetVersion(ctx)
+	require.ErrorIs(test, err, szerror.ErrSzDatabaseConnectionLost)
+	assert.Equal(test, StateOpen, breaker.State())
+	assert.Equal(test, 5, product.calls)
+
+	clock.advance(time.Minute)
+	product.err = nil
+	_, err = szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, StateClosed, breaker.State())
+}
+
+func TestBreaker_HalfOpen_cancelled(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, _ := newTestBreaker()
+	breaker.Probe = func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	szEngine := NewSzEngine(&mockSzEngine{err: errConnectionLost}, breaker)
+	_ = getRecords(ctx, szEngine, 4)
+	clock.advance(time.Minute)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	err := getRecords(cancelled, szEngine, 1)
+	require.ErrorIs(test, err, context.Canceled)
+	assert.Equal(test, StateHalfOpen, breaker.State())
+}
+
+func TestBreaker_Iterator(test *testing.T) {
+	ctx := context.TODO()
+	breaker, _, _ := newTestBreaker()
+	engine := &mockSzEngine{err: errConnectionLost}
+	szEngine := NewSzEngine(engine, breaker)
+	for i := 0; i < 4; i++ {
+		var fragments []senzing.StringFragment
+		for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+			fragments = append(fragments, fragment)
+		}
+		require.Len(test, fragments, 2)
+	}
+	assert.Equal(test, StateOpen, breaker.State())
+
+	var fragments []senzing.StringFragment
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		fragments = append(fragments, fragment)
+	}
+	require.Len(test, fragments, 1)
+	require.ErrorIs(test, fragments[0].Error, ErrOpen)
+}
+
+func TestBreaker_Iterator_abandonedTrial(test *testing.T) {
+	ctx := context.TODO()
+	breaker, clock, _ := newTestBreaker()
+	_ = getRecords(ctx, NewSzEngine(&mockSzEngine{err: errConnectionLost}, breaker), 4)
+	require.Equal(test, StateOpen, breaker.State())
+	clock.advance(time.Minute)
+
+	// Without a probe, the iterator is the half-open trial; its consumer stops after one fragment.
+	guard := &guard{breaker: breaker}
+	fragments := guard.iterate(ctx, func() chan senzing.StringFragment {
+		result := make(chan senzing.StringFragment, 2)
+		result <- senzing.StringFragment{Value: "{}"}
+		result <- senzing.StringFragment{Value: "{}"}
+		close(result)
+		return result
+	})
+	<-fragments
+	assert.Eventually(test, func() bool { return breaker.State() == StateClosed }, time.Second, time.Millisecond)
+	require.NoError(test, guard.do(ctx, func() error { return nil }))
+}
+
+func TestBreaker_Window_minimum(test *testing.T) {
+	breaker := &Breaker{Window: 50 * time.Millisecond}
+	assert.Equal(test, DefaultWindow, breaker.window())
+	breaker.Window = time.Second
+	assert.Equal(test, time.Second, breaker.window())
+}
+
+func TestBreaker_State_String(test *testing.T) {
+	assert.Equal(test, "closed", StateClosed.String())
+	assert.Equal(test, "open", StateOpen.String())
+	assert.Equal(test, "half-open", StateHalfOpen.String())
+	assert.Equal(test, "unknown", State(99).String())
+}
@@ -0,0 +1,17 @@
+/*
+The breaker package stops calls to the Senzing SDK while the datastore is unavailable.
+
+Wrap any of the Sz interfaces with NewSzConfig, NewSzConfigManager, NewSzDiagnostic, NewSzEngine or NewSzProduct.
+The wrappers share a Breaker, which counts calls failing with szerror.ErrSzDatabaseConnectionLost or szerror.ErrSzUnrecoverable.
+When the rate of such failures within Window reaches FailureRatio, the breaker opens and calls fail fast with an *OpenError
+instead of reaching the datastore.
+After OpenTimeout the breaker is half-open: one caller probes the datastore with a cheap call,
+such as GetActiveConfigID or GetDatastoreInfo, and the breaker closes if it succeeds or opens again if it fails.
+
+Iterators, such as ExportJSONEntityReportIterator, must be read to the end or have their context cancelled;
+otherwise the goroutine forwarding their fragments stays blocked. A half-open trial made by an iterator
+is settled by its first fragment, so an abandoned iterator does not hold the breaker half-open.
+
+Share one Breaker between the wrappers of all interfaces using the same datastore.
+*/
+package breaker
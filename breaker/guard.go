@@ -0,0 +1,87 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Common state of the Sz interface wrappers.
+type guard struct {
+	breaker *Breaker
+	// A cheap call to the wrapped interface, made when half-open. Nil if it has none.
+	probe func(ctx context.Context) error
+}
+
+// ----------------------------------------------------------------------------
+// Methods - guard
+// ----------------------------------------------------------------------------
+
+func (guard *guard) do(ctx context.Context, method func() error) error {
+	trial, err := guard.breaker.allow(ctx, guard.probe)
+	if err != nil {
+		return err
+	}
+	err = method()
+	guard.breaker.record(ctx, trial, err)
+	return err
+}
+
+// Forward fragments, recording the first error sent on the channel when it is closed.
+// A half-open trial is settled by the first fragment the consumer receives, so that a consumer
+// that stops reading without cancelling ctx does not keep the breaker half-open.
+func (guard *guard) iterate(ctx context.Context, method func() chan senzing.StringFragment) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment)
+	trial, err := guard.breaker.allow(ctx, guard.probe)
+	if err != nil {
+		go func() {
+			defer close(result)
+			select {
+			case result <- senzing.StringFragment{Error: err}:
+			case <-ctx.Done():
+			}
+		}()
+		return result
+	}
+	fragments := method()
+	go func() {
+		defer close(result)
+		var err error
+		for fragment := range fragments {
+			if fragment.Error != nil && err == nil {
+				err = fragment.Error
+			}
+			select {
+			case result <- fragment:
+			case <-ctx.Done():
+				guard.breaker.record(ctx, trial, ctx.Err())
+				return
+			}
+			if trial {
+				guard.breaker.record(ctx, true, fragment.Error)
+				trial = false
+			}
+		}
+		guard.breaker.record(ctx, trial, err)
+	}()
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func call[T any](ctx context.Context, guard *guard, method func() (T, error)) (T, error) {
+	var result T
+	trial, err := guard.breaker.allow(ctx, guard.probe)
+	if err != nil {
+		return result, err
+	}
+	result, err = method()
+	guard.breaker.record(ctx, trial, err)
+	return result, err
+}
@@ -0,0 +1,110 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Breaker tracks failures of calls through the wrappers that share it.
+// The zero value is ready to use with default settings. A Breaker must not be copied after first use.
+type Breaker struct {
+	// Errors that count as failures. Defaults to DefaultErrors.
+	Errors []error
+	// FailureRatio of calls within Window that opens the breaker. Defaults to DefaultFailureRatio.
+	FailureRatio float64
+	// MinimumCalls within Window before the breaker may open. Defaults to DefaultMinimumCalls.
+	MinimumCalls int
+	// OnStateChange, if set, is called after each change of state. It must not block.
+	OnStateChange func(change StateChange)
+	// OpenTimeout is how long the breaker stays open before probing. Defaults to DefaultOpenTimeout.
+	OpenTimeout time.Duration
+	// Probe, if set, is called when half-open instead of the wrapper's own probe.
+	Probe func(ctx context.Context) error
+	// Window over which failures are counted. Defaults to DefaultWindow, which also replaces values under 100ms.
+	Window time.Duration
+
+	buckets  [bucketCount]bucket
+	cause    error
+	clock    func() time.Time
+	mutex    sync.Mutex
+	openedAt time.Time
+	probing  bool
+	state    State
+}
+
+// An OpenError is returned, without calling the SDK, while a Breaker is open.
+// It matches ErrOpen and szerror.ErrSzRetryable with errors.Is.
+type OpenError struct {
+	// Cause is the error that opened the breaker.
+	Cause error
+	// Until is when the breaker will next probe the datastore.
+	Until time.Time
+}
+
+// State is the state of a Breaker.
+type State int
+
+// A StateChange is published through Breaker.OnStateChange.
+type StateChange struct {
+	// Err is the error that caused the change, or nil when the breaker closes or becomes half-open.
+	Err  error
+	From State
+	Time time.Time
+	To   State
+}
+
+// Calls and failures within one part of the window.
+type bucket struct {
+	calls    int
+	failures int
+	start    time.Time
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default values.
+const (
+	DefaultFailureRatio = 0.5
+	DefaultMinimumCalls = 10
+	DefaultOpenTimeout  = 30 * time.Second
+	DefaultWindow       = 10 * time.Second
+)
+
+// States of a Breaker.
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+// Number of parts the window is divided into.
+const bucketCount = 10
+
+// The shortest Window used; shorter values are replaced by DefaultWindow.
+const minimumWindow = 100 * time.Millisecond
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// DefaultErrors are the errors counted as failures when Breaker.Errors is empty.
+var DefaultErrors = []error{szerror.ErrSzDatabaseConnectionLost, szerror.ErrSzUnrecoverable}
+
+// ErrOpen matches every *OpenError.
+var ErrOpen = errors.New("circuit breaker is open")
+
+var stateNames = map[State]string{
+	StateClosed:   "closed",
+	StateHalfOpen: "half-open",
+	StateOpen:     "open",
+}
@@ -0,0 +1,87 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzConfig that fails fast while its Breaker is open.
+type guardedSzConfig struct {
+	guard
+	szConfig senzing.SzConfig
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *guardedSzConfig) AddDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szConfig.AddDataSource(ctx, configHandle, dataSourceCode)
+	})
+}
+
+func (client *guardedSzConfig) CloseConfig(ctx context.Context, configHandle uintptr) error {
+	return client.do(ctx, func() error {
+		return client.szConfig.CloseConfig(ctx, configHandle)
+	})
+}
+
+func (client *guardedSzConfig) CreateConfig(ctx context.Context) (uintptr, error) {
+	return call(ctx, &client.guard, func() (uintptr, error) {
+		return client.szConfig.CreateConfig(ctx)
+	})
+}
+
+func (client *guardedSzConfig) DeleteDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) error {
+	return client.do(ctx, func() error {
+		return client.szConfig.DeleteDataSource(ctx, configHandle, dataSourceCode)
+	})
+}
+
+func (client *guardedSzConfig) Destroy(ctx context.Context) error {
+	return client.szConfig.Destroy(ctx)
+}
+
+func (client *guardedSzConfig) ExportConfig(ctx context.Context, configHandle uintptr) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szConfig.ExportConfig(ctx, configHandle)
+	})
+}
+
+func (client *guardedSzConfig) GetDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szConfig.GetDataSources(ctx, configHandle)
+	})
+}
+
+func (client *guardedSzConfig) ImportConfig(ctx context.Context, configDefinition string) (uintptr, error) {
+	return call(ctx, &client.guard, func() (uintptr, error) {
+		return client.szConfig.ImportConfig(ctx, configDefinition)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzConfig function wraps an SzConfig so that calls fail fast with an *OpenError while breaker is open.
+Calls failing with one of breaker.Errors count towards opening it. Destroy is always passed through.
+When half-open, the first call is the trial, unless breaker.Probe is set.
+
+Input
+  - szConfig: The SzConfig to wrap.
+  - breaker: The Breaker, usually shared with the wrappers of the other interfaces.
+*/
+func NewSzConfig(szConfig senzing.SzConfig, breaker *Breaker) senzing.SzConfig {
+	return &guardedSzConfig{
+		guard:    guard{breaker: breaker},
+		szConfig: szConfig,
+	}
+}
@@ -0,0 +1,87 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzConfigManager that fails fast while its Breaker is open.
+type guardedSzConfigManager struct {
+	guard
+	szConfigManager senzing.SzConfigManager
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *guardedSzConfigManager) AddConfig(ctx context.Context, configDefinition string, configComments string) (int64, error) {
+	return call(ctx, &client.guard, func() (int64, error) {
+		return client.szConfigManager.AddConfig(ctx, configDefinition, configComments)
+	})
+}
+
+func (client *guardedSzConfigManager) Destroy(ctx context.Context) error {
+	return client.szConfigManager.Destroy(ctx)
+}
+
+func (client *guardedSzConfigManager) GetConfig(ctx context.Context, configID int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szConfigManager.GetConfig(ctx, configID)
+	})
+}
+
+func (client *guardedSzConfigManager) GetConfigs(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szConfigManager.GetConfigs(ctx)
+	})
+}
+
+func (client *guardedSzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	return call(ctx, &client.guard, func() (int64, error) {
+		return client.szConfigManager.GetDefaultConfigID(ctx)
+	})
+}
+
+func (client *guardedSzConfigManager) ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error {
+	return client.do(ctx, func() error {
+		return client.szConfigManager.ReplaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+	})
+}
+
+func (client *guardedSzConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	return client.do(ctx, func() error {
+		return client.szConfigManager.SetDefaultConfigID(ctx, configID)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzConfigManager function wraps an SzConfigManager so that calls fail fast with an *OpenError while breaker is open.
+Calls failing with one of breaker.Errors count towards opening it. Destroy is always passed through.
+When half-open, the breaker probes with GetDefaultConfigID.
+
+Input
+  - szConfigManager: The SzConfigManager to wrap.
+  - breaker: The Breaker, usually shared with the wrappers of the other interfaces.
+*/
+func NewSzConfigManager(szConfigManager senzing.SzConfigManager, breaker *Breaker) senzing.SzConfigManager {
+	return &guardedSzConfigManager{
+		guard: guard{
+			breaker: breaker,
+			probe: func(ctx context.Context) error {
+				_, err := szConfigManager.GetDefaultConfigID(ctx)
+				return err
+			},
+		},
+		szConfigManager: szConfigManager,
+	}
+}
@@ -0,0 +1,81 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzDiagnostic that fails fast while its Breaker is open.
+type guardedSzDiagnostic struct {
+	guard
+	szDiagnostic senzing.SzDiagnostic
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *guardedSzDiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szDiagnostic.CheckDatastorePerformance(ctx, secondsToRun)
+	})
+}
+
+func (client *guardedSzDiagnostic) Destroy(ctx context.Context) error {
+	return client.szDiagnostic.Destroy(ctx)
+}
+
+func (client *guardedSzDiagnostic) GetDatastoreInfo(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szDiagnostic.GetDatastoreInfo(ctx)
+	})
+}
+
+func (client *guardedSzDiagnostic) GetFeature(ctx context.Context, featureID int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szDiagnostic.GetFeature(ctx, featureID)
+	})
+}
+
+func (client *guardedSzDiagnostic) PurgeRepository(ctx context.Context) error {
+	return client.do(ctx, func() error {
+		return client.szDiagnostic.PurgeRepository(ctx)
+	})
+}
+
+func (client *guardedSzDiagnostic) Reinitialize(ctx context.Context, configID int64) error {
+	return client.do(ctx, func() error {
+		return client.szDiagnostic.Reinitialize(ctx, configID)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzDiagnostic function wraps an SzDiagnostic so that calls fail fast with an *OpenError while breaker is open.
+Calls failing with one of breaker.Errors count towards opening it. Destroy is always passed through.
+When half-open, the breaker probes with GetDatastoreInfo.
+
+Input
+  - szDiagnostic: The SzDiagnostic to wrap.
+  - breaker: The Breaker, usually shared with the wrappers of the other interfaces.
+*/
+func NewSzDiagnostic(szDiagnostic senzing.SzDiagnostic, breaker *Breaker) senzing.SzDiagnostic {
+	return &guardedSzDiagnostic{
+		guard: guard{
+			breaker: breaker,
+			probe: func(ctx context.Context) error {
+				_, err := szDiagnostic.GetDatastoreInfo(ctx)
+				return err
+			},
+		},
+		szDiagnostic: szDiagnostic,
+	}
+}
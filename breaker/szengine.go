@@ -0,0 +1,243 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzEngine that fails fast while its Breaker is open.
+type guardedSzEngine struct {
+	guard
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *guardedSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+	})
+}
+
+func (client *guardedSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	return client.do(ctx, func() error {
+		return client.szEngine.CloseExport(ctx, exportHandle)
+	})
+}
+
+func (client *guardedSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return call(ctx, &client.guard, func() (int64, error) {
+		return client.szEngine.CountRedoRecords(ctx)
+	})
+}
+
+func (client *guardedSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) Destroy(ctx context.Context) error {
+	return client.szEngine.Destroy(ctx)
+}
+
+func (client *guardedSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	return call(ctx, &client.guard, func() (uintptr, error) {
+		return client.szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+	})
+}
+
+func (client *guardedSzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	return client.iterate(ctx, func() chan senzing.StringFragment {
+		return client.szEngine.ExportCsvEntityReportIterator(ctx, csvColumnList, flags)
+	})
+}
+
+func (client *guardedSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return call(ctx, &client.guard, func() (uintptr, error) {
+		return client.szEngine.ExportJSONEntityReport(ctx, flags)
+	})
+}
+
+func (client *guardedSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	return client.iterate(ctx, func() chan senzing.StringFragment {
+		return client.szEngine.ExportJSONEntityReportIterator(ctx, flags)
+	})
+}
+
+func (client *guardedSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FetchNext(ctx, exportHandle)
+	})
+}
+
+func (client *guardedSzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *guardedSzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	})
+}
+
+func (client *guardedSzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	})
+}
+
+func (client *guardedSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+	})
+}
+
+func (client *guardedSzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+	})
+}
+
+func (client *guardedSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return call(ctx, &client.guard, func() (int64, error) {
+		return client.szEngine.GetActiveConfigID(ctx)
+	})
+}
+
+func (client *guardedSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetEntityByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *guardedSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetRedoRecord(ctx)
+	})
+}
+
+func (client *guardedSzEngine) GetStats(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetStats(ctx)
+	})
+}
+
+func (client *guardedSzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.GetVirtualEntityByRecordID(ctx, recordList, flags)
+	})
+}
+
+func (client *guardedSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.HowEntityByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *guardedSzEngine) PrimeEngine(ctx context.Context) error {
+	return client.do(ctx, func() error {
+		return client.szEngine.PrimeEngine(ctx)
+	})
+}
+
+func (client *guardedSzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+	})
+}
+
+func (client *guardedSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.ReevaluateEntity(ctx, entityID, flags)
+	})
+}
+
+func (client *guardedSzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	return client.do(ctx, func() error {
+		return client.szEngine.Reinitialize(ctx, configID)
+	})
+}
+
+func (client *guardedSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+	})
+}
+
+func (client *guardedSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+	})
+}
+
+func (client *guardedSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *guardedSzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzEngine function wraps an SzEngine so that calls fail fast with an *OpenError while breaker is open.
+Calls failing with one of breaker.Errors count towards opening it. Destroy is always passed through.
+When half-open, the breaker probes with GetActiveConfigID.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - breaker: The Breaker, usually shared with the wrappers of the other interfaces.
+*/
+func NewSzEngine(szEngine senzing.SzEngine, breaker *Breaker) senzing.SzEngine {
+	return &guardedSzEngine{
+		guard: guard{
+			breaker: breaker,
+			probe: func(ctx context.Context) error {
+				_, err := szEngine.GetActiveConfigID(ctx)
+				return err
+			},
+		},
+		szEngine: szEngine,
+	}
+}
@@ -0,0 +1,57 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzProduct that fails fast while its Breaker is open.
+type guardedSzProduct struct {
+	guard
+	szProduct senzing.SzProduct
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *guardedSzProduct) Destroy(ctx context.Context) error {
+	return client.szProduct.Destroy(ctx)
+}
+
+func (client *guardedSzProduct) GetLicense(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szProduct.GetLicense(ctx)
+	})
+}
+
+func (client *guardedSzProduct) GetVersion(ctx context.Context) (string, error) {
+	return call(ctx, &client.guard, func() (string, error) {
+		return client.szProduct.GetVersion(ctx)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzProduct function wraps an SzProduct so that calls fail fast with an *OpenError while breaker is open.
+Calls failing with one of breaker.Errors count towards opening it. Destroy is always passed through.
+When half-open, the first call is the trial, unless breaker.Probe is set.
+
+Input
+  - szProduct: The SzProduct to wrap.
+  - breaker: The Breaker, usually shared with the wrappers of the other interfaces.
+*/
+func NewSzProduct(szProduct senzing.SzProduct, breaker *Breaker) senzing.SzProduct {
+	return &guardedSzProduct{
+		guard:     guard{breaker: breaker},
+		szProduct: szProduct,
+	}
+}
@@ -0,0 +1,346 @@
+package catalog
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/format"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	kindEnter      = "Enter"
+	kindExit       = "Exit"
+	kindName       = "Name"
+	kindFoldedName = "FoldedName" // Method names in lower case, so Generate can correct their case.
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Component
+// ----------------------------------------------------------------------------
+
+/*
+The Check method compares the catalog with the interface and returns its inconsistencies, ordered by method.
+*/
+func (component Component) Check() []Finding {
+	result := []Finding{}
+	add := func(method string, messageNumber int, format string, args ...any) {
+		result = append(result, Finding{
+			Message:       fmt.Sprintf(format, args...),
+			MessageNumber: messageNumber,
+			Method:        method,
+			Package:       component.Package,
+		})
+	}
+	entries := component.entries()
+	for _, method := range component.methods() {
+		enters := entries[kindEnter][method.Name]
+		switch len(enters) {
+		case 0:
+			add(method.Name, 0, "%s has no Enter template", method.Name)
+		case 1:
+			enterNumber := enters[0]
+			if actual, expected := component.IDMessages[enterNumber], component.enterTemplate(method); actual != expected {
+				add(method.Name, enterNumber, "Enter template is %q; want %q", actual, expected)
+			}
+			if actual, expected := component.IDMessages[enterNumber+1], component.exitTemplate(method); actual != expected {
+				add(method.Name, enterNumber+1, "Exit template is %q; want %q", actual, expected)
+			}
+		default:
+			add(method.Name, enters[1], "%s has %d Enter templates", method.Name, len(enters))
+		}
+		for _, exitNumber := range entries[kindExit][method.Name] {
+			if !slices.Contains(enters, exitNumber-1) {
+				add(method.Name, exitNumber, "Exit template does not follow an Enter template for %s", method.Name)
+			}
+		}
+		names := entries[kindName][method.Name]
+		switch len(names) {
+		case 0:
+			add(method.Name, 0, "%s has no method name in the 8xxx range", method.Name)
+		case 1:
+		default:
+			add(method.Name, names[1], "%s has %d method names", method.Name, len(names))
+		}
+	}
+	for _, kind := range []string{kindEnter, kindExit, kindName} {
+		for _, method := range sortedKeys(entries[kind]) {
+			if component.isKnownMethod(method) {
+				continue
+			}
+			for _, messageNumber := range entries[kind][method] {
+				add(method, messageNumber, "%s is not a method of %s", method, component.Interface.Name())
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+/*
+The Generate method returns the catalog brought into line with the interface.
+Existing message numbers are kept, so regenerating an up-to-date catalog changes nothing.
+Stale templates and method names are removed; missing ones are added after the highest number in use.
+*/
+func (component Component) Generate() map[int]string {
+	result := map[int]string{}
+	entries := component.entries()
+	parsed := map[int]bool{}
+	for _, kind := range entries {
+		for _, messageNumbers := range kind {
+			for _, messageNumber := range messageNumbers {
+				parsed[messageNumber] = true
+			}
+		}
+	}
+
+	// Keep everything that is not an Enter/Exit template or method name of a stale method.
+
+	for messageNumber, template := range component.IDMessages {
+		if !parsed[messageNumber] {
+			result[messageNumber] = template
+		}
+	}
+	for _, kind := range entries {
+		for method, messageNumbers := range kind {
+			if !slices.Contains(ImplementationMethods, method) {
+				continue
+			}
+			for _, messageNumber := range messageNumbers {
+				result[messageNumber] = component.IDMessages[messageNumber]
+			}
+		}
+	}
+
+	// Place interface methods at their existing numbers where possible.
+
+	newTemplates := []reflect.Method{}
+	newNames := []reflect.Method{}
+	for _, method := range component.methods() {
+		enters := entries[kindEnter][method.Name]
+		_, taken := result[firstOrZero(enters)+1]
+		if len(enters) > 0 && !taken {
+			result[enters[0]] = component.enterTemplate(method)
+			result[enters[0]+1] = component.exitTemplate(method)
+		} else {
+			newTemplates = append(newTemplates, method)
+		}
+		names := entries[kindFoldedName][strings.ToLower(method.Name)]
+		if len(names) > 0 {
+			result[names[0]] = component.Prefix + method.Name
+		} else {
+			newNames = append(newNames, method)
+		}
+	}
+
+	// Number the new ones after the highest number in use.
+
+	nextTemplate := highest(result, 0, firstImplementationNumber-1) + 1
+	if nextTemplate%2 == 0 { // Enter templates are at odd numbers.
+		nextTemplate++
+	}
+	for _, method := range newTemplates {
+		result[nextTemplate] = component.enterTemplate(method)
+		result[nextTemplate+1] = component.exitTemplate(method)
+		nextTemplate += 2
+	}
+	nextName := max(highest(result, firstMethodNameNumber, lastMethodNameNumber), firstMethodNameNumber) + 1
+	for _, method := range newNames {
+		result[nextName] = component.Prefix + method.Name
+		nextName++
+	}
+	return result
+}
+
+/*
+The Source method returns the Go source of the package's main.go, with IDMessages regenerated by Generate().
+*/
+func (component Component) Source() ([]byte, error) {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, `package %s
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Log message prefix.
+const Prefix = %q
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Message templates for %s implementations.
+var IDMessages = map[int]string{
+`, component.Package, component.Prefix, component.Package)
+	idMessages := component.Generate()
+	for _, messageNumber := range sortedKeys(idMessages) {
+		fmt.Fprintf(&buffer, "\t%d: %s,\n", messageNumber, component.expression(idMessages[messageNumber]))
+	}
+	fmt.Fprintf(&buffer, "}\n\n// Status strings for specific %s messages.\nvar IDStatuses = map[int]string{", component.Package)
+	if len(component.IDStatuses) > 0 {
+		buffer.WriteString("\n")
+		for _, messageNumber := range sortedKeys(component.IDStatuses) {
+			fmt.Fprintf(&buffer, "\t%d: %q,\n", messageNumber, component.IDStatuses[messageNumber])
+		}
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+// Parse Enter/Exit templates and method names, indexed by kind and method.
+func (component Component) entries() map[string]map[string][]int {
+	result := map[string]map[string][]int{
+		kindEnter:      {},
+		kindExit:       {},
+		kindName:       {},
+		kindFoldedName: {},
+	}
+	templatePattern := regexp.MustCompile(`^(Enter |Exit  )` + regexp.QuoteMeta(component.Prefix) + `(\w+)\(`)
+	namePattern := regexp.MustCompile(`^` + regexp.QuoteMeta(component.Prefix) + `(\w+)$`)
+	for _, messageNumber := range sortedKeys(component.IDMessages) {
+		template := component.IDMessages[messageNumber]
+		switch {
+		case messageNumber < methodNameBoundary:
+			if match := templatePattern.FindStringSubmatch(template); match != nil {
+				kind := strings.TrimSpace(match[1])
+				result[kind][match[2]] = append(result[kind][match[2]], messageNumber)
+			}
+		case messageNumber >= firstMethodNameNumber:
+			if match := namePattern.FindStringSubmatch(template); match != nil {
+				result[kindName][match[1]] = append(result[kindName][match[1]], messageNumber)
+				folded := strings.ToLower(match[1])
+				result[kindFoldedName][folded] = append(result[kindFoldedName][folded], messageNumber)
+			}
+		}
+	}
+	return result
+}
+
+func (component Component) enterTemplate(method reflect.Method) string {
+	return fmt.Sprintf("Enter %s%s(%s).", component.Prefix, method.Name, parameterVerbs(method.Type))
+}
+
+func (component Component) exitTemplate(method reflect.Method) string {
+	return fmt.Sprintf("Exit  %s%s(%s) returned (%s).", component.Prefix, method.Name, parameterVerbs(method.Type), resultVerbs(method.Type))
+}
+
+// Render a template as Go source, using Prefix as the original catalogs do.
+func (component Component) expression(template string) string {
+	for _, lead := range []string{"Enter ", "Exit  "} {
+		if strings.HasPrefix(template, lead+component.Prefix) {
+			return fmt.Sprintf("%q + Prefix + %q", lead, strings.TrimPrefix(template, lead+component.Prefix))
+		}
+	}
+	if strings.HasPrefix(template, component.Prefix) {
+		return fmt.Sprintf("Prefix + %q", strings.TrimPrefix(template, component.Prefix))
+	}
+	return fmt.Sprintf("%q", template)
+}
+
+func (component Component) isKnownMethod(method string) bool {
+	if slices.Contains(ImplementationMethods, method) {
+		return true
+	}
+	_, ok := component.Interface.MethodByName(method)
+	return ok
+}
+
+func (component Component) methods() []reflect.Method {
+	result := []reflect.Method{}
+	for i := 0; i < component.Interface.NumMethod(); i++ {
+		result = append(result, component.Interface.Method(i))
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The CheckAll function checks every component in Components.
+*/
+func CheckAll() []Finding {
+	result := []Finding{}
+	for _, component := range Components {
+		result = append(result, component.Check()...)
+	}
+	return result
+}
+
+/*
+The Verb function returns the format verb the catalogs use for a value of the given type:
+%s for strings, %d for integers and %v for everything else.
+
+Input
+  - valueType: The type of a parameter or result.
+*/
+func Verb(valueType reflect.Type) string {
+	switch valueType.Kind() {
+	case reflect.String:
+		return "%s"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "%d"
+	default:
+		return "%v"
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func firstOrZero(values []int) int {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0]
+}
+
+// Return the highest key in [low, high], or low-1 if there is none.
+func highest(idMessages map[int]string, low int, high int) int {
+	result := low - 1
+	for messageNumber := range idMessages {
+		if messageNumber >= low && messageNumber <= high && messageNumber > result {
+			result = messageNumber
+		}
+	}
+	return result
+}
+
+// Verbs for the parameters of an interface method, skipping ctx.
+func parameterVerbs(methodType reflect.Type) string {
+	verbs := []string{}
+	for i := 1; i < methodType.NumIn(); i++ {
+		verbs = append(verbs, Verb(methodType.In(i)))
+	}
+	return strings.Join(verbs, ", ")
+}
+
+func resultVerbs(methodType reflect.Type) string {
+	verbs := []string{}
+	for i := 0; i < methodType.NumOut(); i++ {
+		verbs = append(verbs, Verb(methodType.Out(i)))
+	}
+	return strings.Join(verbs, ", ")
+}
+
+func sortedKeys[K cmp.Ordered, V any](values map[K]V) []K {
+	result := make([]K, 0, len(values))
+	for key := range values {
+		result = append(result, key)
+	}
+	slices.Sort(result)
+	return result
+}
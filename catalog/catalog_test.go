@@ -0,0 +1,134 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testInterface interface {
+	AddThing(ctx context.Context, name string, flags int64) (string, error)
+	CloseThing(ctx context.Context, handle uintptr) error
+	CountThings(ctx context.Context) (int64, error)
+	StreamThings(ctx context.Context) chan senzing.StringFragment
+}
+
+func testComponent(idMessages map[int]string) Component {
+	return Component{
+		Catalog: logging.Catalog{
+			IDMessages: idMessages,
+			IDStatuses: map[int]string{4001: "failed"},
+			Prefix:     "test.",
+		},
+		Interface: reflect.TypeOf((*testInterface)(nil)).Elem(),
+		Package:   "test",
+	}
+}
+
+// A catalog with one of each kind of drift.
+var driftedMessages = map[int]string{
+	1:    "Enter test.AddThing(%s, %s).",
+	2:    "Exit  test.AddThing(%s, %s) returned (%s, %v).",
+	3:    "Enter test.ClearLastException().",
+	4:    "Exit  test.ClearLastException() returned (%v).",
+	5:    "Enter test.CloseThing(%v).",
+	6:    "Exit  test.CloseThing(%v) returned (%v).",
+	9:    "Enter test.StreamThings().",
+	10:   "Exit  test.StreamThings() returned (%v).",
+	11:   "Enter test.Initialize(%s, %s, %d).",
+	12:   "Exit  test.Initialize(%s, %s, %d) returned (%v).",
+	703:  "Enter test.RegisterObserver(%s).",
+	4001: "test.native_addThing(%s, %d) failed. Return code: %d",
+	8001: "test.AddThing",
+	8002: "test.Closething",
+	8003: "test.CountThings",
+	8004: "test.Initialize",
+	8702: "test.RegisterObserver",
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCatalog_Component_Check(test *testing.T) {
+	findings := testComponent(driftedMessages).Check()
+	actual := []string{}
+	for _, finding := range findings {
+		assert.Equal(test, "test", finding.Package)
+		actual = append(actual, finding.Method+": "+finding.Message)
+	}
+	assert.Equal(test, []string{
+		`AddThing: Enter template is "Enter test.AddThing(%s, %s)."; want "Enter test.AddThing(%s, %d)."`,
+		`AddThing: Exit template is "Exit  test.AddThing(%s, %s) returned (%s, %v)."; want "Exit  test.AddThing(%s, %d) returned (%s, %v)."`,
+		"ClearLastException: ClearLastException is not a method of testInterface",
+		"ClearLastException: ClearLastException is not a method of testInterface",
+		"CloseThing: CloseThing has no method name in the 8xxx range",
+		"Closething: Closething is not a method of testInterface",
+		"CountThings: CountThings has no Enter template",
+		"StreamThings: StreamThings has no method name in the 8xxx range",
+	}, actual)
+}
+
+func TestCatalog_Component_Generate(test *testing.T) {
+	component := testComponent(driftedMessages)
+	actual := component.Generate()
+	assert.Equal(test, map[int]string{
+		1:    "Enter test.AddThing(%s, %d).",
+		2:    "Exit  test.AddThing(%s, %d) returned (%s, %v).",
+		5:    "Enter test.CloseThing(%v).",
+		6:    "Exit  test.CloseThing(%v) returned (%v).",
+		9:    "Enter test.StreamThings().",
+		10:   "Exit  test.StreamThings() returned (%v).",
+		11:   "Enter test.Initialize(%s, %s, %d).",
+		12:   "Exit  test.Initialize(%s, %s, %d) returned (%v).",
+		13:   "Enter test.CountThings().",
+		14:   "Exit  test.CountThings() returned (%d, %v).",
+		703:  "Enter test.RegisterObserver(%s).",
+		4001: "test.native_addThing(%s, %d) failed. Return code: %d",
+		8001: "test.AddThing",
+		8002: "test.CloseThing",
+		8003: "test.CountThings",
+		8004: "test.Initialize",
+		8005: "test.StreamThings",
+		8702: "test.RegisterObserver",
+	}, actual)
+
+	regenerated := testComponent(actual)
+	assert.Empty(test, regenerated.Check())
+	assert.Equal(test, actual, regenerated.Generate(), "generation is idempotent")
+}
+
+func TestCatalog_Component_Source(test *testing.T) {
+	source, err := testComponent(driftedMessages).Source()
+	require.NoError(test, err)
+	assert.Contains(test, string(source), "package test\n")
+	assert.Contains(test, string(source), "\t1:    \"Enter \" + Prefix + \"AddThing(%s, %d).\",\n")
+	assert.Contains(test, string(source), "\t4001: Prefix + \"native_addThing(%s, %d) failed. Return code: %d\",\n")
+	assert.Contains(test, string(source), "var IDStatuses = map[int]string{\n\t4001: \"failed\",\n}\n")
+}
+
+func TestCatalog_Components(test *testing.T) {
+	assert.Empty(test, CheckAll(), "run: go run ./cmd/szcatalog -write")
+	for _, component := range Components {
+		expected, err := component.Source()
+		require.NoError(test, err)
+		actual, err := os.ReadFile(filepath.Join("..", component.Package, "main.go"))
+		require.NoError(test, err)
+		assert.Equal(test, string(expected), string(actual), component.Package)
+	}
+}
+
+func TestCatalog_Verb(test *testing.T) {
+	assert.Equal(test, "%s", Verb(reflect.TypeOf("")))
+	assert.Equal(test, "%d", Verb(reflect.TypeOf(int64(0))))
+	assert.Equal(test, "%d", Verb(reflect.TypeOf(0)))
+	assert.Equal(test, "%v", Verb(reflect.TypeOf(uintptr(0))))
+	assert.Equal(test, "%v", Verb(reflect.TypeOf((*error)(nil)).Elem()))
+}
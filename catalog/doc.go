@@ -0,0 +1,12 @@
+/*
+The catalog package checks and regenerates the IDMessages catalogs of the sz* packages
+so that they stay in step with the interfaces in the senzing package.
+
+For every interface method, a catalog must have an "Enter" template and, at the next number, an "Exit" template
+whose format verbs match the method's parameter and result types, plus an 8xxx entry naming the method.
+Templates for methods that are not on the interface are stale, except for ImplementationMethods.
+
+The 4xxx entries name native functions, which cannot be derived from the interfaces,
+so they and IDStatuses are preserved as written.
+*/
+package catalog
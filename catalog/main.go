@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"reflect"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Component pairs a package's message catalog with the interface it implements.
+type Component struct {
+	logging.Catalog
+	// Interface is the senzing interface type, e.g. reflect.TypeOf((*senzing.SzEngine)(nil)).Elem().
+	Interface reflect.Type
+	// Package is the name, and directory, of the package holding the catalog.
+	Package string
+}
+
+// A Finding describes one inconsistency between a catalog and its interface.
+type Finding struct {
+	Message       string `json:"message"`
+	MessageNumber int    `json:"messageNumber,omitempty"`
+	Method        string `json:"method,omitempty"`
+	Package       string `json:"package"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Ranges of message numbers.
+const (
+	firstImplementationNumber = 700
+	firstMethodNameNumber     = 8000
+	lastMethodNameNumber      = 8699
+	methodNameBoundary        = 1000
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Components lists the catalogs of the sz* packages.
+var Components = []Component{
+	{Catalog: logging.SzConfig, Interface: reflect.TypeOf((*senzing.SzConfig)(nil)).Elem(), Package: "szconfig"},
+	{Catalog: logging.SzConfigManager, Interface: reflect.TypeOf((*senzing.SzConfigManager)(nil)).Elem(), Package: "szconfigmanager"},
+	{Catalog: logging.SzDiagnostic, Interface: reflect.TypeOf((*senzing.SzDiagnostic)(nil)).Elem(), Package: "szdiagnostic"},
+	{Catalog: logging.SzEngine, Interface: reflect.TypeOf((*senzing.SzEngine)(nil)).Elem(), Package: "szengine"},
+	{Catalog: logging.SzProduct, Interface: reflect.TypeOf((*senzing.SzProduct)(nil)).Elem(), Package: "szproduct"},
+}
+
+// ImplementationMethods are methods that every implementation provides but that are not on the interfaces.
+// Their templates are preserved as written.
+var ImplementationMethods = []string{
+	"Initialize",
+	"RegisterObserver",
+	"SetLogLevel",
+	"UnregisterObserver",
+}
@@ -0,0 +1,299 @@
+package changefeed
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	affected []int64
+	err      error
+	flags    []int64
+	result   string
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = recordDefinition
+	return engine.withInfo(dataSourceCode, recordID, flags)
+}
+
+func (engine *mockSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	return engine.withInfo(dataSourceCode, recordID, flags)
+}
+
+func (engine *mockSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = entityID
+	return engine.withInfo("", "", flags)
+}
+
+func (engine *mockSzEngine) withInfo(dataSourceCode string, recordID string, flags int64) (string, error) {
+	engine.flags = append(engine.flags, flags)
+	if engine.err != nil || len(engine.result) > 0 {
+		return engine.result, engine.err
+	}
+	affected := make([]string, 0, len(engine.affected))
+	for _, entityID := range engine.affected {
+		affected = append(affected, fmt.Sprintf(`{"ENTITY_ID":%d}`, entityID))
+	}
+	return fmt.Sprintf(`{"DATA_SOURCE":"%s","RECORD_ID":"%s","AFFECTED_ENTITIES":[%s]}`,
+		dataSourceCode, recordID, strings.Join(affected, ",")), nil
+}
+
+type mockProducer struct {
+	messages []Message
+}
+
+func (producer *mockProducer) Produce(ctx context.Context, messages []Message) error {
+	_ = ctx
+	producer.messages = append(producer.messages, messages...)
+	return nil
+}
+
+// A sink failing until failures reaches zero.
+type flakySink struct {
+	events   []EntityChanged
+	failures int
+	mutex    sync.Mutex
+}
+
+func (sink *flakySink) Publish(ctx context.Context, events []EntityChanged) error {
+	_ = ctx
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	if sink.failures != 0 {
+		sink.failures--
+		return errors.New("unavailable")
+	}
+	sink.events = append(sink.events, events...)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func receive(test *testing.T, sink ChannelSink, count int) []EntityChanged {
+	test.Helper()
+	var result []EntityChanged
+	for len(result) < count {
+		select {
+		case event := <-sink:
+			result = append(result, event)
+		case <-time.After(time.Second):
+			require.FailNow(test, "timed out", "received %d of %d events", len(result), count)
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestFeed_SzEngine(test *testing.T) {
+	ctx := context.TODO()
+	sink := make(ChannelSink, 10)
+	feed := &Feed{FlushInterval: time.Hour, Sinks: []Sink{sink}}
+	engine := &mockSzEngine{affected: []int64{1, 2}}
+	szEngine := NewSzEngine(engine, feed)
+
+	result, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Empty(test, result)
+	engine.affected = []int64{2}
+	result, err = szEngine.DeleteRecord(ctx, "TEST", "2", senzing.SzWithInfo)
+	require.NoError(test, err)
+	assert.Contains(test, result, "AFFECTED_ENTITIES")
+	assert.Equal(test, []int64{senzing.SzWithInfo, senzing.SzWithInfo}, engine.flags)
+
+	stats := feed.Stats()
+	assert.Equal(test, uint64(3), stats.Changes)
+	assert.Equal(test, uint64(1), stats.Deduplicated)
+	assert.Equal(test, 2, stats.Pending)
+
+	require.NoError(test, feed.Close(ctx))
+	events := receive(test, sink, 2)
+	assert.Equal(test, int64(1), events[0].EntityID)
+	assert.Equal(test, uint64(1), events[0].Sequence)
+	assert.Equal(test, "AddRecord", events[0].Method)
+	assert.Equal(test, 1, events[0].Changes)
+	assert.Equal(test, int64(2), events[1].EntityID)
+	assert.Equal(test, uint64(2), events[1].Sequence)
+	assert.Equal(test, "DeleteRecord", events[1].Method)
+	assert.Equal(test, "TEST", events[1].DataSourceCode)
+	assert.Equal(test, "2", events[1].RecordID)
+	assert.Equal(test, 2, events[1].Changes)
+
+	stats = feed.Stats()
+	assert.Equal(test, uint64(2), stats.Sequence)
+	assert.Equal(test, []SinkStats{{Acknowledged: 2, Batches: 1}}, stats.Sinks)
+
+	_, err = szEngine.ReevaluateEntity(ctx, 3, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, uint64(3), feed.Stats().Changes)
+	require.NoError(test, feed.Close(ctx))
+}
+
+func TestFeed_SzEngine_errors(test *testing.T) {
+	ctx := context.TODO()
+	feed := &Feed{}
+	defer func() { require.NoError(test, feed.Close(ctx)) }()
+	engine := &mockSzEngine{err: errors.New("SENZ0023|Conflicting DATA_SOURCE values")}
+	szEngine := NewSzEngine(engine, feed)
+	_, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+	require.Error(test, err)
+
+	engine.err = nil
+	engine.result = "not json"
+	result, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Empty(test, result)
+	stats := feed.Stats()
+	assert.Equal(test, uint64(1), stats.Unparsed)
+	assert.Zero(test, stats.Changes)
+}
+
+func TestFeed_BatchSize(test *testing.T) {
+	ctx := context.TODO()
+	sink := make(ChannelSink, 10)
+	feed := &Feed{BatchSize: 2, FlushInterval: time.Hour, Sinks: []Sink{sink}}
+	defer func() { require.NoError(test, feed.Close(ctx)) }()
+	feed.record("AddRecord", "TEST", "1", []int64{1, 2, 3})
+	events := receive(test, sink, 3)
+	assert.Equal(test, uint64(3), events[2].Sequence)
+	require.Eventually(test, func() bool {
+		return feed.Stats().Sinks[0].Batches == 2
+	}, time.Second, time.Millisecond)
+}
+
+func TestFeed_FlushInterval(test *testing.T) {
+	ctx := context.TODO()
+	sink := make(ChannelSink, 10)
+	feed := &Feed{FlushInterval: 10 * time.Millisecond, Sinks: []Sink{sink}}
+	defer func() { require.NoError(test, feed.Close(ctx)) }()
+	feed.record("AddRecord", "TEST", "1", []int64{1})
+	receive(test, sink, 1)
+}
+
+func TestFeed_retry(test *testing.T) {
+	ctx := context.TODO()
+	flaky := &flakySink{failures: 2}
+	reliable := &flakySink{}
+	feed := &Feed{RetryInterval: time.Millisecond, Sinks: []Sink{flaky, reliable}}
+	feed.record("AddRecord", "TEST", "1", []int64{1, 2})
+	feed.record("AddRecord", "TEST", "2", []int64{3})
+	require.NoError(test, feed.Close(ctx))
+	assert.Len(test, flaky.events, 3)
+	assert.Len(test, reliable.events, 3)
+	stats := feed.Stats()
+	assert.Equal(test, SinkStats{Acknowledged: 3, Batches: 1, Failures: 2}, stats.Sinks[0])
+	assert.Equal(test, SinkStats{Acknowledged: 3, Batches: 1}, stats.Sinks[1])
+}
+
+func TestFeed_Close_timeout(test *testing.T) {
+	feed := &Feed{RetryInterval: time.Millisecond, Sinks: []Sink{&flakySink{failures: -1}}}
+	feed.record("AddRecord", "TEST", "1", []int64{1})
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(test, feed.Close(ctx), context.DeadlineExceeded)
+	assert.Positive(test, feed.Stats().Sinks[0].Failures)
+	assert.Zero(test, feed.Stats().Sinks[0].Acknowledged)
+}
+
+func TestFeed_Close_undelivered(test *testing.T) {
+	failing := &flakySink{failures: -1}
+	reliable := &flakySink{}
+	feed := &Feed{BatchSize: 2, QueueSize: 1, RetryInterval: time.Millisecond, Sinks: []Sink{failing, reliable}, StartSequence: 10}
+	feed.record("AddRecord", "TEST", "1", []int64{1, 2, 3, 4, 5, 6, 7})
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	err := feed.Close(ctx)
+	require.ErrorIs(test, err, context.DeadlineExceeded)
+	var undeliveredErr *UndeliveredError
+	require.ErrorAs(test, err, &undeliveredErr)
+	require.Len(test, undeliveredErr.Sinks, 2)
+
+	assert.Equal(test, failing, undeliveredErr.Sinks[0].Sink)
+	assert.Zero(test, undeliveredErr.Sinks[0].Acknowledged)
+	sequences := []uint64{}
+	for _, event := range undeliveredErr.Sinks[0].Events {
+		sequences = append(sequences, event.Sequence)
+	}
+	assert.Equal(test, []uint64{11, 12, 13, 14, 15, 16, 17}, sequences, "every event, in Sequence order")
+
+	assert.Equal(test, reliable, undeliveredErr.Sinks[1].Sink)
+	published := len(reliable.events)
+	assert.Equal(test, uint64(10+published), undeliveredErr.Sinks[1].Acknowledged)
+	assert.Len(test, undeliveredErr.Sinks[1].Events, 7-published)
+}
+
+func TestFeed_Close_unused(test *testing.T) {
+	ctx := context.TODO()
+	sink := make(ChannelSink, 1)
+	feed := &Feed{Sinks: []Sink{sink}}
+	require.NoError(test, feed.Close(ctx))
+	feed.record("AddRecord", "TEST", "1", []int64{1})
+	assert.Zero(test, feed.Stats().Changes)
+	require.NoError(test, feed.Close(ctx))
+	assert.Empty(test, sink)
+}
+
+func TestJSONLSink(test *testing.T) {
+	ctx := context.TODO()
+	path := filepath.Join(test.TempDir(), "changes.jsonl")
+	sink, err := OpenJSONLFile(path)
+	require.NoError(test, err)
+	feed := &Feed{Sinks: []Sink{sink}}
+	feed.record("AddRecord", "TEST", "1", []int64{1, 2})
+	require.NoError(test, feed.Close(ctx))
+	require.NoError(test, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(test, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	var events []EntityChanged
+	for scanner.Scan() {
+		var event EntityChanged
+		require.NoError(test, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.Len(test, events, 2)
+	assert.Equal(test, int64(2), events[1].EntityID)
+	assert.Equal(test, "TEST", events[1].DataSourceCode)
+	require.NoError(test, NewJSONLSink(&strings.Builder{}).Close())
+}
+
+func TestBrokerSink(test *testing.T) {
+	producer := &mockProducer{}
+	sink := BrokerSink{Producer: producer}
+	err := sink.Publish(context.TODO(), []EntityChanged{{EntityID: 42, Method: "AddRecord", Sequence: 7}})
+	require.NoError(test, err)
+	require.Len(test, producer.messages, 1)
+	assert.Equal(test, "42", string(producer.messages[0].Key))
+	assert.Contains(test, string(producer.messages[0].Value), `"sequence":7`)
+}
+
+func TestChannelSink_cancelled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	err := make(ChannelSink).Publish(ctx, []EntityChanged{{EntityID: 1}})
+	require.ErrorIs(test, err, context.Canceled)
+}
@@ -0,0 +1,19 @@
+/*
+The changefeed package publishes the entities changed by calls to an SzEngine.
+
+NewSzEngine wraps an SzEngine so that AddRecord, DeleteRecord, ProcessRedoRecord, ReevaluateEntity and ReevaluateRecord
+are always called with senzing.SzWithInfo. The AFFECTED_ENTITIES of each result are recorded in a Feed as EntityChanged events.
+If the caller did not request senzing.SzWithInfo, the empty result the engine would have returned is returned instead.
+
+The Feed collects events for up to FlushInterval, or until BatchSize entities have changed,
+collapsing repeated changes of an entity into one event, and then publishes the batch to each of its Sinks.
+A sink that fails is retried with the same batch until it succeeds, so each sink receives every event at least once and in Sequence order.
+
+Delivery is at least once only within the life of the process: events are held in memory, not persisted.
+If the context passed to Feed.Close is done before every sink has caught up, for instance because a sink keeps failing,
+Close returns an *UndeliveredError with the events each sink has not published and the last Sequence it acknowledged,
+for the caller to save or publish again. Events not yet published when the process exits are lost.
+StartSequence lets a new Feed continue the numbering of a previous one.
+ChannelSink, JSONLSink and BrokerSink are provided; BrokerSink adapts a message broker client through the Producer interface.
+*/
+package changefeed
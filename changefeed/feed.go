@@ -0,0 +1,281 @@
+package changefeed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Feed
+// ----------------------------------------------------------------------------
+
+/*
+The Close method publishes the pending events and waits for every sink to publish its queued batches.
+Changes recorded after Close are discarded.
+If ctx is done first, the sinks' contexts are canceled and, once they have returned, Close returns an *UndeliveredError
+holding the events each sink has not published, e.g. to be saved and published again by the caller.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (feed *Feed) Close(ctx context.Context) error {
+	feed.start.Do(feed.run)
+	feed.mutex.Lock()
+	if feed.closed {
+		feed.mutex.Unlock()
+		return nil
+	}
+	feed.closed = true
+	close(feed.flush)
+	feed.mutex.Unlock()
+	select {
+	case <-feed.done:
+		feed.cancel()
+		return nil
+	case <-ctx.Done():
+		feed.cancel()
+		<-feed.done
+		return feed.undeliveredError(ctx.Err())
+	}
+}
+
+/*
+The Stats method returns the counts of changes and the delivery progress of each sink.
+*/
+func (feed *Feed) Stats() Stats {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+	result := feed.stats
+	result.Pending = len(feed.pending)
+	result.Sequence = feed.sequence
+	result.Sinks = make([]SinkStats, 0, len(feed.workers))
+	for _, worker := range feed.workers {
+		stats := worker.stats
+		stats.Queued = len(worker.batches)
+		result.Sinks = append(result.Sinks, stats)
+	}
+	return result
+}
+
+func (feed *Feed) batchSize() int {
+	if feed.BatchSize > 0 {
+		return feed.BatchSize
+	}
+	return DefaultBatchSize
+}
+
+// Publish batches to one sink in order, retrying each until it succeeds or ctx is done.
+func (feed *Feed) deliver(ctx context.Context, worker *worker) {
+	defer close(worker.done)
+	for batch := range worker.batches {
+		delay := feed.retryInterval()
+		for {
+			err := worker.sink.Publish(ctx, batch)
+			feed.mutex.Lock()
+			if err == nil {
+				worker.stats.Acknowledged = batch[len(batch)-1].Sequence
+				worker.stats.Batches++
+				feed.mutex.Unlock()
+				break
+			}
+			worker.stats.Failures++
+			feed.mutex.Unlock()
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				feed.undeliver(worker, batch)
+				return
+			}
+			delay = min(2*delay, MaxRetryInterval)
+		}
+	}
+}
+
+func (feed *Feed) flushInterval() time.Duration {
+	if feed.FlushInterval > 0 {
+		return feed.FlushInterval
+	}
+	return DefaultFlushInterval
+}
+
+// Number the pending events and queue them for every sink in batches of at most BatchSize.
+func (feed *Feed) publish(ctx context.Context) {
+	feed.mutex.Lock()
+	pending := feed.pending
+	feed.pending = nil
+	feed.index = map[int64]int{}
+	for index := range pending {
+		feed.sequence++
+		pending[index].Sequence = feed.sequence
+	}
+	feed.mutex.Unlock()
+	for len(pending) > 0 {
+		batch := pending[:min(len(pending), feed.batchSize())]
+		pending = pending[len(batch):]
+		for index, worker := range feed.workers {
+			select {
+			case worker.batches <- batch:
+			case <-ctx.Done():
+				for _, worker := range feed.workers[index:] {
+					feed.undeliver(worker, batch)
+				}
+				for _, worker := range feed.workers {
+					feed.undeliver(worker, pending)
+				}
+				return
+			}
+		}
+	}
+}
+
+func (feed *Feed) queueSize() int {
+	if feed.QueueSize > 0 {
+		return feed.QueueSize
+	}
+	return DefaultQueueSize
+}
+
+// Record that a call changed entities, collapsing changes of entities already pending.
+func (feed *Feed) record(method string, dataSourceCode string, recordID string, entityIDs []int64) {
+	if len(entityIDs) == 0 {
+		return
+	}
+	feed.start.Do(feed.run)
+	now := time.Now()
+	feed.mutex.Lock()
+	if feed.closed {
+		feed.mutex.Unlock()
+		return
+	}
+	for _, entityID := range entityIDs {
+		feed.stats.Changes++
+		event := EntityChanged{
+			Changes:        1,
+			DataSourceCode: dataSourceCode,
+			EntityID:       entityID,
+			Method:         method,
+			RecordID:       recordID,
+			Time:           now,
+		}
+		if index, ok := feed.index[entityID]; ok {
+			event.Changes += feed.pending[index].Changes
+			feed.pending[index] = event
+			feed.stats.Deduplicated++
+			continue
+		}
+		feed.index[entityID] = len(feed.pending)
+		feed.pending = append(feed.pending, event)
+	}
+	if len(feed.pending) >= feed.batchSize() {
+		select {
+		case feed.flush <- struct{}{}:
+		default:
+		}
+	}
+	feed.mutex.Unlock()
+}
+
+func (feed *Feed) retryInterval() time.Duration {
+	if feed.RetryInterval > 0 {
+		return feed.RetryInterval
+	}
+	return DefaultRetryInterval
+}
+
+// Start a worker for each sink, and a goroutine publishing every FlushInterval,
+// when BatchSize entities are pending, and when Close is called.
+func (feed *Feed) run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+	feed.cancel = cancel
+	feed.done = make(chan struct{})
+	feed.sequence = feed.StartSequence
+	feed.flush = make(chan struct{}, 1)
+	feed.index = map[int64]int{}
+	for _, sink := range feed.Sinks {
+		worker := &worker{
+			batches: make(chan []EntityChanged, feed.queueSize()),
+			done:    make(chan struct{}),
+			sink:    sink,
+		}
+		feed.workers = append(feed.workers, worker)
+		go feed.deliver(ctx, worker)
+	}
+	go func() {
+		defer close(feed.done)
+		ticker := time.NewTicker(feed.flushInterval())
+		defer ticker.Stop()
+		for open := true; open; {
+			select {
+			case <-ticker.C:
+			case _, open = <-feed.flush:
+			}
+			feed.publish(ctx)
+		}
+		for _, worker := range feed.workers {
+			close(worker.batches)
+		}
+		for _, worker := range feed.workers {
+			<-worker.done
+			for batch := range worker.batches {
+				feed.undeliver(worker, batch)
+			}
+		}
+	}()
+}
+
+// Keep events that a sink will not publish, for Close to return.
+func (feed *Feed) undeliver(worker *worker, events []EntityChanged) {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+	worker.undelivered = append(worker.undelivered, events...)
+}
+
+// The error returned by Close when cause ended the delivery: an *UndeliveredError if events were not published.
+func (feed *Feed) undeliveredError(cause error) error {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+	result := &UndeliveredError{Cause: cause}
+	for _, worker := range feed.workers {
+		if len(worker.undelivered) == 0 {
+			continue
+		}
+		events := append([]EntityChanged{}, worker.undelivered...)
+		sort.Slice(events, func(i, j int) bool { return events[i].Sequence < events[j].Sequence })
+		result.Sinks = append(result.Sinks, Undelivered{
+			Acknowledged: worker.stats.Acknowledged,
+			Events:       events,
+			Sink:         worker.sink,
+		})
+	}
+	if len(result.Sinks) == 0 {
+		return cause
+	}
+	return result
+}
+
+func (feed *Feed) unparsed() {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+	feed.stats.Unparsed++
+}
+
+// ----------------------------------------------------------------------------
+// Methods - UndeliveredError
+// ----------------------------------------------------------------------------
+
+func (err *UndeliveredError) Error() string {
+	events := 0
+	for _, sink := range err.Sinks {
+		events += len(sink.Events)
+	}
+	return fmt.Sprintf("%d events not published to %d sinks: %v", events, len(err.Sinks), err.Cause)
+}
+
+// Unwrap returns the error of the context passed to Close.
+func (err *UndeliveredError) Unwrap() error {
+	return err.Cause
+}
@@ -0,0 +1,158 @@
+package changefeed
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A BrokerSink publishes events through a Producer, one message per event keyed by entity ID.
+type BrokerSink struct {
+	Producer Producer
+}
+
+// A ChannelSink sends events on a Go channel.
+type ChannelSink chan EntityChanged
+
+// An EntityChanged event reports that an entity was changed by one or more SzEngine calls.
+type EntityChanged struct {
+	// Changes is the number of calls collapsed into this event.
+	Changes int `json:"changes"`
+	// DataSourceCode and RecordID identify the record of the latest call, if it had one.
+	DataSourceCode string `json:"dataSourceCode,omitempty"`
+	EntityID       int64  `json:"entityId"`
+	// Method is the SzEngine method of the latest call, e.g. "AddRecord".
+	Method   string `json:"method"`
+	RecordID string `json:"recordId,omitempty"`
+	// Sequence increases by one for each event published by a Feed.
+	Sequence uint64    `json:"sequence"`
+	Time     time.Time `json:"time"`
+}
+
+// A Feed batches EntityChanged events and publishes them to its Sinks.
+// The zero value is ready to use with default settings once Sinks are set.
+// Settings must not be changed after first use. A Feed must not be copied after first use.
+type Feed struct {
+	// BatchSize is the number of changed entities that triggers publishing. Defaults to DefaultBatchSize.
+	BatchSize int
+	// FlushInterval is the longest an event is held before publishing, and the window
+	// within which repeated changes of an entity are collapsed. Defaults to DefaultFlushInterval.
+	FlushInterval time.Duration
+	// QueueSize is the number of batches held for each sink. When a sink's queue is full, publishing waits. Defaults to DefaultQueueSize.
+	QueueSize int
+	// RetryInterval is the first delay before retrying a sink, doubling up to MaxRetryInterval. Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+	// Sinks receive every batch.
+	Sinks []Sink
+	// StartSequence is the Sequence after which events are numbered, e.g. the last Sequence a previous Feed published,
+	// so that a Sequence persisted by a sink's consumer keeps increasing across restarts.
+	StartSequence uint64
+
+	cancel   context.CancelFunc
+	closed   bool
+	done     chan struct{}
+	flush    chan struct{}
+	index    map[int64]int
+	mutex    sync.Mutex
+	pending  []EntityChanged
+	sequence uint64
+	start    sync.Once
+	stats    Stats
+	workers  []*worker
+}
+
+// A JSONLSink writes events to an io.Writer as JSON lines.
+type JSONLSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// A Message is one message for a Producer.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// A Producer sends messages to a message broker, such as a Kafka, NATS or SQS client.
+// It returns nil only once every message has been accepted by the broker.
+type Producer interface {
+	Produce(ctx context.Context, messages []Message) error
+}
+
+// A Sink receives batches of events. It returns nil only once every event in the batch has been delivered;
+// otherwise the same batch is offered again. Publish should return once ctx is done.
+type Sink interface {
+	Publish(ctx context.Context, events []EntityChanged) error
+}
+
+// SinkStats are the stats of one of a Feed's Sinks.
+type SinkStats struct {
+	// Acknowledged is the Sequence of the last event the sink has published.
+	Acknowledged uint64 `json:"acknowledged"`
+	// Batches is the number of batches the sink has published.
+	Batches uint64 `json:"batches"`
+	// Failures is the number of failed attempts to publish.
+	Failures uint64 `json:"failures"`
+	// Queued is the number of batches waiting for the sink.
+	Queued int `json:"queued"`
+}
+
+// Undelivered are the events a sink had not published when a Feed was closed.
+type Undelivered struct {
+	// Acknowledged is the Sequence of the last event the sink published.
+	Acknowledged uint64
+	// Events are the events not published, in Sequence order.
+	Events []EntityChanged
+	Sink   Sink
+}
+
+// An UndeliveredError is returned by Feed.Close when its context is done before every sink has published every event.
+// It matches the context's error with errors.Is.
+type UndeliveredError struct {
+	// Cause is the error of the context passed to Close.
+	Cause error
+	// Sinks are the sinks with events not published, in the order of Feed.Sinks.
+	Sinks []Undelivered
+}
+
+// Stats of a Feed.
+type Stats struct {
+	// Changes is the number of entity changes recorded.
+	Changes uint64 `json:"changes"`
+	// Deduplicated is the number of changes collapsed into an event already pending.
+	Deduplicated uint64 `json:"deduplicated"`
+	// Pending is the number of events not yet batched.
+	Pending int `json:"pending"`
+	// Sequence is the Sequence of the last event batched.
+	Sequence uint64      `json:"sequence"`
+	Sinks    []SinkStats `json:"sinks"`
+	// Unparsed is the number of results whose affected entities could not be parsed.
+	Unparsed uint64 `json:"unparsed"`
+}
+
+// Delivery of batches to one Sink.
+type worker struct {
+	batches     chan []EntityChanged
+	done        chan struct{}
+	sink        Sink
+	stats       SinkStats
+	undelivered []EntityChanged
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default values.
+const (
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = time.Second
+	DefaultQueueSize     = 100
+	DefaultRetryInterval = 100 * time.Millisecond
+	MaxRetryInterval     = 30 * time.Second
+)
@@ -0,0 +1,120 @@
+package changefeed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - BrokerSink
+// ----------------------------------------------------------------------------
+
+/*
+The Publish method sends each event as a JSON message keyed by its decimal entity ID,
+so that a partitioned broker keeps the events of an entity in order.
+*/
+func (sink BrokerSink) Publish(ctx context.Context, events []EntityChanged) error {
+	messages := make([]Message, 0, len(events))
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, Message{
+			Key:   []byte(strconv.FormatInt(event.EntityID, 10)),
+			Value: value,
+		})
+	}
+	return sink.Producer.Produce(ctx, messages)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - ChannelSink
+// ----------------------------------------------------------------------------
+
+/*
+The Publish method sends each event on the channel, returning early if ctx is done.
+*/
+func (sink ChannelSink) Publish(ctx context.Context, events []EntityChanged) error {
+	for _, event := range events {
+		select {
+		case sink <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - JSONLSink
+// ----------------------------------------------------------------------------
+
+/*
+The Close method closes the underlying writer if it is an io.Closer.
+*/
+func (sink *JSONLSink) Close() error {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	if closer, ok := sink.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+/*
+The Publish method writes the batch in one write, one event per line.
+If the writer is an *os.File, it is synced before returning.
+*/
+func (sink *JSONLSink) Publish(ctx context.Context, events []EntityChanged) error {
+	_ = ctx
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	if _, err := sink.writer.Write(buffer.Bytes()); err != nil {
+		return err
+	}
+	if file, ok := sink.writer.(*os.File); ok {
+		return file.Sync()
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewJSONLSink function returns a sink writing JSON lines to writer.
+
+Input
+  - writer: Where events are written.
+*/
+func NewJSONLSink(writer io.Writer) *JSONLSink {
+	return &JSONLSink{writer: writer}
+}
+
+/*
+The OpenJSONLFile function returns a sink appending JSON lines to a file, creating it if needed.
+Close the sink after closing the Feed.
+
+Input
+  - path: The path of the file.
+*/
+func OpenJSONLFile(path string) (*JSONLSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return NewJSONLSink(file), nil
+}
@@ -0,0 +1,198 @@
+package changefeed
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzEngine that records the entities changed by its calls in a Feed.
+type publishingSzEngine struct {
+	feed     *Feed
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *publishingSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	result, err := client.szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags|senzing.SzWithInfo)
+	return client.publish(ctx, "AddRecord", result, err, flags)
+}
+
+func (client *publishingSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	return client.szEngine.CloseExport(ctx, exportHandle)
+}
+
+func (client *publishingSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return client.szEngine.CountRedoRecords(ctx)
+}
+
+func (client *publishingSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	result, err := client.szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	return client.publish(ctx, "DeleteRecord", result, err, flags)
+}
+
+func (client *publishingSzEngine) Destroy(ctx context.Context) error {
+	return client.szEngine.Destroy(ctx)
+}
+
+func (client *publishingSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	return client.szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+}
+
+func (client *publishingSzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	return client.szEngine.ExportCsvEntityReportIterator(ctx, csvColumnList, flags)
+}
+
+func (client *publishingSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return client.szEngine.ExportJSONEntityReport(ctx, flags)
+}
+
+func (client *publishingSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	return client.szEngine.ExportJSONEntityReportIterator(ctx, flags)
+}
+
+func (client *publishingSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	return client.szEngine.FetchNext(ctx, exportHandle)
+}
+
+func (client *publishingSzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+}
+
+func (client *publishingSzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *publishingSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return client.szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+}
+
+func (client *publishingSzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return client.szEngine.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+}
+
+func (client *publishingSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	return client.szEngine.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+}
+
+func (client *publishingSzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	return client.szEngine.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+}
+
+func (client *publishingSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return client.szEngine.GetActiveConfigID(ctx)
+}
+
+func (client *publishingSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.szEngine.GetEntityByEntityID(ctx, entityID, flags)
+}
+
+func (client *publishingSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *publishingSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *publishingSzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	return client.szEngine.GetRedoRecord(ctx)
+}
+
+func (client *publishingSzEngine) GetStats(ctx context.Context) (string, error) {
+	return client.szEngine.GetStats(ctx)
+}
+
+func (client *publishingSzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	return client.szEngine.GetVirtualEntityByRecordID(ctx, recordList, flags)
+}
+
+func (client *publishingSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.szEngine.HowEntityByEntityID(ctx, entityID, flags)
+}
+
+func (client *publishingSzEngine) PrimeEngine(ctx context.Context) error {
+	return client.szEngine.PrimeEngine(ctx)
+}
+
+func (client *publishingSzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	result, err := client.szEngine.ProcessRedoRecord(ctx, redoRecord, flags|senzing.SzWithInfo)
+	return client.publish(ctx, "ProcessRedoRecord", result, err, flags)
+}
+
+func (client *publishingSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	result, err := client.szEngine.ReevaluateEntity(ctx, entityID, flags|senzing.SzWithInfo)
+	return client.publish(ctx, "ReevaluateEntity", result, err, flags)
+}
+
+func (client *publishingSzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	result, err := client.szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	return client.publish(ctx, "ReevaluateRecord", result, err, flags)
+}
+
+func (client *publishingSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	return client.szEngine.Reinitialize(ctx, configID)
+}
+
+func (client *publishingSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	return client.szEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+}
+
+func (client *publishingSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	return client.szEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+}
+
+func (client *publishingSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *publishingSzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	return client.szEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+}
+
+// Record the affected entities of a mutating call made with senzing.SzWithInfo.
+func (client *publishingSzEngine) publish(ctx context.Context, method string, result string, err error, flags int64) (string, error) {
+	if err != nil {
+		return result, err
+	}
+	withInfo, parseErr := response.SzEngineAddRecord(ctx, result)
+	if parseErr != nil {
+		client.feed.unparsed()
+	} else {
+		entityIDs := make([]int64, 0, len(withInfo.AffectedEntities))
+		for _, affected := range withInfo.AffectedEntities {
+			entityIDs = append(entityIDs, affected.EntityID)
+		}
+		client.feed.record(method, withInfo.DataSource, withInfo.RecordID, entityIDs)
+	}
+	if flags&senzing.SzWithInfo == 0 {
+		return "", nil
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzEngine function wraps an SzEngine so that the entities changed by its calls are published through feed.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - feed: The Feed, which may be shared by several wrappers.
+*/
+func NewSzEngine(szEngine senzing.SzEngine, feed *Feed) senzing.SzEngine {
+	return &publishingSzEngine{
+		feed:     feed,
+		szEngine: szEngine,
+	}
+}
@@ -0,0 +1,54 @@
+/*
+The szcatalog command checks, or with -write regenerates, the IDMessages catalogs of the sz* packages.
+
+Usage, from the repository root:
+
+	go run ./cmd/szcatalog [-write] [package ...]
+
+Without -write, inconsistencies are printed and the exit status is 1 if there are any.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/senzing-garage/sz-sdk-go/catalog"
+)
+
+func main() {
+	directory := flag.String("dir", ".", "Repository root containing the sz* package directories.")
+	write := flag.Bool("write", false, "Rewrite each package's main.go instead of checking it.")
+	flag.Parse()
+
+	components := []catalog.Component{}
+	for _, component := range catalog.Components {
+		if flag.NArg() == 0 || slices.Contains(flag.Args(), component.Package) {
+			components = append(components, component)
+		}
+	}
+
+	exitCode := 0
+	for _, component := range components {
+		if *write {
+			source, err := component.Source()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", component.Package, err)
+				os.Exit(1)
+			}
+			if err := os.WriteFile(filepath.Join(*directory, component.Package, "main.go"), source, 0o644); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", component.Package, err)
+				os.Exit(1)
+			}
+			continue
+		}
+		for _, finding := range component.Check() {
+			fmt.Printf("%s: %d: %s\n", finding.Package, finding.MessageNumber, finding.Message)
+			exitCode = 1
+		}
+	}
+	os.Exit(exitCode)
+}
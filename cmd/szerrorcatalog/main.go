@@ -0,0 +1,130 @@
+/*
+The szerrorcatalog command regenerates szerror/szerrorcatalog.go from the comments of szerror/szerrortypes.go,
+which name each Senzing error code and give its message template.
+
+Usage, from the repository root:
+
+	go run ./cmd/szerrorcatalog [-dir .]
+*/
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// An entry of szerrortypes.go.
+type entry struct {
+	code     int
+	name     string
+	template string
+	types    string
+}
+
+// Components by substrings of the symbolic name, checked in order.
+var nameComponents = []struct {
+	component  string
+	substrings []string
+}{
+	{"SecureStore", []string{"G2SS"}},
+	{"Hashing", []string{"SALT", "HASHER", "HMAC", "DIGEST", "CIPHER", "TOKEN_LIBRARY"}},
+	{"License", []string{"LICENSE", "EAS_LIMIT_"}},
+	{"Database", []string{"_DB_", "DATABASE", "DATASTORE"}},
+}
+
+// Lines such as: 33: {SzNotFound, SzBadInput}, // EAS_ERR_UNKNOWN_DSRC_RECORD_ID - Unknown record: dsrc[{0}], record[{1}]
+var entryPattern = regexp.MustCompile(`^\s*(\d+):\s*\{([^}]*)\},\s*// (\S+) - (.*)$`)
+
+func main() {
+	directory := flag.String("dir", ".", "Repository root containing the szerror package directory.")
+	flag.Parse()
+
+	input := filepath.Join(*directory, "szerror", "szerrortypes.go")
+	entries, err := readEntries(input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", input, err)
+		os.Exit(1)
+	}
+	source, err := generate(entries)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "generate: %v\n", err)
+		os.Exit(1)
+	}
+	output := filepath.Join(*directory, "szerror", "szerrorcatalog.go")
+	if err := os.WriteFile(output, source, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", output, err)
+		os.Exit(1)
+	}
+}
+
+// The component of a code: by its name, then its range, then whether it is a configuration error.
+func component(entry entry) string {
+	for _, nameComponent := range nameComponents {
+		for _, substring := range nameComponent.substrings {
+			if strings.Contains(entry.name, substring) {
+				return nameComponent.component
+			}
+		}
+	}
+	switch {
+	case entry.code >= 1000 && entry.code < 2000:
+		return "Database"
+	case entry.code >= 3000 && entry.code < 4000:
+		return "API"
+	case strings.Contains(entry.types, "SzConfiguration"):
+		return "Configuration"
+	}
+	return "Engine"
+}
+
+func generate(entries []entry) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString("// Code generated by cmd/szerrorcatalog from szerrortypes.go. DO NOT EDIT.\n\n")
+	buffer.WriteString("package szerror\n\n")
+	buffer.WriteString("// The symbolic name, message template and component of each Senzing error code.\n")
+	buffer.WriteString("var szErrorCatalog = map[int]ErrorInfo{\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&buffer, "\t%d: {Code: %d, Component: %q, Name: %q, Template: %s},\n",
+			entry.code, entry.code, component(entry), entry.name, strconv.Quote(entry.template))
+	}
+	buffer.WriteString("}\n")
+	return format.Source(buffer.Bytes())
+}
+
+func readEntries(path string) ([]entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	result := []entry{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		match := entryPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		code, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry{code: code, types: match[2], name: match[3], template: strings.TrimSpace(match[4])})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no error codes found")
+	}
+	slices.SortFunc(result, func(a entry, b entry) int { return a.code - b.code })
+	return result, nil
+}
@@ -0,0 +1,485 @@
+package configlint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-json-type-definition/go/typedef"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	anyTypes = map[reflect.Type]bool{
+		reflect.TypeOf(typedef.FixmeUnknown{}): true,
+	}
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// The String method returns the lowercase name of the severity.
+func (severity Severity) String() string {
+	result, ok := severityNames[severity]
+	if !ok {
+		result = fmt.Sprintf("severity(%d)", int(severity))
+	}
+	return result
+}
+
+// The MarshalText method lets a Severity be serialized by name.
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+// The UnmarshalText method parses a Severity serialized by name.
+func (severity *Severity) UnmarshalText(text []byte) error {
+	for key, value := range severityNames {
+		if strings.EqualFold(value, string(text)) {
+			*severity = key
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown severity: %s", text)
+}
+
+// The String method formats a finding on a single line.
+func (finding Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", finding.Severity, finding.Path, finding.Message, finding.Rule)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The HasErrors function reports whether any finding has SeverityError.
+
+Input
+  - findings: The result of Lint().
+*/
+func HasErrors(findings []Finding) bool {
+	for _, finding := range findings {
+		if finding.Severity >= SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+The Lint function checks a Senzing configuration definition without calling Senzing.
+
+Input
+  - ctx: A context to control lifecycle.
+  - configDefinition: The JSON configuration document that would be given to SzConfigManager.AddConfig().
+  - productVersion: The JSON returned by SzProduct.GetVersion(), or "" to skip the compatibility check.
+
+Output
+  - The findings, sorted by Path, with array elements in index order.
+  - An error if productVersion cannot be parsed.
+*/
+func Lint(ctx context.Context, configDefinition string, productVersion string) ([]Finding, error) {
+	result, err := lint(ctx, configDefinition, productVersion)
+	sort.SliceStable(result, func(i, j int) bool {
+		return comparePaths(result[i].Path, result[j].Path) < 0
+	})
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// The findings of Lint, in the order of the checks.
+func lint(ctx context.Context, configDefinition string, productVersion string) ([]Finding, error) {
+	var document any
+	if err := json.Unmarshal([]byte(configDefinition), &document); err != nil {
+		return []Finding{{
+			Severity: SeverityError,
+			Path:     "$",
+			Rule:     RuleInvalidJSON,
+			Message:  err.Error(),
+		}}, nil
+	}
+
+	result := checkSchema("$", document, reflect.TypeOf(typedef.SzConfigManagerGetConfigResponse{}))
+	root, _ := document.(map[string]any)
+	g2Config, ok := root["G2_CONFIG"].(map[string]any)
+	if !ok {
+		// checkSchema has reported G2_CONFIG as missing or not an object.
+		return result, nil
+	}
+
+	result = append(result, checkDuplicates(g2Config)...)
+	result = append(result, checkReferences(g2Config)...)
+	result = append(result, checkThresholds(g2Config)...)
+
+	if len(productVersion) > 0 {
+		findings, err := checkCompatibility(ctx, g2Config, productVersion)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, findings...)
+	}
+	return result, nil
+}
+
+func checkCompatibility(ctx context.Context, g2Config map[string]any, productVersion string) ([]Finding, error) {
+	result := []Finding{}
+	version, err := response.SzProductGetVersion(ctx, productVersion)
+	if err != nil {
+		return result, fmt.Errorf("cannot parse product version: %w", err)
+	}
+	path := "$.G2_CONFIG.CONFIG_BASE_VERSION.COMPATIBILITY_VERSION.CONFIG_VERSION"
+	baseVersion, _ := g2Config["CONFIG_BASE_VERSION"].(map[string]any)
+	compatibilityVersion, _ := baseVersion["COMPATIBILITY_VERSION"].(map[string]any)
+	configVersion, ok := compatibilityVersion["CONFIG_VERSION"].(string)
+	// A missing CONFIG_VERSION is reported by checkSchema.
+	if ok && configVersion != version.CompatibilityVersion.ConfigVersion {
+		result = append(result, Finding{
+			Severity: SeverityError,
+			Path:     path,
+			Rule:     RuleCompatibilityVersion,
+			Message:  fmt.Sprintf("configuration compatibility version %q does not match engine version %q", configVersion, version.CompatibilityVersion.ConfigVersion),
+		})
+	}
+	return result, nil
+}
+
+func checkDuplicates(g2Config map[string]any) []Finding {
+	result := []Finding{}
+	for _, aTable := range uniqueTables {
+		ids := map[string]int{}
+		codes := map[string]int{}
+		for index, row := range rows(g2Config, aTable.name) {
+			if id, ok := row[aTable.idField]; ok && id != nil {
+				key := fmt.Sprint(id)
+				if first, seen := ids[key]; seen {
+					result = append(result, Finding{
+						Severity: SeverityError,
+						Path:     fieldPath(aTable.name, index, aTable.idField),
+						Rule:     RuleDuplicateID,
+						Message:  fmt.Sprintf("%s %s is also used by %s[%d]", aTable.idField, key, aTable.name, first),
+					})
+				} else {
+					ids[key] = index
+				}
+			}
+			if len(aTable.codeField) == 0 {
+				continue
+			}
+			if code, ok := row[aTable.codeField].(string); ok {
+				key := strings.ToUpper(code)
+				if first, seen := codes[key]; seen {
+					result = append(result, Finding{
+						Severity: SeverityError,
+						Path:     fieldPath(aTable.name, index, aTable.codeField),
+						Rule:     RuleDuplicateCode,
+						Message:  fmt.Sprintf("%s %q is also used by %s[%d]", aTable.codeField, code, aTable.name, first),
+					})
+				} else {
+					codes[key] = index
+				}
+			}
+		}
+	}
+	return result
+}
+
+func checkReferences(g2Config map[string]any) []Finding {
+	result := []Finding{}
+	targets := map[string]map[string]bool{}
+	for _, aReference := range references {
+		targetKey := aReference.targetTable + "." + aReference.targetField
+		known, ok := targets[targetKey]
+		if !ok {
+			known = map[string]bool{}
+			for _, row := range rows(g2Config, aReference.targetTable) {
+				if key, ok := referenceKey(row[aReference.targetField]); ok {
+					known[key] = true
+				}
+			}
+			targets[targetKey] = known
+		}
+		for index, row := range rows(g2Config, aReference.name) {
+			key, ok := referenceKey(row[aReference.field])
+			if !ok || known[key] {
+				continue
+			}
+			result = append(result, Finding{
+				Severity: SeverityError,
+				Path:     fieldPath(aReference.name, index, aReference.field),
+				Rule:     RuleDanglingReference,
+				Message:  fmt.Sprintf("%s %v does not exist in %s", aReference.field, row[aReference.field], aReference.targetTable),
+			})
+		}
+	}
+	return result
+}
+
+func checkSchema(path string, value any, aType reflect.Type) []Finding {
+	result := []Finding{}
+	if value == nil || anyTypes[aType] {
+		return result
+	}
+	mismatch := func(expected string) []Finding {
+		return append(result, Finding{
+			Severity: SeverityError,
+			Path:     path,
+			Rule:     RuleSchema,
+			Message:  fmt.Sprintf("expected %s, found %s", expected, jsonTypeName(value)),
+		})
+	}
+
+	switch {
+	case aType == timeType:
+		if _, ok := value.(string); !ok {
+			return mismatch("string")
+		}
+	case aType.Kind() == reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return mismatch("object")
+		}
+		fields := jsonFields(aType)
+		for _, key := range sortedKeys(fields) {
+			if _, ok := object[key]; !ok {
+				result = append(result, Finding{
+					Severity: SeverityError,
+					Path:     path + "." + key,
+					Rule:     RuleMissingField,
+					Message:  fmt.Sprintf("%s is required", key),
+				})
+			}
+		}
+		for _, key := range sortedKeys(object) {
+			fieldType, ok := fields[key]
+			if !ok {
+				result = append(result, Finding{
+					Severity: SeverityWarning,
+					Path:     path + "." + key,
+					Rule:     RuleUnknownField,
+					Message:  fmt.Sprintf("%s is not a known field", key),
+				})
+				continue
+			}
+			result = append(result, checkSchema(path+"."+key, object[key], fieldType)...)
+		}
+	case aType.Kind() == reflect.Slice:
+		array, ok := value.([]any)
+		if !ok {
+			return mismatch("array")
+		}
+		for index, element := range array {
+			result = append(result, checkSchema(fmt.Sprintf("%s[%d]", path, index), element, aType.Elem())...)
+		}
+	case aType.Kind() == reflect.Map:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return mismatch("object")
+		}
+		for _, key := range sortedKeys(object) {
+			result = append(result, checkSchema(path+"."+key, object[key], aType.Elem())...)
+		}
+	case aType.Kind() == reflect.String:
+		if _, ok := value.(string); !ok {
+			return mismatch("string")
+		}
+	case aType.Kind() == reflect.Int64:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return mismatch("integer")
+		}
+	case aType.Kind() == reflect.Interface:
+		// Any JSON value is accepted.
+	default:
+		// A kind the typedef did not use when this check was written; fail rather than accept unchecked values.
+		return append(result, Finding{
+			Severity: SeverityError,
+			Path:     path,
+			Rule:     RuleSchema,
+			Message:  fmt.Sprintf("values of type %s cannot be checked", aType),
+		})
+	}
+	return result
+}
+
+func checkThresholds(g2Config map[string]any) []Finding {
+	result := []Finding{}
+	scoreFields := []string{"SAME_SCORE", "CLOSE_SCORE", "LIKELY_SCORE", "PLAUSIBLE_SCORE", "UN_LIKELY_SCORE"}
+	for index, row := range rows(g2Config, "CFG_CFRTN") {
+		previousField := ""
+		previousScore := int64(math.MaxInt64)
+		for _, field := range scoreFields {
+			score, ok := asInt(row[field])
+			if !ok {
+				continue
+			}
+			if score < 0 || score > maximumScore {
+				result = append(result, Finding{
+					Severity: SeverityWarning,
+					Path:     fieldPath("CFG_CFRTN", index, field),
+					Rule:     RuleSuspiciousThreshold,
+					Message:  fmt.Sprintf("%s %d is outside 0..%d", field, score, maximumScore),
+				})
+			} else if score > previousScore {
+				result = append(result, Finding{
+					Severity: SeverityWarning,
+					Path:     fieldPath("CFG_CFRTN", index, field),
+					Rule:     RuleSuspiciousThreshold,
+					Message:  fmt.Sprintf("%s %d is greater than %s %d", field, score, previousField, previousScore),
+				})
+			}
+			previousField = field
+			previousScore = score
+		}
+	}
+
+	for index, row := range rows(g2Config, "CFG_GENERIC_THRESHOLD") {
+		candidateCap, candidateOK := asInt(row["CANDIDATE_CAP"])
+		scoringCap, scoringOK := asInt(row["SCORING_CAP"])
+		if candidateOK && candidateCap < 1 {
+			result = append(result, Finding{
+				Severity: SeverityWarning,
+				Path:     fieldPath("CFG_GENERIC_THRESHOLD", index, "CANDIDATE_CAP"),
+				Rule:     RuleSuspiciousThreshold,
+				Message:  fmt.Sprintf("CANDIDATE_CAP %d prevents features from being used as candidates", candidateCap),
+			})
+		}
+		if candidateOK && scoringOK && scoringCap >= 0 && scoringCap < candidateCap {
+			result = append(result, Finding{
+				Severity: SeverityWarning,
+				Path:     fieldPath("CFG_GENERIC_THRESHOLD", index, "SCORING_CAP"),
+				Rule:     RuleSuspiciousThreshold,
+				Message:  fmt.Sprintf("SCORING_CAP %d is less than CANDIDATE_CAP %d", scoringCap, candidateCap),
+			})
+		}
+	}
+
+	for index, row := range rows(g2Config, "CFG_ERRULE") {
+		if score, ok := asInt(row["REF_SCORE"]); ok && (score < 0 || score > maximumScore) {
+			result = append(result, Finding{
+				Severity: SeverityWarning,
+				Path:     fieldPath("CFG_ERRULE", index, "REF_SCORE"),
+				Rule:     RuleSuspiciousThreshold,
+				Message:  fmt.Sprintf("REF_SCORE %d is outside 0..%d", score, maximumScore),
+			})
+		}
+	}
+	return result
+}
+
+func asInt(value any) (int64, bool) {
+	number, ok := value.(float64)
+	if !ok {
+		return 0, false
+	}
+	return int64(number), true
+}
+
+// Compare paths as strings, except that runs of digits, e.g. array indexes, compare as numbers.
+func comparePaths(a string, b string) int {
+	for len(a) > 0 && len(b) > 0 {
+		aDigits, bDigits := leadingDigits(a), leadingDigits(b)
+		if aDigits > 0 && bDigits > 0 {
+			aNumber, bNumber := strings.TrimLeft(a[:aDigits], "0"), strings.TrimLeft(b[:bDigits], "0")
+			if len(aNumber) != len(bNumber) {
+				return len(aNumber) - len(bNumber)
+			}
+			if result := strings.Compare(aNumber, bNumber); result != 0 {
+				return result
+			}
+			a, b = a[aDigits:], b[bDigits:]
+			continue
+		}
+		if a[0] != b[0] {
+			return int(a[0]) - int(b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+func fieldPath(tableName string, index int, field string) string {
+	return fmt.Sprintf("$.G2_CONFIG.%s[%d].%s", tableName, index, field)
+}
+
+func jsonFields(aType reflect.Type) map[string]reflect.Type {
+	result := map[string]reflect.Type{}
+	for i := 0; i < aType.NumField(); i++ {
+		field := aType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if len(name) > 0 && name != "-" {
+			result[name] = field.Type
+		}
+	}
+	return result
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+func leadingDigits(value string) int {
+	result := 0
+	for result < len(value) && value[result] >= '0' && value[result] <= '9' {
+		result++
+	}
+	return result
+}
+
+// Numeric references less than 1 and empty codes mean "not used".
+func referenceKey(value any) (string, bool) {
+	switch typedValue := value.(type) {
+	case float64:
+		return fmt.Sprint(typedValue), typedValue >= 1
+	case string:
+		return strings.ToUpper(typedValue), len(typedValue) > 0
+	default:
+		return "", false
+	}
+}
+
+func rows(g2Config map[string]any, tableName string) []map[string]any {
+	result := []map[string]any{}
+	array, _ := g2Config[tableName].([]any)
+	for _, element := range array {
+		row, _ := element.(map[string]any)
+		result = append(result, row)
+	}
+	return result
+}
+
+func sortedKeys[V any](object map[string]V) []string {
+	result := make([]string, 0, len(object))
+	for key := range object {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,222 @@
+package configlint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	productVersion = `{"PRODUCT_NAME":"Senzing API","VERSION":"4.0.0","BUILD_VERSION":"4.0.0.24162","BUILD_DATE":"2024-06-10","BUILD_NUMBER":"2024_06_10__14_29","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"},"SCHEMA_VERSION":{"ENGINE_SCHEMA_VERSION":"4.0","MINIMUM_REQUIRED_SCHEMA_VERSION":"4.0","MAXIMUM_REQUIRED_SCHEMA_VERSION":"4.99"}}`
+	validConfig    = `{"G2_CONFIG":{
+		"CFG_ATTR":[{"ATTR_ID":1001,"ATTR_CODE":"NAME_FULL","ATTR_CLASS":"NAME","FTYPE_CODE":"NAME","FELEM_CODE":"FULL_NAME","FELEM_REQ":"Any","DEFAULT_VALUE":null,"ADVANCED":"No","INTERNAL":"No"}],
+		"CFG_CFBOM":[],
+		"CFG_CFCALL":[],
+		"CFG_CFRTN":[{"CFRTN_ID":1,"CFUNC_ID":1,"FTYPE_ID":0,"CFUNC_RTNVAL":"FULL_SCORE","EXEC_ORDER":1,"SAME_SCORE":100,"CLOSE_SCORE":90,"LIKELY_SCORE":80,"PLAUSIBLE_SCORE":70,"UN_LIKELY_SCORE":60}],
+		"CFG_CFUNC":[{"CFUNC_ID":1,"CFUNC_CODE":"GNR_COMP","CFUNC_DESC":"Generic name comparison","CONNECT_STR":"g2GenericNameComp","ANON_SUPPORT":"Yes","LANGUAGE":null,"JAVA_CLASS_NAME":null,"FUNC_LIB":"g2GenericNameComp","FUNC_VER":"1"}],
+		"CFG_DFBOM":[],
+		"CFG_DFCALL":[],
+		"CFG_DFUNC":[],
+		"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST","DSRC_DESC":"Test","DSRC_RELY":1,"RETENTION_LEVEL":"Remember","CONVERSATIONAL":"No"},{"DSRC_ID":2,"DSRC_CODE":"SEARCH","DSRC_DESC":"Search","DSRC_RELY":1,"RETENTION_LEVEL":"Forget","CONVERSATIONAL":"No"}],
+		"CFG_DSRC_INTEREST":[],
+		"CFG_EBOM":[],
+		"CFG_ECLASS":[],
+		"CFG_EFBOM":[],
+		"CFG_EFCALL":[],
+		"CFG_EFUNC":[],
+		"CFG_ERFRAG":[],
+		"CFG_ERRULE":[],
+		"CFG_ETYPE":[],
+		"CFG_FBOM":[{"FTYPE_ID":1,"FELEM_ID":2,"EXEC_ORDER":1,"DISPLAY_LEVEL":1,"DISPLAY_DELIM":null,"DERIVED":"No"}],
+		"CFG_FBOVR":[],
+		"CFG_FCLASS":[{"FCLASS_ID":1,"FCLASS_CODE":"NAME","FCLASS_DESC":"Name"}],
+		"CFG_FELEM":[{"FELEM_ID":2,"FELEM_CODE":"FULL_NAME","FELEM_DESC":"Full name","TOKENIZE":"No","DATA_TYPE":"string"}],
+		"CFG_FTYPE":[{"FTYPE_ID":1,"FTYPE_CODE":"NAME","FTYPE_DESC":"Name","FCLASS_ID":1,"FTYPE_FREQ":"NAME","FTYPE_EXCL":"No","FTYPE_STAB":"No","PERSIST_HISTORY":"Yes","USED_FOR_CAND":"No","DERIVED":"No","RTYPE_ID":0,"ANONYMIZE":"No","VERSION":1,"SHOW_IN_MATCH_KEY":"Yes","DERIVATION":null}],
+		"CFG_GENERIC_THRESHOLD":[{"GPLAN_ID":1,"BEHAVIOR":"NAME","FTYPE_ID":0,"CANDIDATE_CAP":10,"SCORING_CAP":-1,"SEND_TO_REDO":"Yes"}],
+		"CFG_GPLAN":[{"GPLAN_ID":1,"GPLAN_CODE":"INGEST","GPLAN_DESC":"Ingestion"}],
+		"CFG_LENS":[],
+		"CFG_LENSRL":[],
+		"CFG_RCLASS":[],
+		"CFG_RTYPE":[],
+		"CFG_SFCALL":[],
+		"CFG_SFUNC":[],
+		"CONFIG_BASE_VERSION":{"PRODUCT_NAME":"Senzing","VERSION":"4.0.0","BUILD_VERSION":"4.0.0.00000","BUILD_DATE":"2024-01-01","BUILD_NUMBER":"00000","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"}},
+		"SYS_OOM":[]
+	}}`
+)
+
+// Configurations other than validConfig are partial, so findings for their missing fields are ignored unless expected.
+var testCases = []struct {
+	configDefinition string
+	expectedPaths    []string
+	expectedRules    []string
+	hasErrors        bool
+	name             string
+	productVersion   string
+}{
+	{
+		name:             "configlint-valid",
+		configDefinition: validConfig,
+		productVersion:   productVersion,
+	},
+	{
+		name:             "configlint-invalid-json",
+		configDefinition: `{"G2_CONFIG":`,
+		expectedPaths:    []string{"$"},
+		expectedRules:    []string{RuleInvalidJSON},
+		hasErrors:        true,
+	},
+	{
+		name:             "configlint-missing-g2-config",
+		configDefinition: `{}`,
+		expectedPaths:    []string{"$.G2_CONFIG"},
+		expectedRules:    []string{RuleMissingField},
+		hasErrors:        true,
+	},
+	{
+		name:             "configlint-schema",
+		configDefinition: `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":"1","DSRC_CODE":"TEST","DSRC_COLOR":"blue"}]}}`,
+		expectedPaths:    []string{"$.G2_CONFIG.CFG_DSRC[0].DSRC_COLOR", "$.G2_CONFIG.CFG_DSRC[0].DSRC_ID"},
+		expectedRules:    []string{RuleUnknownField, RuleSchema},
+		hasErrors:        true,
+	},
+	{
+		name:             "configlint-duplicates",
+		configDefinition: `{"G2_CONFIG":{"CFG_DSRC":[{"DSRC_ID":1,"DSRC_CODE":"TEST"},{"DSRC_ID":1,"DSRC_CODE":"test"}]}}`,
+		expectedPaths:    []string{"$.G2_CONFIG.CFG_DSRC[1].DSRC_ID", "$.G2_CONFIG.CFG_DSRC[1].DSRC_CODE"},
+		expectedRules:    []string{RuleDuplicateID, RuleDuplicateCode},
+		hasErrors:        true,
+	},
+	{
+		name:             "configlint-dangling-reference",
+		configDefinition: `{"G2_CONFIG":{"CFG_ATTR":[{"ATTR_ID":1,"ATTR_CODE":"NAME_FULL","FTYPE_CODE":"NAME","FELEM_CODE":""}]}}`,
+		expectedPaths:    []string{"$.G2_CONFIG.CFG_ATTR[0].FTYPE_CODE"},
+		expectedRules:    []string{RuleDanglingReference},
+		hasErrors:        true,
+	},
+	{
+		name:             "configlint-thresholds",
+		configDefinition: `{"G2_CONFIG":{"CFG_CFRTN":[{"CFRTN_ID":1,"SAME_SCORE":100,"CLOSE_SCORE":101,"LIKELY_SCORE":95}],"CFG_GENERIC_THRESHOLD":[{"CANDIDATE_CAP":0,"SCORING_CAP":-1},{"CANDIDATE_CAP":10,"SCORING_CAP":5}]}}`,
+		expectedPaths:    []string{"$.G2_CONFIG.CFG_CFRTN[0].CLOSE_SCORE", "$.G2_CONFIG.CFG_GENERIC_THRESHOLD[0].CANDIDATE_CAP", "$.G2_CONFIG.CFG_GENERIC_THRESHOLD[1].SCORING_CAP"},
+		expectedRules:    []string{RuleSuspiciousThreshold, RuleSuspiciousThreshold, RuleSuspiciousThreshold},
+	},
+	{
+		name:             "configlint-compatibility",
+		configDefinition: `{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"}}}}`,
+		productVersion:   productVersion,
+		expectedPaths:    []string{"$.G2_CONFIG.CONFIG_BASE_VERSION.COMPATIBILITY_VERSION.CONFIG_VERSION"},
+		expectedRules:    []string{RuleCompatibilityVersion},
+		hasErrors:        true,
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestConfiglint_Lint(test *testing.T) {
+	ctx := context.TODO()
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			findings, err := Lint(ctx, testCase.configDefinition, testCase.productVersion)
+			require.NoError(test, err)
+			if !slices.Contains(testCase.expectedRules, RuleMissingField) {
+				findings = slices.DeleteFunc(findings, func(finding Finding) bool { return finding.Rule == RuleMissingField })
+			}
+			actualPaths := []string{}
+			actualRules := []string{}
+			for _, finding := range findings {
+				actualPaths = append(actualPaths, finding.Path)
+				actualRules = append(actualRules, finding.Rule)
+			}
+			assert.ElementsMatch(test, testCase.expectedPaths, actualPaths, "%v", findings)
+			assert.ElementsMatch(test, testCase.expectedRules, actualRules, "%v", findings)
+			assert.Equal(test, testCase.hasErrors, HasErrors(findings))
+		})
+	}
+}
+
+func TestConfiglint_Lint_badProductVersion(test *testing.T) {
+	ctx := context.TODO()
+	_, err := Lint(ctx, validConfig, "not JSON")
+	require.Error(test, err)
+}
+
+func TestConfiglint_Lint_missingCompatibilityVersion(test *testing.T) {
+	ctx := context.TODO()
+	configDefinition := strings.Replace(validConfig, `"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"}`, `"COMPATIBILITY_VERSION":{}`, 1)
+	findings, err := Lint(ctx, configDefinition, productVersion)
+	require.NoError(test, err)
+	require.Len(test, findings, 1)
+	assert.Equal(test, RuleMissingField, findings[0].Rule)
+	assert.Equal(test, SeverityError, findings[0].Severity)
+	assert.Equal(test, "$.G2_CONFIG.CONFIG_BASE_VERSION.COMPATIBILITY_VERSION.CONFIG_VERSION", findings[0].Path)
+}
+
+func TestConfiglint_Lint_missingFields(test *testing.T) {
+	ctx := context.TODO()
+	configDefinition := strings.Replace(validConfig, `"CFG_LENS":[],`, "", 1)
+	configDefinition = strings.Replace(configDefinition, `"DSRC_ID":2,"DSRC_CODE":"SEARCH","DSRC_DESC":"Search",`, `"DSRC_ID":2,"DSRC_CODE":"SEARCH",`, 1)
+	findings, err := Lint(ctx, configDefinition, "")
+	require.NoError(test, err)
+	assert.Equal(test, []Finding{
+		{Severity: SeverityError, Path: "$.G2_CONFIG.CFG_DSRC[1].DSRC_DESC", Rule: RuleMissingField, Message: "DSRC_DESC is required"},
+		{Severity: SeverityError, Path: "$.G2_CONFIG.CFG_LENS", Rule: RuleMissingField, Message: "CFG_LENS is required"},
+	}, findings)
+}
+
+func TestConfiglint_Lint_order(test *testing.T) {
+	ctx := context.TODO()
+	rows := []string{}
+	for index := 0; index < 11; index++ {
+		rows = append(rows, fmt.Sprintf(`{"DSRC_ID":%d,"DSRC_CODE":"TEST"}`, index%10+1))
+	}
+	configDefinition := `{"G2_CONFIG":{"CFG_DSRC":[` + strings.Join(rows, ",") + `],"CFG_ATTR":[{"ATTR_ID":"1"}]}}`
+	findings, err := Lint(ctx, configDefinition, "")
+	require.NoError(test, err)
+	findings = slices.DeleteFunc(findings, func(finding Finding) bool { return finding.Rule == RuleMissingField })
+	actualPaths := []string{}
+	for _, finding := range findings {
+		actualPaths = append(actualPaths, finding.Path)
+	}
+	expectedPaths := []string{"$.G2_CONFIG.CFG_ATTR[0].ATTR_ID"}
+	for index := 1; index < 11; index++ {
+		expectedPaths = append(expectedPaths, fmt.Sprintf("$.G2_CONFIG.CFG_DSRC[%d].DSRC_CODE", index))
+	}
+	expectedPaths = append(expectedPaths, "$.G2_CONFIG.CFG_DSRC[10].DSRC_ID")
+	assert.Equal(test, expectedPaths, actualPaths)
+}
+
+func TestConfiglint_Lint_orderWithoutG2Config(test *testing.T) {
+	ctx := context.TODO()
+	findings, err := Lint(ctx, `{"A_FIELD":1}`, "")
+	require.NoError(test, err)
+	require.Len(test, findings, 2)
+	assert.Equal(test, "$.A_FIELD", findings[0].Path)
+	assert.Equal(test, "$.G2_CONFIG", findings[1].Path)
+}
+
+func TestConfiglint_checkSchema_unhandledKind(test *testing.T) {
+	findings := checkSchema("$.FLAG", true, reflect.TypeOf(true))
+	require.Len(test, findings, 1)
+	assert.Equal(test, RuleSchema, findings[0].Rule)
+	assert.Equal(test, SeverityError, findings[0].Severity)
+}
+
+func TestConfiglint_Finding_JSON(test *testing.T) {
+	finding := Finding{Severity: SeverityWarning, Path: "$", Rule: RuleSchema, Message: "message"}
+	actual, err := json.Marshal(finding)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"severity":"warning","path":"$","rule":"schema","message":"message"}`, string(actual))
+	parsed := Finding{}
+	require.NoError(test, json.Unmarshal(actual, &parsed))
+	assert.Equal(test, finding, parsed)
+	assert.Equal(test, "warning: $: message [schema]", finding.String())
+}
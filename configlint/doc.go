@@ -0,0 +1,4 @@
+/*
+The configlint package checks a Senzing configuration definition offline, before it is given to SzConfigManager.AddConfig.
+*/
+package configlint
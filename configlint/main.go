@@ -0,0 +1,134 @@
+package configlint
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Severity describes how serious a Finding is.
+type Severity int
+
+// A Finding is a single problem detected in a Senzing configuration definition.
+type Finding struct {
+	Message  string   `json:"message"`
+	Path     string   `json:"path"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+}
+
+// A table describes the identifier and code columns of a CFG_xxx table.
+type table struct {
+	codeField string
+	idField   string
+	name      string
+}
+
+// A reference describes a column that must refer to an existing row of another table.
+type reference struct {
+	field       string
+	name        string
+	targetField string
+	targetTable string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+// Rule identifiers reported in Finding.Rule.
+const (
+	RuleCompatibilityVersion = "compatibility-version"
+	RuleDanglingReference    = "dangling-reference"
+	RuleDuplicateCode        = "duplicate-code"
+	RuleDuplicateID          = "duplicate-id"
+	RuleInvalidJSON          = "invalid-json"
+	RuleMissingField         = "missing-field"
+	RuleSchema               = "schema"
+	RuleSuspiciousThreshold  = "suspicious-threshold"
+	RuleUnknownField         = "unknown-field"
+)
+
+// The largest score Senzing comparison functions return.
+const maximumScore = 100
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var severityNames = map[Severity]string{
+	SeverityError:   "error",
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+}
+
+// Tables whose identifiers and codes must be unique.
+var uniqueTables = []table{
+	{name: "CFG_ATTR", idField: "ATTR_ID", codeField: "ATTR_CODE"},
+	{name: "CFG_CFCALL", idField: "CFCALL_ID"},
+	{name: "CFG_CFRTN", idField: "CFRTN_ID"},
+	{name: "CFG_CFUNC", idField: "CFUNC_ID", codeField: "CFUNC_CODE"},
+	{name: "CFG_DFCALL", idField: "DFCALL_ID"},
+	{name: "CFG_DFUNC", idField: "DFUNC_ID", codeField: "DFUNC_CODE"},
+	{name: "CFG_DSRC", idField: "DSRC_ID", codeField: "DSRC_CODE"},
+	{name: "CFG_ECLASS", idField: "ECLASS_ID", codeField: "ECLASS_CODE"},
+	{name: "CFG_EFCALL", idField: "EFCALL_ID"},
+	{name: "CFG_EFUNC", idField: "EFUNC_ID", codeField: "EFUNC_CODE"},
+	{name: "CFG_ERFRAG", idField: "ERFRAG_ID", codeField: "ERFRAG_CODE"},
+	{name: "CFG_ERRULE", idField: "ERRULE_ID", codeField: "ERRULE_CODE"},
+	{name: "CFG_ETYPE", idField: "ETYPE_ID", codeField: "ETYPE_CODE"},
+	{name: "CFG_FCLASS", idField: "FCLASS_ID", codeField: "FCLASS_CODE"},
+	{name: "CFG_FELEM", idField: "FELEM_ID", codeField: "FELEM_CODE"},
+	{name: "CFG_FTYPE", idField: "FTYPE_ID", codeField: "FTYPE_CODE"},
+	{name: "CFG_GPLAN", idField: "GPLAN_ID", codeField: "GPLAN_CODE"},
+	{name: "CFG_LENS", idField: "LENS_ID", codeField: "LENS_CODE"},
+	{name: "CFG_RCLASS", idField: "RCLASS_ID", codeField: "RCLASS_CODE"},
+	{name: "CFG_RTYPE", idField: "RTYPE_ID", codeField: "RTYPE_CODE"},
+	{name: "CFG_SFCALL", idField: "SFCALL_ID"},
+	{name: "CFG_SFUNC", idField: "SFUNC_ID", codeField: "SFUNC_CODE"},
+}
+
+// Columns that must refer to existing rows.
+// Numeric references less than 1 mean "not used" in Senzing configurations and are not checked.
+var references = []reference{
+	{name: "CFG_ATTR", field: "FELEM_CODE", targetTable: "CFG_FELEM", targetField: "FELEM_CODE"},
+	{name: "CFG_ATTR", field: "FTYPE_CODE", targetTable: "CFG_FTYPE", targetField: "FTYPE_CODE"},
+	{name: "CFG_CFBOM", field: "CFCALL_ID", targetTable: "CFG_CFCALL", targetField: "CFCALL_ID"},
+	{name: "CFG_CFBOM", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_CFBOM", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_CFCALL", field: "CFUNC_ID", targetTable: "CFG_CFUNC", targetField: "CFUNC_ID"},
+	{name: "CFG_CFCALL", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_CFRTN", field: "CFUNC_ID", targetTable: "CFG_CFUNC", targetField: "CFUNC_ID"},
+	{name: "CFG_CFRTN", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_DFBOM", field: "DFCALL_ID", targetTable: "CFG_DFCALL", targetField: "DFCALL_ID"},
+	{name: "CFG_DFBOM", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_DFBOM", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_DFCALL", field: "DFUNC_ID", targetTable: "CFG_DFUNC", targetField: "DFUNC_ID"},
+	{name: "CFG_DFCALL", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_DSRC_INTEREST", field: "DSRC_ID", targetTable: "CFG_DSRC", targetField: "DSRC_ID"},
+	{name: "CFG_EFBOM", field: "EFCALL_ID", targetTable: "CFG_EFCALL", targetField: "EFCALL_ID"},
+	{name: "CFG_EFBOM", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_EFBOM", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_EFCALL", field: "EFUNC_ID", targetTable: "CFG_EFUNC", targetField: "EFUNC_ID"},
+	{name: "CFG_EFCALL", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_EFCALL", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_ERRULE", field: "DISQ_ERFRAG_CODE", targetTable: "CFG_ERFRAG", targetField: "ERFRAG_CODE"},
+	{name: "CFG_ERRULE", field: "QUAL_ERFRAG_CODE", targetTable: "CFG_ERFRAG", targetField: "ERFRAG_CODE"},
+	{name: "CFG_ERRULE", field: "RTYPE_ID", targetTable: "CFG_RTYPE", targetField: "RTYPE_ID"},
+	{name: "CFG_ETYPE", field: "ECLASS_ID", targetTable: "CFG_ECLASS", targetField: "ECLASS_ID"},
+	{name: "CFG_FBOM", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_FBOM", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_FBOVR", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_FTYPE", field: "FCLASS_ID", targetTable: "CFG_FCLASS", targetField: "FCLASS_ID"},
+	{name: "CFG_FTYPE", field: "RTYPE_ID", targetTable: "CFG_RTYPE", targetField: "RTYPE_ID"},
+	{name: "CFG_GENERIC_THRESHOLD", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_GENERIC_THRESHOLD", field: "GPLAN_ID", targetTable: "CFG_GPLAN", targetField: "GPLAN_ID"},
+	{name: "CFG_RTYPE", field: "RCLASS_ID", targetTable: "CFG_RCLASS", targetField: "RCLASS_ID"},
+	{name: "CFG_SFCALL", field: "FELEM_ID", targetTable: "CFG_FELEM", targetField: "FELEM_ID"},
+	{name: "CFG_SFCALL", field: "FTYPE_ID", targetTable: "CFG_FTYPE", targetField: "FTYPE_ID"},
+	{name: "CFG_SFCALL", field: "SFUNC_ID", targetTable: "CFG_SFUNC", targetField: "SFUNC_ID"},
+}
@@ -0,0 +1,4 @@
+/*
+The enginestats package interprets the workload statistics returned by SzEngine.GetStats.
+*/
+package enginestats
@@ -0,0 +1,307 @@
+package enginestats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - AnomalyType
+// ----------------------------------------------------------------------------
+
+// The String method returns the name of the anomaly type.
+func (anomalyType AnomalyType) String() string {
+	result, ok := anomalyTypeNames[anomalyType]
+	if !ok {
+		result = fmt.Sprintf("AnomalyType(%d)", int(anomalyType))
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Sample
+// ----------------------------------------------------------------------------
+
+/*
+The CacheHitRatio method returns the fraction of feature cache lookups that were hits during the interval.
+If there were no lookups, 1 is returned.
+*/
+func (sample Sample) CacheHitRatio() float64 {
+	hits := sample.Deltas[CounterLibFeatCacheHit] + sample.Deltas[CounterResFeatStatCacheHit]
+	misses := sample.Deltas[CounterLibFeatCacheMiss] + sample.Deltas[CounterResFeatStatCacheMiss]
+	if hits+misses == 0 {
+		return 1
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+/*
+The Rate method returns the per-second rate of a counter during the interval.
+
+Input
+  - counter: The dotted JSON path of the counter (e.g. CounterAddedRecords).
+*/
+func (sample Sample) Rate(counter string) float64 {
+	if sample.Interval <= 0 {
+		return 0
+	}
+	return float64(sample.Deltas[counter]) / sample.Interval.Seconds()
+}
+
+// The RecordsAddedPerSecond method returns the rate of records added during the interval.
+func (sample Sample) RecordsAddedPerSecond() float64 {
+	return sample.Rate(CounterAddedRecords)
+}
+
+/*
+The RetryRatio method returns the number of retries per record operation
+(adds, deletes and reevaluations) during the interval.
+*/
+func (sample Sample) RetryRatio() float64 {
+	operations := sample.Deltas[CounterAddedRecords] + sample.Deltas[CounterDeletedRecords] + sample.Deltas[CounterReevaluations]
+	if operations == 0 {
+		return 0
+	}
+	return float64(sample.Deltas[CounterRetries]) / float64(operations)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Sampler
+// ----------------------------------------------------------------------------
+
+/*
+The Sample method calls GetStats and CountRedoRecords once and compares the result with the previous sample.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - The Sample. On failure, Sample.Error is also returned as the error.
+*/
+func (sampler *Sampler) Sample(ctx context.Context) (Sample, error) {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+	result := Sample{
+		Time: time.Now(),
+	}
+	statsJSON, err := sampler.SzEngine.GetStats(ctx)
+	if err == nil {
+		result.Stats, err = Parse(ctx, statsJSON)
+	}
+	if err == nil {
+		result.RedoRecords, err = sampler.SzEngine.CountRedoRecords(ctx)
+	}
+	if err != nil {
+		result.Error = err
+		return result, err
+	}
+
+	var previousStats *Stats
+	if sampler.previous != nil {
+		previousStats = sampler.previous.Stats
+		result.Interval = result.Time.Sub(sampler.previous.Time)
+		if result.RedoRecords > sampler.previous.RedoRecords {
+			sampler.redoGrowthCount++
+		} else {
+			sampler.redoGrowthCount = 0
+		}
+	}
+	result.Deltas = Deltas(previousStats, result.Stats, sampler.Cumulative)
+	result.Anomalies = sampler.detectAnomalies(result)
+	sampler.previous = &result
+	return result, nil
+}
+
+/*
+The Start method samples every Interval until ctx is cancelled.
+Each Sample is passed to Callback, if set, and sent on the returned channel.
+Without a Callback, sampling waits for each Sample to be received. With a Callback, the channel need not be read:
+it buffers the latest Sample, replacing any Sample not yet received.
+A Sample whose GetStats or CountRedoRecords call failed has its Error set.
+The channel is closed when ctx is cancelled.
+
+Input
+  - ctx: A context to control lifecycle.
+
+Output
+  - A channel of samples.
+*/
+func (sampler *Sampler) Start(ctx context.Context) chan Sample {
+	result := make(chan Sample, 1)
+	interval := sampler.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	go func() {
+		defer close(result)
+		if !sampler.Cumulative && !sampler.started() {
+			// Establish the start of the first interval.
+			_, _ = sampler.Sample(ctx)
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sample, _ := sampler.Sample(ctx)
+				if sampler.Callback != nil {
+					sampler.Callback(ctx, sample)
+					// Only this goroutine sends, so once the stale Sample is drained there is room.
+					select {
+					case <-result:
+					default:
+					}
+					result <- sample
+					continue
+				}
+				select {
+				case result <- sample:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return result
+}
+
+// Whether a Sample has been taken, establishing the start of the next interval.
+func (sampler *Sampler) started() bool {
+	sampler.mutex.Lock()
+	defer sampler.mutex.Unlock()
+	return sampler.previous != nil
+}
+
+func (sampler *Sampler) detectAnomalies(sample Sample) []Anomaly {
+	result := []Anomaly{}
+	if sample.Interval <= 0 {
+		return result
+	}
+	redoGrowthSamples := sampler.RedoGrowthSamples
+	if redoGrowthSamples <= 0 {
+		redoGrowthSamples = DefaultRedoGrowthSamples
+	}
+	maxRetryRatio := sampler.MaxRetryRatio
+	if maxRetryRatio <= 0 {
+		maxRetryRatio = DefaultMaxRetryRatio
+	}
+	minCacheHitRatio := sampler.MinCacheHitRatio
+	if minCacheHitRatio <= 0 {
+		minCacheHitRatio = DefaultMinCacheHitRatio
+	}
+
+	if sampler.redoGrowthCount >= redoGrowthSamples {
+		result = append(result, Anomaly{
+			Type:    AnomalyRedoBacklogGrowing,
+			Value:   float64(sample.RedoRecords),
+			Message: fmt.Sprintf("redo backlog has grown for %d samples to %d records", sampler.redoGrowthCount, sample.RedoRecords),
+		})
+	}
+	if retryRatio := sample.RetryRatio(); retryRatio > maxRetryRatio {
+		result = append(result, Anomaly{
+			Type:    AnomalyHighRetryRatio,
+			Value:   retryRatio,
+			Message: fmt.Sprintf("retry ratio %.3f exceeds %.3f", retryRatio, maxRetryRatio),
+		})
+	}
+	if cacheHitRatio := sample.CacheHitRatio(); cacheHitRatio < minCacheHitRatio {
+		result = append(result, Anomaly{
+			Type:    AnomalyLowCacheHitRatio,
+			Value:   cacheHitRatio,
+			Message: fmt.Sprintf("cache hit ratio %.3f is below %.3f", cacheHitRatio, minCacheHitRatio),
+		})
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Deltas function returns the change of each counter between two GetStats results.
+Gauges, such as "threadState.active", are not included.
+
+Input
+  - previous: The earlier statistics, or nil if there are none.
+  - current: The later statistics.
+  - cumulative: True if counters accumulate across GetStats calls.
+    If false, each result already holds the counts since the previous call.
+    If true, a counter that decreased is assumed to have been reset.
+*/
+func Deltas(previous *Stats, current *Stats, cumulative bool) map[string]int64 {
+	result := map[string]int64{}
+	if cumulative && previous == nil {
+		return result
+	}
+	for counter, value := range current.Counters {
+		if isGauge(counter) {
+			continue
+		}
+		if !cumulative {
+			result[counter] = value
+			continue
+		}
+		previousValue := previous.Counters[counter]
+		if value < previousValue {
+			result[counter] = value
+		} else {
+			result[counter] = value - previousValue
+		}
+	}
+	return result
+}
+
+/*
+The Parse function parses the JSON returned by SzEngine.GetStats.
+
+Input
+  - ctx: A context to control lifecycle.
+  - jsonString: The JSON returned by SzEngine.GetStats().
+*/
+func Parse(ctx context.Context, jsonString string) (*Stats, error) {
+	_ = ctx
+	result := &Stats{
+		Counters: map[string]int64{},
+	}
+	if err := json.Unmarshal([]byte(jsonString), result); err != nil {
+		return result, err
+	}
+	document := struct {
+		Workload map[string]any `json:"workload"`
+	}{}
+	if err := json.Unmarshal([]byte(jsonString), &document); err != nil {
+		return result, err
+	}
+	flatten("", document.Workload, result.Counters)
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func flatten(prefix string, object map[string]any, counters map[string]int64) {
+	for key, value := range object {
+		switch typedValue := value.(type) {
+		case float64:
+			counters[prefix+key] = int64(typedValue)
+		case map[string]any:
+			flatten(prefix+key+".", typedValue, counters)
+		}
+	}
+}
+
+func isGauge(counter string) bool {
+	for _, prefix := range gaugePrefixes {
+		if strings.HasPrefix(counter, prefix) {
+			return true
+		}
+	}
+	return false
+}
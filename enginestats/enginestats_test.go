@@ -0,0 +1,224 @@
+package enginestats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const statsTemplate = `{"workload":{"apiVersion":"4.0.0.24162","addedRecords":%d,"deletedRecords":0,"reevaluations":0,"retries":%d,"libFeatCacheHit":%d,"libFeatCacheMiss":%d,"lockWaits":{"refreshLocks":{"count":2,"maxMS":5,"totalMS":7}},"threadState":{"active":3,"idle":5},"cacheHit":[]}}`
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	redoRecords []int64
+	stats       []string
+	calls       int
+}
+
+func (engine *mockSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	_ = ctx
+	return engine.redoRecords[(engine.calls-1)%len(engine.redoRecords)], nil
+}
+
+func (engine *mockSzEngine) GetStats(ctx context.Context) (string, error) {
+	_ = ctx
+	engine.calls++
+	if len(engine.stats) == 0 {
+		return "", errors.New("no stats")
+	}
+	return engine.stats[(engine.calls-1)%len(engine.stats)], nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestEnginestats_Parse(test *testing.T) {
+	ctx := context.TODO()
+	actual, err := Parse(ctx, fmt.Sprintf(statsTemplate, 10, 1, 90, 10))
+	require.NoError(test, err)
+	assert.Equal(test, int64(10), actual.Workload.AddedRecords)
+	assert.Equal(test, int64(3), actual.Workload.ThreadState.Active)
+	assert.Equal(test, int64(7), actual.Workload.LockWaits["refreshLocks"].TotalMS)
+	assert.Equal(test, int64(7), actual.Counters["lockWaits.refreshLocks.totalMS"])
+	assert.Equal(test, int64(5), actual.Counters["threadState.idle"])
+}
+
+func TestEnginestats_Parse_badJSON(test *testing.T) {
+	ctx := context.TODO()
+	_, err := Parse(ctx, "{")
+	require.Error(test, err)
+}
+
+func TestEnginestats_Deltas(test *testing.T) {
+	ctx := context.TODO()
+	previous, err := Parse(ctx, fmt.Sprintf(statsTemplate, 10, 1, 90, 10))
+	require.NoError(test, err)
+	current, err := Parse(ctx, fmt.Sprintf(statsTemplate, 25, 0, 100, 20))
+	require.NoError(test, err)
+
+	resetting := Deltas(previous, current, false)
+	assert.Equal(test, int64(25), resetting[CounterAddedRecords])
+	assert.NotContains(test, resetting, "threadState.active")
+
+	cumulative := Deltas(previous, current, true)
+	assert.Equal(test, int64(15), cumulative[CounterAddedRecords])
+	assert.Equal(test, int64(0), cumulative[CounterRetries], "a decreased counter is treated as reset")
+	assert.Equal(test, int64(10), cumulative[CounterLibFeatCacheMiss])
+
+	assert.Empty(test, Deltas(nil, current, true))
+}
+
+func TestEnginestats_Sampler_Sample(test *testing.T) {
+	ctx := context.TODO()
+	engine := &mockSzEngine{
+		stats: []string{
+			fmt.Sprintf(statsTemplate, 100, 0, 90, 10),
+			fmt.Sprintf(statsTemplate, 100, 50, 10, 90),
+		},
+		redoRecords: []int64{1, 2, 3, 4},
+	}
+	sampler := &Sampler{
+		SzEngine:          engine,
+		RedoGrowthSamples: 2,
+	}
+
+	first, err := sampler.Sample(ctx)
+	require.NoError(test, err)
+	assert.Zero(test, first.Interval)
+	assert.Empty(test, first.Anomalies)
+
+	time.Sleep(10 * time.Millisecond)
+	second, err := sampler.Sample(ctx)
+	require.NoError(test, err)
+	assert.Positive(test, second.Interval)
+	assert.Positive(test, second.RecordsAddedPerSecond())
+	assert.InDelta(test, 0.5, second.RetryRatio(), 0.0001)
+	assert.InDelta(test, 0.1, second.CacheHitRatio(), 0.0001)
+	anomalyTypes := []AnomalyType{}
+	for _, anomaly := range second.Anomalies {
+		anomalyTypes = append(anomalyTypes, anomaly.Type)
+	}
+	assert.ElementsMatch(test, []AnomalyType{AnomalyHighRetryRatio, AnomalyLowCacheHitRatio}, anomalyTypes)
+
+	third, err := sampler.Sample(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, AnomalyRedoBacklogGrowing, third.Anomalies[0].Type)
+	assert.Equal(test, "RedoBacklogGrowing", third.Anomalies[0].Type.String())
+}
+
+func TestEnginestats_Sampler_Sample_error(test *testing.T) {
+	ctx := context.TODO()
+	sampler := &Sampler{SzEngine: &mockSzEngine{}}
+	sample, err := sampler.Sample(ctx)
+	require.Error(test, err)
+	assert.Equal(test, err, sample.Error)
+}
+
+func TestEnginestats_Sampler_Start(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	engine := &mockSzEngine{
+		stats:       []string{fmt.Sprintf(statsTemplate, 10, 0, 1, 0)},
+		redoRecords: []int64{0},
+	}
+	callbacks := make(chan Sample, 10)
+	sampler := &Sampler{
+		SzEngine: engine,
+		Interval: 5 * time.Millisecond,
+		Callback: func(ctx context.Context, sample Sample) {
+			_ = ctx
+			callbacks <- sample
+		},
+	}
+	samples := sampler.Start(ctx)
+	sample := <-samples
+	require.NoError(test, sample.Error)
+	assert.Equal(test, int64(10), sample.Deltas[CounterAddedRecords])
+	assert.Positive(test, sample.Interval)
+	<-callbacks
+	cancel()
+	for range samples {
+	}
+}
+
+func TestEnginestats_Sampler_Start_callbackOnly(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	engine := &mockSzEngine{}
+	callbacks := make(chan Sample, 10)
+	sampler := &Sampler{
+		SzEngine: engine,
+		Interval: time.Millisecond,
+		Callback: func(ctx context.Context, sample Sample) {
+			_ = ctx
+			callbacks <- sample
+		},
+	}
+	_ = sampler.Start(ctx)
+	for index := 0; index < 3; index++ {
+		select {
+		case sample := <-callbacks:
+			require.Error(test, sample.Error)
+		case <-time.After(time.Second):
+			require.Fail(test, "sampling stopped while the channel was not read")
+		}
+	}
+}
+
+func TestEnginestats_Sampler_Start_callbackLatest(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	engine := &mockSzEngine{stats: []string{fmt.Sprintf(statsTemplate, 10, 0, 90, 10)}, redoRecords: []int64{0}}
+	var mutex sync.Mutex
+	callbacks := []Sample{}
+	sampler := &Sampler{
+		SzEngine:   engine,
+		Cumulative: true,
+		Interval:   time.Millisecond,
+		Callback: func(ctx context.Context, sample Sample) {
+			_ = ctx
+			mutex.Lock()
+			defer mutex.Unlock()
+			callbacks = append(callbacks, sample)
+			if len(callbacks) == 3 {
+				cancel()
+			}
+		},
+	}
+	var last Sample
+	for sample := range sampler.Start(ctx) {
+		last = sample
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.GreaterOrEqual(test, len(callbacks), 3)
+	assert.Equal(test, callbacks[len(callbacks)-1].Time, last.Time, "the channel holds the latest Sample")
+}
+
+func TestEnginestats_Sampler_concurrent(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	engine := &mockSzEngine{stats: []string{fmt.Sprintf(statsTemplate, 10, 0, 90, 10)}, redoRecords: []int64{0}}
+	sampler := &Sampler{
+		SzEngine: engine,
+		Interval: time.Millisecond,
+		Callback: func(ctx context.Context, sample Sample) { _, _ = ctx, sample },
+	}
+	first, second := sampler.Start(ctx), sampler.Start(ctx)
+	for index := 0; index < 10; index++ {
+		_, err := sampler.Sample(ctx)
+		require.NoError(test, err)
+	}
+	cancel()
+	for range first {
+	}
+	for range second {
+	}
+}
@@ -0,0 +1,167 @@
+package enginestats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// AnomalyType identifies the kind of Anomaly detected in a Sample.
+type AnomalyType int
+
+// An Anomaly is a condition in a Sample that deserves attention.
+type Anomaly struct {
+	Message string
+	Type    AnomalyType
+	Value   float64
+}
+
+// LockWait is one entry of the "lockWaits" section of the workload statistics.
+type LockWait struct {
+	Count   int64 `json:"count"`
+	MaxMS   int64 `json:"maxMS"`
+	TotalMS int64 `json:"totalMS"`
+}
+
+// ThreadState is the "threadState" section of the workload statistics.
+type ThreadState struct {
+	Active              int64 `json:"active"`
+	DataLatchContention int64 `json:"dataLatchContention"`
+	GovernorContention  int64 `json:"governorContention"`
+	Idle                int64 `json:"idle"`
+	Loader              int64 `json:"loader"`
+	ObsEntContention    int64 `json:"obsEntContention"`
+	ResEntContention    int64 `json:"resEntContention"`
+	Resolver            int64 `json:"resolver"`
+	Scoring             int64 `json:"scoring"`
+	SQLExecuting        int64 `json:"sqlExecuting"`
+}
+
+// Workload is the "workload" section of the statistics returned by SzEngine.GetStats.
+type Workload struct {
+	AbortedUnresolve         int64               `json:"abortedUnresolve"`
+	ActualAmbiguousTest      int64               `json:"actualAmbiguousTest"`
+	AddedRecords             int64               `json:"addedRecords"`
+	APIVersion               string              `json:"apiVersion"`
+	BulkAddedRecords         int64               `json:"bulkAddedRecords"`
+	CachedAmbiguousTest      int64               `json:"cachedAmbiguousTest"`
+	Candidates               int64               `json:"candidates"`
+	ChangeDeletes            int64               `json:"changeDeletes"`
+	DeletedRecords           int64               `json:"deletedRecords"`
+	Duration                 int64               `json:"duration"`
+	LibFeatCacheHit          int64               `json:"libFeatCacheHit"`
+	LibFeatCacheMiss         int64               `json:"libFeatCacheMiss"`
+	LibFeatInsert            int64               `json:"libFeatInsert"`
+	LoadedRecords            int64               `json:"loadedRecords"`
+	LockWaits                map[string]LockWait `json:"lockWaits"`
+	NewObsEnt                int64               `json:"newObsEnt"`
+	ObsEntHashDiff           int64               `json:"obsEntHashDiff"`
+	ObsEntHashSame           int64               `json:"obsEntHashSame"`
+	OptimizedOut             int64               `json:"optimizedOut"`
+	PartiallyResolved        int64               `json:"partiallyResolved"`
+	Reevaluations            int64               `json:"reevaluations"`
+	RepairedEntities         int64               `json:"repairedEntities"`
+	ResFeatStatCacheHit      int64               `json:"resFeatStatCacheHit"`
+	ResFeatStatCacheMiss     int64               `json:"resFeatStatCacheMiss"`
+	ResFeatStatInsert        int64               `json:"resFeatStatInsert"`
+	ResFeatStatUpdateAttempt int64               `json:"resFeatStatUpdateAttempt"`
+	ResFeatStatUpdateFail    int64               `json:"resFeatStatUpdateFail"`
+	Retries                  int64               `json:"retries"`
+	ThreadState              ThreadState         `json:"threadState"`
+	UnresolveTest            int64               `json:"unresolveTest"`
+}
+
+// Stats is the parsed result of SzEngine.GetStats.
+type Stats struct {
+	// Counters holds every numeric value in the workload section, keyed by its dotted JSON path
+	// (e.g. "addedRecords", "lockWaits.refreshLocks.count").
+	Counters map[string]int64 `json:"-"`
+	Workload Workload         `json:"workload"`
+}
+
+// A Sample is the result of one GetStats call, compared with the previous one.
+type Sample struct {
+	Anomalies   []Anomaly
+	Deltas      map[string]int64
+	Error       error
+	Interval    time.Duration
+	RedoRecords int64
+	Stats       *Stats
+	Time        time.Time
+}
+
+// A Sampler calls SzEngine.GetStats repeatedly and computes per-interval deltas and rates.
+// It is safe for concurrent use once its fields are set; concurrent calls to Sample are serialized.
+type Sampler struct {
+	// Callback, if set, is called with each Sample produced by Start().
+	Callback func(ctx context.Context, sample Sample)
+	// Cumulative is true when GetStats counters accumulate across calls.
+	// By default, counters are assumed to reset on every call, as the Senzing engine does.
+	Cumulative bool
+	// Interval between samples taken by Start(). Defaults to DefaultInterval.
+	Interval time.Duration
+	// MaxRetryRatio is the retries per record operation above which AnomalyHighRetryRatio is reported.
+	MaxRetryRatio float64
+	// MinCacheHitRatio is the cache hit ratio below which AnomalyLowCacheHitRatio is reported.
+	MinCacheHitRatio float64
+	// RedoGrowthSamples is the number of consecutive samples with a growing redo backlog
+	// needed to report AnomalyRedoBacklogGrowing.
+	RedoGrowthSamples int
+	SzEngine          senzing.SzEngine
+
+	mutex           sync.Mutex
+	previous        *Sample
+	redoGrowthCount int
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	AnomalyRedoBacklogGrowing AnomalyType = iota + 1
+	AnomalyHighRetryRatio
+	AnomalyLowCacheHitRatio
+)
+
+// Names of commonly used Counters.
+const (
+	CounterAddedRecords         = "addedRecords"
+	CounterDeletedRecords       = "deletedRecords"
+	CounterLibFeatCacheHit      = "libFeatCacheHit"
+	CounterLibFeatCacheMiss     = "libFeatCacheMiss"
+	CounterReevaluations        = "reevaluations"
+	CounterResFeatStatCacheHit  = "resFeatStatCacheHit"
+	CounterResFeatStatCacheMiss = "resFeatStatCacheMiss"
+	CounterRetries              = "retries"
+)
+
+// Default values for Sampler fields left unset.
+const (
+	DefaultInterval          = time.Minute
+	DefaultMaxRetryRatio     = 0.1
+	DefaultMinCacheHitRatio  = 0.5
+	DefaultRedoGrowthSamples = 3
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var anomalyTypeNames = map[AnomalyType]string{
+	AnomalyHighRetryRatio:     "HighRetryRatio",
+	AnomalyLowCacheHitRatio:   "LowCacheHitRatio",
+	AnomalyRedoBacklogGrowing: "RedoBacklogGrowing",
+}
+
+// Prefixes of Counters that are point-in-time gauges rather than accumulating counters.
+var gaugePrefixes = []string{
+	"threadState.",
+	"systemResources.",
+}
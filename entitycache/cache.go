@@ -0,0 +1,276 @@
+package entitycache
+
+import (
+	"container/list"
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Cache
+// ----------------------------------------------------------------------------
+
+/*
+The Invalidate method removes the given entities, any cached entity relating to them, and the record keys mapped to them.
+
+Input
+  - entityIDs: The entities that have changed.
+*/
+func (cache *Cache) Invalidate(entityIDs ...int64) {
+	cache.invalidate(entityIDs, nil)
+}
+
+/*
+The Purge method removes every entity.
+*/
+func (cache *Cache) Purge() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.record(nil)
+	cache.entries = nil
+	cache.lru = nil
+	cache.mentions = nil
+	cache.records = nil
+	cache.recordsByEntity = nil
+}
+
+/*
+The Stats method returns the counts of hits, misses and removals since the Cache was first used.
+*/
+func (cache *Cache) Stats() Stats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	result := cache.stats
+	if cache.lru != nil {
+		result.Entries = cache.lru.Len()
+	}
+	return result
+}
+
+// Whether a response read since epoch may be cached: no entity it mentions was invalidated meanwhile.
+// Must be called with the mutex held.
+func (cache *Cache) admissible(epoch uint64, mentions []int64) bool {
+	if cache.epoch == epoch {
+		return true
+	}
+	if len(cache.invalidations) == 0 || cache.invalidations[0].epoch > epoch+1 {
+		return false
+	}
+	for _, invalidation := range cache.invalidations {
+		if invalidation.epoch <= epoch {
+			continue
+		}
+		if invalidation.entityIDs == nil {
+			return false
+		}
+		for _, entityID := range invalidation.entityIDs {
+			for _, mention := range mentions {
+				if entityID == mention {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Look up an entity, returning the epoch to pass to put on a miss.
+func (cache *Cache) get(key entityKey) (string, uint64, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.lookup(key)
+}
+
+// Look up the entity containing a record, returning the epoch to pass to put on a miss.
+func (cache *Cache) getByRecord(record recordKey, flags int64) (string, uint64, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entityID, ok := cache.records[record]
+	if !ok {
+		cache.stats.Misses++
+		return "", cache.epoch, false
+	}
+	return cache.lookup(entityKey{entityID: entityID, flags: flags})
+}
+
+func (cache *Cache) invalidate(entityIDs []int64, records []recordKey) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.record(append([]int64{}, entityIDs...))
+	for _, entityID := range entityIDs {
+		for key := range cache.mentions[entityID] {
+			cache.remove(cache.entries[key])
+			cache.stats.Invalidations++
+		}
+		cache.unmap(entityID)
+	}
+	for _, record := range records {
+		cache.unmapRecord(record)
+	}
+}
+
+// Must be called with the mutex held.
+func (cache *Cache) lookup(key entityKey) (string, uint64, bool) {
+	element, ok := cache.entries[key]
+	if !ok {
+		cache.stats.Misses++
+		return "", cache.epoch, false
+	}
+	entry := element.Value.(*entry)
+	if !cache.now().Before(entry.expires) {
+		cache.remove(element)
+		cache.stats.Expirations++
+		cache.stats.Misses++
+		return "", cache.epoch, false
+	}
+	cache.lru.MoveToFront(element)
+	cache.stats.Hits++
+	return entry.value, cache.epoch, true
+}
+
+func (cache *Cache) maxEntries() int {
+	if cache.MaxEntries > 0 {
+		return cache.MaxEntries
+	}
+	return DefaultMaxEntries
+}
+
+func (cache *Cache) now() time.Time {
+	if cache.clock != nil {
+		return cache.clock()
+	}
+	return time.Now()
+}
+
+// Cache an entity response read since epoch, mapping record, if given, to its entity.
+// Responses that cannot be parsed are not cached.
+func (cache *Cache) put(ctx context.Context, epoch uint64, flags int64, value string, record *recordKey) {
+	entity, err := response.SzEngineGetEntityByEntityID(ctx, value)
+	if err != nil || entity.ResolvedEntity.EntityID == 0 {
+		return
+	}
+	key := entityKey{entityID: entity.ResolvedEntity.EntityID, flags: flags}
+	mentions := []int64{key.entityID}
+	for _, related := range entity.RelatedEntities {
+		mentions = append(mentions, related.EntityID)
+	}
+	records := make([]recordKey, 0, len(entity.ResolvedEntity.Records)+1)
+	for _, contained := range entity.ResolvedEntity.Records {
+		records = append(records, recordKey{dataSourceCode: contained.DataSource, recordID: contained.RecordID})
+	}
+	if record != nil {
+		records = append(records, *record)
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if !cache.admissible(epoch, mentions) {
+		return
+	}
+	if cache.entries == nil {
+		cache.entries = map[entityKey]*list.Element{}
+		cache.lru = list.New()
+		cache.mentions = map[int64]map[entityKey]struct{}{}
+		cache.records = map[recordKey]int64{}
+		cache.recordsByEntity = map[int64]map[recordKey]struct{}{}
+	}
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+	cache.entries[key] = cache.lru.PushFront(&entry{
+		expires:  cache.now().Add(cache.ttl()),
+		key:      key,
+		mentions: mentions,
+		value:    value,
+	})
+	for _, mention := range mentions {
+		if cache.mentions[mention] == nil {
+			cache.mentions[mention] = map[entityKey]struct{}{}
+		}
+		cache.mentions[mention][key] = struct{}{}
+	}
+	for _, record := range records {
+		cache.unmapRecord(record)
+		cache.records[record] = key.entityID
+		if cache.recordsByEntity[key.entityID] == nil {
+			cache.recordsByEntity[key.entityID] = map[recordKey]struct{}{}
+		}
+		cache.recordsByEntity[key.entityID][record] = struct{}{}
+	}
+	for cache.lru.Len() > cache.maxEntries() {
+		cache.remove(cache.lru.Back())
+		cache.stats.Evictions++
+	}
+}
+
+// Start a new epoch, keeping a bounded history of what it invalidated.
+// Must be called with the mutex held.
+func (cache *Cache) record(entityIDs []int64) {
+	cache.epoch++
+	cache.invalidations = append(cache.invalidations, invalidation{entityIDs: entityIDs, epoch: cache.epoch})
+	if len(cache.invalidations) > invalidationHistory {
+		cache.invalidations = cache.invalidations[len(cache.invalidations)-invalidationHistory:]
+	}
+}
+
+// Remove an entry, and the record keys of its entity once no entry for the entity remains.
+// Must be called with the mutex held.
+func (cache *Cache) remove(element *list.Element) {
+	entry := element.Value.(*entry)
+	cache.lru.Remove(element)
+	delete(cache.entries, entry.key)
+	for _, mention := range entry.mentions {
+		delete(cache.mentions[mention], entry.key)
+		if len(cache.mentions[mention]) == 0 {
+			delete(cache.mentions, mention)
+		}
+	}
+	if _, ok := cache.mentions[entry.key.entityID]; !ok {
+		cache.unmap(entry.key.entityID)
+	}
+}
+
+func (cache *Cache) ttl() time.Duration {
+	if cache.TTL > 0 {
+		return cache.TTL
+	}
+	return DefaultTTL
+}
+
+// Must be called with the mutex held.
+func (cache *Cache) unmap(entityID int64) {
+	for record := range cache.recordsByEntity[entityID] {
+		delete(cache.records, record)
+	}
+	delete(cache.recordsByEntity, entityID)
+}
+
+// Must be called with the mutex held.
+func (cache *Cache) unmapRecord(record recordKey) {
+	entityID, ok := cache.records[record]
+	if !ok {
+		return
+	}
+	delete(cache.records, record)
+	delete(cache.recordsByEntity[entityID], record)
+	if len(cache.recordsByEntity[entityID]) == 0 {
+		delete(cache.recordsByEntity, entityID)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Stats
+// ----------------------------------------------------------------------------
+
+/*
+The HitRate method returns the fraction of reads answered from the cache, or 0 if there have been none.
+*/
+func (stats Stats) HitRate() float64 {
+	reads := stats.Hits + stats.Misses
+	if reads == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(reads)
+}
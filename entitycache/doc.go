@@ -0,0 +1,13 @@
+/*
+The entitycache package caches the entities read through an SzEngine.
+
+NewSzEngine wraps an SzEngine so that GetEntityByEntityID and GetEntityByRecordID are answered from a Cache,
+keyed by entity ID and flags, with record keys mapped to the entity that contains them.
+AddRecord, DeleteRecord, ProcessRedoRecord, ReevaluateEntity and ReevaluateRecord are always called with senzing.SzWithInfo,
+and the AFFECTED_ENTITIES of the result invalidate the cached entities, and any cached entity relating to them.
+If the caller did not request senzing.SzWithInfo, the empty result the engine would have returned is returned instead.
+
+Changes made by other processes are not seen until an entry expires after Cache.TTL.
+Call Cache.Purge after SzDiagnostic.PurgeRepository, or Cache.Invalidate when entities are known to have changed elsewhere.
+*/
+package entitycache
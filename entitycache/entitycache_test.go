@@ -0,0 +1,304 @@
+package entitycache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	affected []int64
+	err      error
+	flags    []int64
+	reads    int
+	related  map[int64][]int64
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = recordDefinition
+	return engine.withInfo(dataSourceCode, recordID, flags)
+}
+
+func (engine *mockSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	return engine.withInfo(dataSourceCode, recordID, flags)
+}
+
+func (engine *mockSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	engine.reads++
+	return engine.entity(entityID), nil
+}
+
+func (engine *mockSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = flags
+	engine.reads++
+	if recordID == "MISSING" {
+		return "", szerror.New(33, "SENZ0033E|Unknown record: dsrc[TEST], record[MISSING]")
+	}
+	var entityID int64
+	_, err := fmt.Sscanf(recordID, "%d", &entityID)
+	return engine.entity(entityID), err
+}
+
+func (engine *mockSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	_ = ctx
+	_ = configID
+	return nil
+}
+
+func (engine *mockSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	_ = entityID
+	return engine.withInfo("", "", flags)
+}
+
+// Entity N contains record TEST/N.
+func (engine *mockSzEngine) entity(entityID int64) string {
+	related := make([]string, 0, len(engine.related[entityID]))
+	for _, relatedID := range engine.related[entityID] {
+		related = append(related, fmt.Sprintf(`{"ENTITY_ID":%d}`, relatedID))
+	}
+	return fmt.Sprintf(`{"RESOLVED_ENTITY":{"ENTITY_ID":%d,"RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"%d"}]},"RELATED_ENTITIES":[%s]}`,
+		entityID, entityID, strings.Join(related, ","))
+}
+
+func (engine *mockSzEngine) withInfo(dataSourceCode string, recordID string, flags int64) (string, error) {
+	engine.flags = append(engine.flags, flags)
+	if engine.err != nil {
+		return "", engine.err
+	}
+	affected := make([]string, 0, len(engine.affected))
+	for _, entityID := range engine.affected {
+		affected = append(affected, fmt.Sprintf(`{"ENTITY_ID":%d}`, entityID))
+	}
+	return fmt.Sprintf(`{"DATA_SOURCE":"%s","RECORD_ID":"%s","AFFECTED_ENTITIES":[%s],"INTERESTING_ENTITIES":{"ENTITIES":[]}}`,
+		dataSourceCode, recordID, strings.Join(affected, ",")), nil
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func readEntities(test *testing.T, szEngine senzing.SzEngine, entityIDs ...int64) {
+	test.Helper()
+	for _, entityID := range entityIDs {
+		result, err := szEngine.GetEntityByEntityID(context.TODO(), entityID, senzing.SzEntityDefaultFlags)
+		require.NoError(test, err)
+		require.Contains(test, result, fmt.Sprintf(`"ENTITY_ID":%d`, entityID))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestCache_GetEntityByEntityID(test *testing.T) {
+	ctx := context.TODO()
+	cache := &Cache{}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, cache)
+
+	readEntities(test, szEngine, 1, 1, 1, 2)
+	assert.Equal(test, 2, engine.reads)
+	_, err := szEngine.GetEntityByEntityID(ctx, 1, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, 3, engine.reads)
+
+	stats := cache.Stats()
+	assert.Equal(test, 3, stats.Entries)
+	assert.Equal(test, uint64(2), stats.Hits)
+	assert.Equal(test, uint64(3), stats.Misses)
+	assert.InDelta(test, 0.4, stats.HitRate(), 0.0001)
+	assert.Zero(test, Stats{}.HitRate())
+}
+
+func TestCache_GetEntityByRecordID(test *testing.T) {
+	ctx := context.TODO()
+	cache := &Cache{}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, cache)
+
+	readEntities(test, szEngine, 7)
+	result, err := szEngine.GetEntityByRecordID(ctx, "TEST", "7", senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	assert.Contains(test, result, `"ENTITY_ID":7`)
+	assert.Equal(test, 1, engine.reads)
+
+	_, err = szEngine.GetEntityByRecordID(ctx, "TEST", "8", senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByEntityID(ctx, 8, senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	assert.Equal(test, 2, engine.reads)
+
+	_, err = szEngine.GetEntityByRecordID(ctx, "TEST", "MISSING", senzing.SzEntityDefaultFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	_, err = szEngine.GetEntityByRecordID(ctx, "TEST", "MISSING", senzing.SzEntityDefaultFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	assert.Equal(test, 4, engine.reads)
+}
+
+func TestCache_AddRecord(test *testing.T) {
+	ctx := context.TODO()
+	cache := &Cache{}
+	engine := &mockSzEngine{affected: []int64{1}}
+	szEngine := NewSzEngine(engine, cache)
+	readEntities(test, szEngine, 1, 2)
+
+	result, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Empty(test, result)
+	assert.Equal(test, []int64{senzing.SzWithInfo}, engine.flags)
+
+	result, err = szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzWithInfo)
+	require.NoError(test, err)
+	assert.Contains(test, result, "AFFECTED_ENTITIES")
+
+	readEntities(test, szEngine, 1, 2)
+	assert.Equal(test, 3, engine.reads)
+	assert.Equal(test, uint64(1), cache.Stats().Invalidations)
+}
+
+func TestCache_DeleteRecord(test *testing.T) {
+	ctx := context.TODO()
+	cache := &Cache{}
+	engine := &mockSzEngine{affected: []int64{}}
+	szEngine := NewSzEngine(engine, cache)
+	_, err := szEngine.GetEntityByRecordID(ctx, "TEST", "3", senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+
+	_, err = szEngine.DeleteRecord(ctx, "TEST", "3", senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.GetEntityByRecordID(ctx, "TEST", "3", senzing.SzEntityDefaultFlags)
+	require.NoError(test, err)
+	assert.Equal(test, 2, engine.reads)
+}
+
+func TestCache_ReevaluateEntity_related(test *testing.T) {
+	ctx := context.TODO()
+	cache := &Cache{}
+	engine := &mockSzEngine{affected: []int64{2}, related: map[int64][]int64{1: {2}}}
+	szEngine := NewSzEngine(engine, cache)
+	readEntities(test, szEngine, 1, 2, 3)
+
+	_, err := szEngine.ReevaluateEntity(ctx, 2, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, 1, cache.Stats().Entries)
+	readEntities(test, szEngine, 1, 2, 3)
+	assert.Equal(test, 5, engine.reads)
+}
+
+func TestCache_errors(test *testing.T) {
+	ctx := context.TODO()
+	testCases := []struct {
+		name    string
+		err     error
+		entries int
+	}{
+		{name: "caller fault", err: szerror.New(33, "SENZ0033E|Unknown record"), entries: 2},
+		{name: "connection lost", err: szerror.New(1007, "SENZ1007E|Database Connection Lost"), entries: 0},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			cache := &Cache{}
+			engine := &mockSzEngine{}
+			szEngine := NewSzEngine(engine, cache)
+			readEntities(test, szEngine, 1, 2)
+			engine.err = testCase.err
+			_, err := szEngine.DeleteRecord(ctx, "TEST", "1", senzing.SzNoFlags)
+			require.ErrorIs(test, err, testCase.err)
+			assert.Equal(test, testCase.entries, cache.Stats().Entries)
+		})
+	}
+}
+
+func TestCache_Reinitialize(test *testing.T) {
+	cache := &Cache{}
+	szEngine := NewSzEngine(&mockSzEngine{}, cache)
+	readEntities(test, szEngine, 1, 2)
+	require.NoError(test, szEngine.Reinitialize(context.TODO(), 1))
+	assert.Zero(test, cache.Stats().Entries)
+	readEntities(test, szEngine, 1)
+	assert.Equal(test, 1, cache.Stats().Entries)
+}
+
+func TestCache_TTL(test *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := &Cache{TTL: time.Second, clock: func() time.Time { return now }}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, cache)
+	readEntities(test, szEngine, 1, 1)
+	now = now.Add(time.Second)
+	readEntities(test, szEngine, 1)
+	assert.Equal(test, 2, engine.reads)
+	assert.Equal(test, uint64(1), cache.Stats().Expirations)
+}
+
+func TestCache_MaxEntries(test *testing.T) {
+	cache := &Cache{MaxEntries: 2}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, cache)
+	readEntities(test, szEngine, 1, 2, 1, 3)
+	assert.Equal(test, uint64(1), cache.Stats().Evictions)
+	readEntities(test, szEngine, 1, 3)
+	assert.Equal(test, 3, engine.reads)
+	readEntities(test, szEngine, 2)
+	assert.Equal(test, 4, engine.reads)
+}
+
+func TestCache_Invalidate(test *testing.T) {
+	cache := &Cache{}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, cache)
+	readEntities(test, szEngine, 1, 2)
+	cache.Invalidate(1)
+	readEntities(test, szEngine, 1, 2)
+	assert.Equal(test, 3, engine.reads)
+	cache.Purge()
+	readEntities(test, szEngine, 2)
+	assert.Equal(test, 4, engine.reads)
+}
+
+func TestCache_put_raced(test *testing.T) {
+	ctx := context.TODO()
+	engine := &mockSzEngine{}
+	testCases := []struct {
+		name       string
+		invalidate func(cache *Cache)
+		cached     bool
+	}{
+		{name: "no invalidation", invalidate: func(cache *Cache) {}, cached: true},
+		{name: "other entity", invalidate: func(cache *Cache) { cache.Invalidate(5) }, cached: true},
+		{name: "same entity", invalidate: func(cache *Cache) { cache.Invalidate(5, 1) }, cached: false},
+		{name: "purge", invalidate: func(cache *Cache) { cache.Purge() }, cached: false},
+		{name: "history exceeded", invalidate: func(cache *Cache) {
+			for i := 0; i <= invalidationHistory; i++ {
+				cache.Invalidate(5)
+			}
+		}, cached: false},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			cache := &Cache{}
+			key := entityKey{entityID: 1, flags: senzing.SzNoFlags}
+			_, epoch, ok := cache.get(key)
+			require.False(test, ok)
+			testCase.invalidate(cache)
+			cache.put(ctx, epoch, senzing.SzNoFlags, engine.entity(1), nil)
+			_, _, ok = cache.get(key)
+			assert.Equal(test, testCase.cached, ok)
+		})
+	}
+}
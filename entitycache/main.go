@@ -0,0 +1,85 @@
+package entitycache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Cache holds entities read through the SzEngine wrappers that share it.
+// The zero value is ready to use with default settings. A Cache must not be copied after first use.
+type Cache struct {
+	// MaxEntries is the number of entities held. The least recently used are evicted. Defaults to DefaultMaxEntries.
+	MaxEntries int
+	// TTL is how long an entity is held. Defaults to DefaultTTL.
+	TTL time.Duration
+
+	clock           func() time.Time
+	entries         map[entityKey]*list.Element
+	epoch           uint64
+	invalidations   []invalidation
+	lru             *list.List
+	mentions        map[int64]map[entityKey]struct{}
+	mutex           sync.Mutex
+	records         map[recordKey]int64
+	recordsByEntity map[int64]map[recordKey]struct{}
+	stats           Stats
+}
+
+// Stats of a Cache.
+type Stats struct {
+	// Entries is the number of entities held.
+	Entries int `json:"entries"`
+	// Evictions is the number of entities evicted to stay within MaxEntries.
+	Evictions uint64 `json:"evictions"`
+	// Expirations is the number of entities found older than TTL.
+	Expirations uint64 `json:"expirations"`
+	// Hits is the number of reads answered from the cache.
+	Hits uint64 `json:"hits"`
+	// Invalidations is the number of entities removed because they changed.
+	Invalidations uint64 `json:"invalidations"`
+	// Misses is the number of reads passed to the engine.
+	Misses uint64 `json:"misses"`
+}
+
+// One cached response.
+type entry struct {
+	expires time.Time
+	key     entityKey
+	// Entity IDs in the response: the resolved entity and its related entities.
+	mentions []int64
+	value    string
+}
+
+type entityKey struct {
+	entityID int64
+	flags    int64
+}
+
+// Entity IDs invalidated at one epoch. Nil entityIDs invalidated everything.
+type invalidation struct {
+	entityIDs []int64
+	epoch     uint64
+}
+
+type recordKey struct {
+	dataSourceCode string
+	recordID       string
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default values.
+const (
+	DefaultMaxEntries = 10000
+	DefaultTTL        = time.Minute
+)
+
+// Number of recent invalidations kept to decide whether a read that raced with them may be cached.
+const invalidationHistory = 256
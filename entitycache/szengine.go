@@ -0,0 +1,233 @@
+package entitycache
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzEngine whose entity reads are cached.
+type cachedSzEngine struct {
+	cache    *Cache
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *cachedSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	result, err := client.szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags|senzing.SzWithInfo)
+	return client.invalidate(ctx, result, err, flags, &recordKey{dataSourceCode: dataSourceCode, recordID: recordID})
+}
+
+func (client *cachedSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	return client.szEngine.CloseExport(ctx, exportHandle)
+}
+
+func (client *cachedSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return client.szEngine.CountRedoRecords(ctx)
+}
+
+func (client *cachedSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	result, err := client.szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	return client.invalidate(ctx, result, err, flags, &recordKey{dataSourceCode: dataSourceCode, recordID: recordID})
+}
+
+func (client *cachedSzEngine) Destroy(ctx context.Context) error {
+	return client.szEngine.Destroy(ctx)
+}
+
+func (client *cachedSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	return client.szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+}
+
+func (client *cachedSzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	return client.szEngine.ExportCsvEntityReportIterator(ctx, csvColumnList, flags)
+}
+
+func (client *cachedSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return client.szEngine.ExportJSONEntityReport(ctx, flags)
+}
+
+func (client *cachedSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	return client.szEngine.ExportJSONEntityReportIterator(ctx, flags)
+}
+
+func (client *cachedSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	return client.szEngine.FetchNext(ctx, exportHandle)
+}
+
+func (client *cachedSzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+}
+
+func (client *cachedSzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *cachedSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return client.szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+}
+
+func (client *cachedSzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return client.szEngine.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+}
+
+func (client *cachedSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	return client.szEngine.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+}
+
+func (client *cachedSzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	return client.szEngine.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+}
+
+func (client *cachedSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return client.szEngine.GetActiveConfigID(ctx)
+}
+
+func (client *cachedSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	result, epoch, ok := client.cache.get(entityKey{entityID: entityID, flags: flags})
+	if ok {
+		return result, nil
+	}
+	result, err := client.szEngine.GetEntityByEntityID(ctx, entityID, flags)
+	if err == nil {
+		client.cache.put(ctx, epoch, flags, result, nil)
+	}
+	return result, err
+}
+
+func (client *cachedSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	record := recordKey{dataSourceCode: dataSourceCode, recordID: recordID}
+	result, epoch, ok := client.cache.getByRecord(record, flags)
+	if ok {
+		return result, nil
+	}
+	result, err := client.szEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	if err == nil {
+		client.cache.put(ctx, epoch, flags, result, &record)
+	}
+	return result, err
+}
+
+func (client *cachedSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *cachedSzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	return client.szEngine.GetRedoRecord(ctx)
+}
+
+func (client *cachedSzEngine) GetStats(ctx context.Context) (string, error) {
+	return client.szEngine.GetStats(ctx)
+}
+
+func (client *cachedSzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	return client.szEngine.GetVirtualEntityByRecordID(ctx, recordList, flags)
+}
+
+func (client *cachedSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return client.szEngine.HowEntityByEntityID(ctx, entityID, flags)
+}
+
+func (client *cachedSzEngine) PrimeEngine(ctx context.Context) error {
+	return client.szEngine.PrimeEngine(ctx)
+}
+
+func (client *cachedSzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	result, err := client.szEngine.ProcessRedoRecord(ctx, redoRecord, flags|senzing.SzWithInfo)
+	return client.invalidate(ctx, result, err, flags, nil)
+}
+
+func (client *cachedSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	result, err := client.szEngine.ReevaluateEntity(ctx, entityID, flags|senzing.SzWithInfo)
+	return client.invalidate(ctx, result, err, flags, nil)
+}
+
+func (client *cachedSzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	result, err := client.szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags|senzing.SzWithInfo)
+	return client.invalidate(ctx, result, err, flags, &recordKey{dataSourceCode: dataSourceCode, recordID: recordID})
+}
+
+func (client *cachedSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	err := client.szEngine.Reinitialize(ctx, configID)
+	if err == nil {
+		client.cache.Purge()
+	}
+	return err
+}
+
+func (client *cachedSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	return client.szEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+}
+
+func (client *cachedSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	return client.szEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+}
+
+func (client *cachedSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return client.szEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+}
+
+func (client *cachedSzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	return client.szEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+}
+
+// Invalidate the entities affected by a mutating call made with senzing.SzWithInfo.
+// When the affected entities are unknown, because the call failed other than through the caller's fault
+// or its result cannot be parsed, the whole cache is purged.
+func (client *cachedSzEngine) invalidate(ctx context.Context, result string, err error, flags int64, record *recordKey) (string, error) {
+	if err != nil {
+		if !szerror.IsCallerFault(err) {
+			client.cache.Purge()
+		}
+		return result, err
+	}
+	withInfo, parseErr := response.SzEngineAddRecord(ctx, result)
+	if parseErr != nil {
+		client.cache.Purge()
+	} else {
+		entityIDs := make([]int64, 0, len(withInfo.AffectedEntities))
+		for _, affected := range withInfo.AffectedEntities {
+			entityIDs = append(entityIDs, affected.EntityID)
+		}
+		var records []recordKey
+		if record != nil {
+			records = append(records, *record)
+		}
+		if len(withInfo.DataSource) > 0 {
+			records = append(records, recordKey{dataSourceCode: withInfo.DataSource, recordID: withInfo.RecordID})
+		}
+		client.cache.invalidate(entityIDs, records)
+	}
+	if flags&senzing.SzWithInfo == 0 {
+		return "", nil
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzEngine function wraps an SzEngine so that entity reads are answered from cache when possible,
+and mutating calls invalidate the entities they affect.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - cache: The Cache, which may be shared by several wrappers of the same engine.
+*/
+func NewSzEngine(szEngine senzing.SzEngine, cache *Cache) senzing.SzEngine {
+	return &cachedSzEngine{
+		cache:    cache,
+		szEngine: szEngine,
+	}
+}
@@ -0,0 +1,17 @@
+/*
+The gateway package serves SzEngine methods as a REST/JSON API.
+
+	handler := &gateway.Gateway{Factory: factory}
+	err := http.ListenAndServe(":8262", handler)
+
+Routes such as GET /entities/{entityID} and POST /search call the SzEngine of the Factory and answer with the
+typed structs of the response package. Flags are given by name in the "flags" query parameter, e.g.
+?flags=SZ_ENTITY_INCLUDE_ENTITY_NAME,SZ_ENTITY_INCLUDE_RECORD_DATA; each route has a default.
+Errors are answered with the application/problem+json bodies and HTTP statuses of szerror.NewProblem.
+The OpenAPI document of the routes is served at /openapi.json.
+
+Only routes that read the datastore are served unless Gateway.AllowWrites is set; AddRecord, DeleteRecord,
+ReevaluateEntity and ReevaluateRecord are then served too. GetStats is not served, as it resets the engine's counters;
+use an enginestats.Sampler instead.
+*/
+package gateway
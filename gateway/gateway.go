@@ -0,0 +1,257 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Gateway
+// ----------------------------------------------------------------------------
+
+/*
+The ServeHTTP method routes a request to an SzEngine method and writes its typed response.
+
+Input
+  - responseWriter: The response.
+  - request: The request. Its path has Gateway.Prefix removed before routing.
+*/
+func (gateway *Gateway) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
+	path, ok := strings.CutPrefix(request.URL.EscapedPath(), gateway.Prefix)
+	if !ok || (len(path) > 0 && !strings.HasPrefix(path, "/")) {
+		gateway.writeStatus(responseWriter, http.StatusNotFound)
+		return
+	}
+	if path == PathOpenAPI {
+		if request.Method != http.MethodGet {
+			responseWriter.Header().Set("Allow", http.MethodGet)
+			gateway.writeStatus(responseWriter, http.StatusMethodNotAllowed)
+			return
+		}
+		gateway.writeJSON(responseWriter, http.StatusOK, gateway.OpenAPI())
+		return
+	}
+
+	var matched *route
+	var pathValues map[string]string
+	allowed := []string{}
+	for index := range routes {
+		if routes[index].write && !gateway.AllowWrites {
+			continue
+		}
+		values, ok := match(routes[index].pattern, path)
+		if !ok {
+			continue
+		}
+		allowed = append(allowed, routes[index].method)
+		if routes[index].method == request.Method {
+			matched, pathValues = &routes[index], values
+		}
+	}
+	switch {
+	case len(allowed) == 0:
+		gateway.writeStatus(responseWriter, http.StatusNotFound)
+		return
+	case matched == nil:
+		responseWriter.Header().Set("Allow", strings.Join(allowed, ", "))
+		gateway.writeStatus(responseWriter, http.StatusMethodNotAllowed)
+		return
+	}
+
+	request.Body = http.MaxBytesReader(responseWriter, request.Body, gateway.maxBodyBytes())
+	args, err := parseArguments(request, matched, pathValues)
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		problem := szerror.NewProblem(err)
+		problem.Status = http.StatusRequestEntityTooLarge
+		problem.Title = http.StatusText(problem.Status)
+		gateway.writeProblem(responseWriter, problem)
+		return
+	}
+	if err != nil {
+		gateway.writeError(responseWriter, err)
+		return
+	}
+	ctx := request.Context()
+	szEngine, err := gateway.getSzEngine(ctx)
+	if err != nil {
+		problem := szerror.NewProblem(err)
+		problem.Status = http.StatusServiceUnavailable
+		problem.Title = http.StatusText(problem.Status)
+		gateway.writeProblem(responseWriter, problem)
+		return
+	}
+	result, err := matched.handle(ctx, szEngine, args)
+	if err != nil {
+		gateway.writeError(responseWriter, err)
+		return
+	}
+	if len(result) == 0 {
+		responseWriter.WriteHeader(http.StatusNoContent)
+		return
+	}
+	typedResult, err := matched.typed(ctx, result)
+	if err != nil {
+		gateway.writeError(responseWriter, err)
+		return
+	}
+	gateway.writeJSON(responseWriter, http.StatusOK, typedResult)
+}
+
+// Create the SzEngine on first use, so a Gateway can be built before the engine is reachable.
+func (gateway *Gateway) getSzEngine(ctx context.Context) (senzing.SzEngine, error) {
+	gateway.mutex.Lock()
+	defer gateway.mutex.Unlock()
+	if gateway.szEngine != nil {
+		return gateway.szEngine, nil
+	}
+	if gateway.Factory == nil {
+		return nil, errors.New("gateway has no Factory")
+	}
+	szEngine, err := gateway.Factory.CreateSzEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gateway.szEngine = szEngine
+	return szEngine, nil
+}
+
+func (gateway *Gateway) maxBodyBytes() int64 {
+	if gateway.MaxBodyBytes <= 0 {
+		return DefaultMaxBodyBytes
+	}
+	return gateway.MaxBodyBytes
+}
+
+func (gateway *Gateway) retryAfter() string {
+	retryAfter := gateway.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = DefaultRetryAfter
+	}
+	return strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+}
+
+func (gateway *Gateway) writeError(responseWriter http.ResponseWriter, err error) {
+	gateway.writeProblem(responseWriter, szerror.NewProblem(err))
+}
+
+func (gateway *Gateway) writeJSON(responseWriter http.ResponseWriter, status int, value any) {
+	responseWriter.Header().Set("Content-Type", ContentTypeJSON)
+	responseWriter.Header().Set("Cache-Control", "no-store")
+	responseWriter.WriteHeader(status)
+	_ = json.NewEncoder(responseWriter).Encode(value)
+}
+
+func (gateway *Gateway) writeProblem(responseWriter http.ResponseWriter, problem *szerror.Problem) {
+	if problem.Status == http.StatusServiceUnavailable {
+		responseWriter.Header().Set("Retry-After", gateway.retryAfter())
+	}
+	responseWriter.Header().Set("Content-Type", ContentTypeProblem)
+	responseWriter.Header().Set("Cache-Control", "no-store")
+	responseWriter.WriteHeader(problem.Status)
+	_ = json.NewEncoder(responseWriter).Encode(problem)
+}
+
+// Answer a routing error, which has no szerror type.
+func (gateway *Gateway) writeStatus(responseWriter http.ResponseWriter, status int) {
+	gateway.writeProblem(responseWriter, &szerror.Problem{
+		Status: status,
+		Title:  http.StatusText(status),
+		Type:   "about:blank",
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// Match an escaped path against a route pattern, returning the values of its path parameters.
+func match(pattern string, path string) (map[string]string, bool) {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return nil, false
+	}
+	result := map[string]string{}
+	for index, segment := range patternSegments {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			if len(pathSegments[index]) == 0 {
+				return nil, false
+			}
+			result[strings.TrimSuffix(name, "}")] = pathSegments[index]
+			continue
+		}
+		if segment != pathSegments[index] {
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+// The flags of a request: the sum of the names or integers in its "flags" query parameters, or the route's default.
+func parseFlags(values []string, defaultFlags int64) (int64, error) {
+	if len(values) == 0 {
+		return defaultFlags, nil
+	}
+	var result int64
+	for _, value := range values {
+		for _, name := range splitList(value) {
+			if flag, ok := FlagNames[strings.ToUpper(name)]; ok {
+				result |= flag
+				continue
+			}
+			flag, err := strconv.ParseInt(name, 0, 64)
+			if err != nil {
+				return 0, errors.Join(szerror.ErrSzBadInput, fmt.Errorf("unknown flag %q", name))
+			}
+			result |= flag
+		}
+	}
+	return result, nil
+}
+
+func parseArguments(request *http.Request, matched *route, pathValues map[string]string) (*arguments, error) {
+	var err error
+	query := request.URL.Query()
+	result := &arguments{
+		path:  map[string]string{},
+		query: map[string]string{},
+	}
+	for name, value := range pathValues {
+		if result.path[name], err = url.PathUnescape(value); err != nil {
+			return nil, errors.Join(szerror.ErrSzBadInput, err)
+		}
+	}
+	for _, parameter := range matched.query {
+		value := query.Get(parameter.name)
+		if parameter.required && len(value) == 0 {
+			return nil, errors.Join(szerror.ErrSzBadInput, fmt.Errorf("query parameter %q is required", parameter.name))
+		}
+		result.query[parameter.name] = value
+	}
+	if result.flags, err = parseFlags(query["flags"], matched.defaultFlags); err != nil {
+		return nil, err
+	}
+	if len(matched.body) > 0 {
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, errors.Join(szerror.ErrSzBadInput, err)
+		}
+		if !json.Valid(body) {
+			return nil, errors.Join(szerror.ErrSzBadInput, errors.New("request body is not JSON"))
+		}
+		result.body = string(body)
+	}
+	return result, nil
+}
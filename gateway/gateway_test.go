@@ -0,0 +1,407 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzAbstractFactory struct {
+	senzing.SzAbstractFactory
+	err      error
+	szEngine *mockSzEngine
+}
+
+func (factory *mockSzAbstractFactory) CreateSzEngine(ctx context.Context) (senzing.SzEngine, error) {
+	_ = ctx
+	if factory.err != nil {
+		return nil, factory.err
+	}
+	return factory.szEngine, nil
+}
+
+// The mock SzEngine records the arguments of its last call and returns err, if set.
+type mockSzEngine struct {
+	senzing.SzEngine
+	arguments []any
+	err       error
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{dataSourceCode, recordID, recordDefinition, flags}
+	if flags&senzing.SzWithInfo == 0 {
+		return "", engine.err
+	}
+	return `{"DATA_SOURCE":"` + dataSourceCode + `","RECORD_ID":"` + recordID + `","AFFECTED_ENTITIES":[{"ENTITY_ID":1}]}`, engine.err
+}
+
+func (engine *mockSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags}
+	return `{"ENTITY_PATHS":[],"ENTITIES":[]}`, engine.err
+}
+
+func (engine *mockSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags}
+	return `{"ENTITY_PATHS":[{"START_ENTITY_ID":1,"END_ENTITY_ID":2,"ENTITIES":[1,2]}]}`, engine.err
+}
+
+func (engine *mockSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{entityID, flags}
+	return `{"RESOLVED_ENTITY":{"ENTITY_ID":1,"ENTITY_NAME":"Robert Smith"}}`, engine.err
+}
+
+func (engine *mockSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{dataSourceCode, recordID, flags}
+	return `{"DATA_SOURCE":"` + dataSourceCode + `","RECORD_ID":"` + recordID + `"}`, engine.err
+}
+
+func (engine *mockSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{attributes, searchProfile, flags}
+	return `{"RESOLVED_ENTITIES":[{"MATCH_INFO":{"MATCH_KEY":"+NAME"},"ENTITY":{"RESOLVED_ENTITY":{"ENTITY_ID":1}}}]}`, engine.err
+}
+
+func (engine *mockSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	_ = ctx
+	engine.arguments = []any{entityID1, entityID2, flags}
+	return `{"WHY_RESULTS":[{"ENTITY_ID":1,"ENTITY_ID_2":2}]}`, engine.err
+}
+
+func newTestGateway(engine *mockSzEngine) (*Gateway, *mockSzAbstractFactory) {
+	factory := &mockSzAbstractFactory{szEngine: engine}
+	return &Gateway{AllowWrites: true, Factory: factory}, factory
+}
+
+func serve(test *testing.T, handler http.Handler, method string, target string, body string) *httptest.ResponseRecorder {
+	test.Helper()
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func problemOf(test *testing.T, recorder *httptest.ResponseRecorder) szerror.Problem {
+	test.Helper()
+	assert.Equal(test, ContentTypeProblem, recorder.Header().Get("Content-Type"))
+	result := szerror.Problem{}
+	require.NoError(test, json.Unmarshal(recorder.Body.Bytes(), &result))
+	assert.Equal(test, recorder.Code, result.Status)
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestGateway_ServeHTTP(test *testing.T) {
+	testCases := []struct {
+		name      string
+		method    string
+		target    string
+		body      string
+		status    int
+		arguments []any
+		contains  string
+	}{
+		{
+			name:      "get-record",
+			method:    http.MethodGet,
+			target:    "/data-sources/CUSTOMERS/records/1001",
+			status:    http.StatusOK,
+			arguments: []any{"CUSTOMERS", "1001", senzing.SzRecordDefaultFlags},
+			contains:  `"RECORD_ID":"1001"`,
+		},
+		{
+			name:      "get-record-escaped",
+			method:    http.MethodGet,
+			target:    "/data-sources/CUSTOMERS/records/A%2F1",
+			status:    http.StatusOK,
+			arguments: []any{"CUSTOMERS", "A/1", senzing.SzRecordDefaultFlags},
+		},
+		{
+			name:      "add-record",
+			method:    http.MethodPut,
+			target:    "/data-sources/CUSTOMERS/records/1001",
+			body:      `{"NAME_FULL":"Robert Smith"}`,
+			status:    http.StatusNoContent,
+			arguments: []any{"CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, senzing.SzNoFlags},
+		},
+		{
+			name:      "add-record-with-info",
+			method:    http.MethodPut,
+			target:    "/data-sources/CUSTOMERS/records/1001?flags=SZ_WITH_INFO",
+			body:      `{"NAME_FULL":"Robert Smith"}`,
+			status:    http.StatusOK,
+			arguments: []any{"CUSTOMERS", "1001", `{"NAME_FULL":"Robert Smith"}`, senzing.SzWithInfo},
+			contains:  `"AFFECTED_ENTITIES"`,
+		},
+		{
+			name:      "get-entity-flags",
+			method:    http.MethodGet,
+			target:    "/entities/1?flags=SZ_ENTITY_INCLUDE_ENTITY_NAME,sz_entity_include_record_data&flags=4",
+			status:    http.StatusOK,
+			arguments: []any{int64(1), senzing.SzEntityIncludeEntityName | senzing.SzEntityIncludeRecordData | 4},
+			contains:  `"ENTITY_NAME":"Robert Smith"`,
+		},
+		{
+			name:      "why-entities",
+			method:    http.MethodGet,
+			target:    "/entities/1/why/2/",
+			status:    http.StatusOK,
+			arguments: []any{int64(1), int64(2), senzing.SzWhyEntitiesDefaultFlags},
+		},
+		{
+			name:      "find-path",
+			method:    http.MethodGet,
+			target:    "/entities/1/path/2?maxDegrees=2&avoidEntityIDs=3,4&requiredDataSources=CUSTOMERS",
+			status:    http.StatusOK,
+			arguments: []any{int64(1), int64(2), int64(2), `{"ENTITIES":[{"ENTITY_ID":3},{"ENTITY_ID":4}]}`, `{"DATA_SOURCES":["CUSTOMERS"]}`, senzing.SzFindPathDefaultFlags},
+		},
+		{
+			name:      "find-network",
+			method:    http.MethodGet,
+			target:    "/network?entityIDs=1,2",
+			status:    http.StatusOK,
+			arguments: []any{`{"ENTITIES":[{"ENTITY_ID":1},{"ENTITY_ID":2}]}`, int64(DefaultMaxDegrees), int64(DefaultBuildOutDegree), int64(DefaultBuildOutMaxEntities), senzing.SzFindNetworkDefaultFlags},
+		},
+		{
+			name:      "search",
+			method:    http.MethodPost,
+			target:    "/search?searchProfile=SEARCH",
+			body:      `{"NAME_FULL":"Robert Smith"}`,
+			status:    http.StatusOK,
+			arguments: []any{`{"NAME_FULL":"Robert Smith"}`, "SEARCH", senzing.SzSearchByAttributesDefaultFlags},
+			contains:  `"MATCH_KEY":"+NAME"`,
+		},
+		{name: "bad-entity-id", method: http.MethodGet, target: "/entities/one", status: http.StatusBadRequest},
+		{name: "bad-flag", method: http.MethodGet, target: "/entities/1?flags=SZ_NOT_A_FLAG", status: http.StatusBadRequest},
+		{name: "missing-query", method: http.MethodGet, target: "/network", status: http.StatusBadRequest},
+		{name: "bad-body", method: http.MethodPost, target: "/search", body: "{", status: http.StatusBadRequest},
+		{name: "unknown-path", method: http.MethodGet, target: "/entities", status: http.StatusNotFound},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			engine := &mockSzEngine{}
+			gateway, _ := newTestGateway(engine)
+			recorder := serve(test, gateway, testCase.method, testCase.target, testCase.body)
+			require.Equal(test, testCase.status, recorder.Code, recorder.Body.String())
+			if testCase.status >= http.StatusBadRequest {
+				problemOf(test, recorder)
+				return
+			}
+			assert.Equal(test, testCase.arguments, engine.arguments)
+			if testCase.status == http.StatusOK {
+				assert.Equal(test, ContentTypeJSON, recorder.Header().Get("Content-Type"))
+				assert.True(test, json.Valid(recorder.Body.Bytes()))
+			}
+			assert.Contains(test, recorder.Body.String(), testCase.contains)
+		})
+	}
+}
+
+func TestGateway_ServeHTTP_errors(test *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		status     int
+		types      []string
+		problem    string
+		retryAfter string
+	}{
+		{
+			name:    "not-found",
+			err:     szerror.New(33, "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]"),
+			status:  http.StatusNotFound,
+			types:   []string{"SzBadInput", "SzNotFound"},
+			problem: szerror.ProblemTypeBase + "SzNotFound",
+		},
+		{
+			name:    "unknown-data-source",
+			err:     errors.Join(szerror.ErrSzBadInput, szerror.ErrSzUnknownDataSource, errors.New("SENZ2207|Data source code [CUSTOMERS] does not exist.")),
+			status:  http.StatusBadRequest,
+			types:   []string{"SzBadInput", "SzUnknownDataSource"},
+			problem: szerror.ProblemTypeBase + "SzUnknownDataSource",
+		},
+		{
+			name:       "retryable",
+			err:        szerror.New(1006, "SENZ1006|Connection lost"),
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "2",
+		},
+		{name: "unclassified", err: errors.New("boom"), status: http.StatusInternalServerError, problem: "about:blank"},
+		{name: "deadline", err: context.DeadlineExceeded, status: http.StatusGatewayTimeout},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			gateway, _ := newTestGateway(&mockSzEngine{err: testCase.err})
+			gateway.RetryAfter = 1500 * time.Millisecond
+			recorder := serve(test, gateway, http.MethodGet, "/data-sources/CUSTOMERS/records/1001", "")
+			require.Equal(test, testCase.status, recorder.Code)
+			problem := problemOf(test, recorder)
+			assert.Equal(test, testCase.retryAfter, recorder.Header().Get("Retry-After"))
+			if len(testCase.problem) > 0 {
+				assert.Equal(test, testCase.problem, problem.Type)
+			}
+			if len(testCase.types) > 0 {
+				assert.ElementsMatch(test, testCase.types, problem.Types)
+				assert.Equal(test, strings.SplitN(testCase.err.Error(), "\n", len(testCase.types)+1)[len(testCase.types)], problem.Detail)
+			}
+		})
+	}
+}
+
+func TestGateway_ServeHTTP_bodyTooLarge(test *testing.T) {
+	engine := &mockSzEngine{}
+	gateway, _ := newTestGateway(engine)
+	gateway.MaxBodyBytes = 16
+	recorder := serve(test, gateway, http.MethodPut, "/data-sources/CUSTOMERS/records/1001", `{"NAME_FULL": "Robert Smith"}`)
+	require.Equal(test, http.StatusRequestEntityTooLarge, recorder.Code)
+	problem := problemOf(test, recorder)
+	assert.Contains(test, problem.Types, "SzBadInput")
+	assert.Empty(test, engine.arguments)
+}
+
+func TestGateway_FlagNames(test *testing.T) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filepath.Join("..", "senzing", "flags.go"), nil, 0)
+	require.NoError(test, err)
+	pkg, err := (&types.Config{}).Check("senzing", fileSet, []*ast.File{file}, nil)
+	require.NoError(test, err)
+	acronyms := map[string]string{"ID": "ID", "IDS": "IDs", "JSON": "JSON"}
+	// Go constants whose names differ from the Senzing flag name.
+	misspelled := map[string]string{"SzWhyRecordInEntityDefaultFlags": "SzWhyRecordInEntityIDefaultFlags"}
+	for key, value := range FlagNames {
+		name := ""
+		for _, word := range strings.Split(key, "_") {
+			if acronym, ok := acronyms[word]; ok {
+				name += acronym
+				continue
+			}
+			name += word[:1] + strings.ToLower(word[1:])
+		}
+		if actual, ok := misspelled[name]; ok {
+			name = actual
+		}
+		object, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if assert.True(test, ok, "%s has no constant %s", key, name) {
+			assert.Equal(test, constant.MakeInt64(value).String(), object.Val().String(), key)
+		}
+	}
+}
+
+func TestGateway_ServeHTTP_methodNotAllowed(test *testing.T) {
+	gateway, _ := newTestGateway(&mockSzEngine{})
+	recorder := serve(test, gateway, http.MethodPost, "/data-sources/CUSTOMERS/records/1001", "")
+	require.Equal(test, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(test, "GET, PUT, DELETE", recorder.Header().Get("Allow"))
+	problemOf(test, recorder)
+}
+
+func TestGateway_ServeHTTP_factory(test *testing.T) {
+	gateway, factory := newTestGateway(&mockSzEngine{})
+	factory.err = szerror.New(1006, "SENZ1006|Connection lost")
+	recorder := serve(test, gateway, http.MethodGet, "/entities/1", "")
+	require.Equal(test, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(test, "5", recorder.Header().Get("Retry-After"))
+
+	factory.err = nil
+	recorder = serve(test, gateway, http.MethodGet, "/entities/1", "")
+	require.Equal(test, http.StatusOK, recorder.Code, "the engine is created once the factory succeeds")
+}
+
+func TestGateway_Prefix(test *testing.T) {
+	gateway, _ := newTestGateway(&mockSzEngine{})
+	gateway.Prefix = "/api"
+	mux := http.NewServeMux()
+	mux.Handle("/api/", gateway)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "/api/entities/1")
+	require.NoError(test, err)
+	defer response.Body.Close()
+	assert.Equal(test, http.StatusOK, response.StatusCode)
+
+	recorder := serve(test, gateway, http.MethodGet, "/entities/1", "")
+	assert.Equal(test, http.StatusNotFound, recorder.Code)
+	recorder = serve(test, gateway, http.MethodGet, "/apientities/1", "")
+	assert.Equal(test, http.StatusNotFound, recorder.Code, "the prefix ends at a segment boundary")
+}
+
+func TestGateway_AllowWrites(test *testing.T) {
+	engine := &mockSzEngine{}
+	gateway, _ := newTestGateway(engine)
+	gateway.AllowWrites = false
+	recorder := serve(test, gateway, http.MethodPut, "/data-sources/CUSTOMERS/records/1001", `{"NAME_FULL": "Robert Smith"}`)
+	require.Equal(test, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(test, http.MethodGet, recorder.Header().Get("Allow"))
+	recorder = serve(test, gateway, http.MethodPost, "/entities/1/reevaluate", "")
+	require.Equal(test, http.StatusNotFound, recorder.Code)
+	assert.Empty(test, engine.arguments)
+
+	paths, ok := gateway.OpenAPI()["paths"].(map[string]any)
+	require.True(test, ok)
+	assert.NotContains(test, paths, "/entities/{entityID}/reevaluate")
+	record, ok := paths["/data-sources/{dataSourceCode}/records/{recordID}"].(map[string]any)
+	require.True(test, ok)
+	assert.Len(test, record, 1)
+	assert.Contains(test, record, "get")
+}
+
+func TestGateway_OpenAPI(test *testing.T) {
+	gateway, _ := newTestGateway(&mockSzEngine{})
+	recorder := serve(test, gateway, http.MethodGet, PathOpenAPI, "")
+	require.Equal(test, http.StatusOK, recorder.Code)
+	document := map[string]any{}
+	require.NoError(test, json.Unmarshal(recorder.Body.Bytes(), &document))
+	assert.Equal(test, "3.0.3", document["openapi"])
+	paths, ok := document["paths"].(map[string]any)
+	require.True(test, ok)
+	patterns := map[string]bool{}
+	for _, route := range routes {
+		patterns[route.pattern] = true
+		pathItem, ok := paths[route.pattern].(map[string]any)
+		require.True(test, ok, route.pattern)
+		operation, ok := pathItem[strings.ToLower(route.method)].(map[string]any)
+		require.True(test, ok, route.pattern)
+		assert.Equal(test, route.operation, operation["operationId"])
+		responses, ok := operation["responses"].(map[string]any)
+		require.True(test, ok)
+		assert.Equal(test, route.withInfo, responses["204"] != nil, route.operation)
+		assert.Equal(test, len(route.body) > 0, operation["requestBody"] != nil, route.operation)
+	}
+	assert.Len(test, paths, len(patterns))
+
+	entity, ok := paths["/entities/{entityID}"].(map[string]any)["get"].(map[string]any)
+	require.True(test, ok)
+	assert.Contains(test, recorderString(entity), "SZ_ENTITY_DEFAULT_FLAGS")
+
+	recorder = serve(test, gateway, http.MethodPost, PathOpenAPI, "")
+	assert.Equal(test, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func recorderString(value any) string {
+	result, _ := json.Marshal(value)
+	return string(result)
+}
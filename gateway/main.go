@@ -0,0 +1,152 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Gateway is an http.Handler serving the routes of the package.
+type Gateway struct {
+	// AllowWrites serves the routes that change the datastore: AddRecord, DeleteRecord and the reevaluate routes.
+	AllowWrites bool
+	// Factory creates the SzEngine shared by all requests. Required.
+	Factory senzing.SzAbstractFactory
+	// MaxBodyBytes is the largest request body read; larger bodies are answered with 413. Defaults to DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// Prefix is removed from request paths before routing, e.g. "/api". Paths not under it, e.g. "/apientities/1", are not found.
+	Prefix string
+	// RetryAfter is sent in the Retry-After header of 503 responses. Defaults to DefaultRetryAfter.
+	RetryAfter time.Duration
+
+	mutex    sync.Mutex
+	szEngine senzing.SzEngine
+}
+
+// A query parameter of a route.
+type parameter struct {
+	description string
+	name        string
+	required    bool
+	schemaType  string // "integer" or "string".
+}
+
+// The values a route handler receives. The first value that fails to parse is recorded in err.
+type arguments struct {
+	body  string
+	err   error
+	flags int64
+	path  map[string]string
+	query map[string]string
+}
+
+// A route maps an HTTP method and path pattern to an SzEngine method.
+type route struct {
+	body         string // Description of the request body, if there is one.
+	defaultFlags int64
+	handle       func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error)
+	method       string
+	operation    string // The SzEngine method, used as the OpenAPI operationId.
+	pattern      string // Path segments in braces are path parameters, e.g. "/entities/{entityID}".
+	query        []parameter
+	summary      string
+	typed        func(ctx context.Context, jsonString string) (any, error) // Parses the result into a response struct.
+	withInfo     bool                                                      // The result is empty unless SZ_WITH_INFO is given.
+	write        bool                                                      // The route changes the datastore; see Gateway.AllowWrites.
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Content types.
+const (
+	ContentTypeJSON    = "application/json"
+	ContentTypeProblem = "application/problem+json"
+)
+
+// Default values.
+const (
+	DefaultBuildOutDegree      = 1
+	DefaultBuildOutMaxEntities = 10
+	DefaultMaxBodyBytes        = 10 << 20
+	DefaultMaxDegrees          = 3
+	DefaultRetryAfter          = 5 * time.Second
+)
+
+// PathOpenAPI serves the OpenAPI document.
+const PathOpenAPI = "/openapi.json"
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Flags accepted by name in the "flags" query parameter.
+var FlagNames = map[string]int64{
+	"SZ_NO_FLAGS":                                  senzing.SzNoFlags,
+	"SZ_ENTITY_BRIEF_DEFAULT_FLAGS":                senzing.SzEntityBriefDefaultFlags,
+	"SZ_ENTITY_DEFAULT_FLAGS":                      senzing.SzEntityDefaultFlags,
+	"SZ_ENTITY_INCLUDE_ALL_FEATURES":               senzing.SzEntityIncludeAllFeatures,
+	"SZ_ENTITY_INCLUDE_ALL_RELATIONS":              senzing.SzEntityIncludeAllRelations,
+	"SZ_ENTITY_INCLUDE_DISCLOSED_RELATIONS":        senzing.SzEntityIncludeDisclosedRelations,
+	"SZ_ENTITY_INCLUDE_ENTITY_NAME":                senzing.SzEntityIncludeEntityName,
+	"SZ_ENTITY_INCLUDE_FEATURE_ELEMENTS":           senzing.SzEntityIncludeFeatureElements,
+	"SZ_ENTITY_INCLUDE_FEATURE_STATS":              senzing.SzEntityIncludeFeatureStats,
+	"SZ_ENTITY_INCLUDE_INTERNAL_FEATURES":          senzing.SzEntityIncludeInternalFeatures,
+	"SZ_ENTITY_INCLUDE_NAME_ONLY_RELATIONS":        senzing.SzEntityIncludeNameOnlyRelations,
+	"SZ_ENTITY_INCLUDE_POSSIBLY_RELATED_RELATIONS": senzing.SzEntityIncludePossiblyRelatedRelations,
+	"SZ_ENTITY_INCLUDE_POSSIBLY_SAME_RELATIONS":    senzing.SzEntityIncludePossiblySameRelations,
+	"SZ_ENTITY_INCLUDE_RECORD_DATA":                senzing.SzEntityIncludeRecordData,
+	"SZ_ENTITY_INCLUDE_RECORD_FEATURE_IDS":         senzing.SzEntityIncludeRecordFeatureIDs,
+	"SZ_ENTITY_INCLUDE_RECORD_JSON_DATA":           senzing.SzEntityIncludeRecordJSONData,
+	"SZ_ENTITY_INCLUDE_RECORD_MATCHING_INFO":       senzing.SzEntityIncludeRecordMatchingInfo,
+	"SZ_ENTITY_INCLUDE_RECORD_SUMMARY":             senzing.SzEntityIncludeRecordSummary,
+	"SZ_ENTITY_INCLUDE_RECORD_TYPES":               senzing.SzEntityIncludeRecordTypes,
+	"SZ_ENTITY_INCLUDE_RECORD_UNMAPPED_DATA":       senzing.SzEntityIncludeRecordUnmappedData,
+	"SZ_ENTITY_INCLUDE_RELATED_ENTITY_NAME":        senzing.SzEntityIncludeRelatedEntityName,
+	"SZ_ENTITY_INCLUDE_RELATED_MATCHING_INFO":      senzing.SzEntityIncludeRelatedMatchingInfo,
+	"SZ_ENTITY_INCLUDE_RELATED_RECORD_DATA":        senzing.SzEntityIncludeRelatedRecordData,
+	"SZ_ENTITY_INCLUDE_RELATED_RECORD_SUMMARY":     senzing.SzEntityIncludeRelatedRecordSummary,
+	"SZ_ENTITY_INCLUDE_RELATED_RECORD_TYPES":       senzing.SzEntityIncludeRelatedRecordTypes,
+	"SZ_ENTITY_INCLUDE_REPRESENTATIVE_FEATURES":    senzing.SzEntityIncludeRepresentativeFeatures,
+	"SZ_EXPORT_DEFAULT_FLAGS":                      senzing.SzExportDefaultFlags,
+	"SZ_EXPORT_INCLUDE_ALL_ENTITIES":               senzing.SzExportIncludeAllEntities,
+	"SZ_EXPORT_INCLUDE_ALL_HAVING_RELATIONSHIPS":   senzing.SzExportIncludeAllHavingRelationships,
+	"SZ_EXPORT_INCLUDE_DISCLOSED":                  senzing.SzExportIncludeDisclosed,
+	"SZ_EXPORT_INCLUDE_MULTI_RECORD_ENTITIES":      senzing.SzExportIncludeMultiRecordEntities,
+	"SZ_EXPORT_INCLUDE_NAME_ONLY":                  senzing.SzExportIncludeNameOnly,
+	"SZ_EXPORT_INCLUDE_POSSIBLY_RELATED":           senzing.SzExportIncludePossiblyRelated,
+	"SZ_EXPORT_INCLUDE_POSSIBLY_SAME":              senzing.SzExportIncludePossiblySame,
+	"SZ_EXPORT_INCLUDE_SINGLE_RECORD_ENTITIES":     senzing.SzExportIncludeSingleRecordEntities,
+	"SZ_FIND_NETWORK_DEFAULT_FLAGS":                senzing.SzFindNetworkDefaultFlags,
+	"SZ_FIND_NETWORK_INCLUDE_MATCHING_INFO":        senzing.SzFindNetworkIncludeMatchingInfo,
+	"SZ_FIND_PATH_DEFAULT_FLAGS":                   senzing.SzFindPathDefaultFlags,
+	"SZ_FIND_PATH_INCLUDE_MATCHING_INFO":           senzing.SzFindPathIncludeMatchingInfo,
+	"SZ_FIND_PATH_STRICT_AVOID":                    senzing.SzFindPathStrictAvoid,
+	"SZ_HOW_ENTITY_DEFAULT_FLAGS":                  senzing.SzHowEntityDefaultFlags,
+	"SZ_INCLUDE_FEATURE_SCORES":                    senzing.SzIncludeFeatureScores,
+	"SZ_INCLUDE_MATCH_KEY_DETAILS":                 senzing.SzIncludeMatchKeyDetails,
+	"SZ_RECORD_DEFAULT_FLAGS":                      senzing.SzRecordDefaultFlags,
+	"SZ_SEARCH_BY_ATTRIBUTES_ALL":                  senzing.SzSearchByAttributesAll,
+	"SZ_SEARCH_BY_ATTRIBUTES_DEFAULT_FLAGS":        senzing.SzSearchByAttributesDefaultFlags,
+	"SZ_SEARCH_BY_ATTRIBUTES_MINIMAL_ALL":          senzing.SzSearchByAttributesMinimalAll,
+	"SZ_SEARCH_BY_ATTRIBUTES_MINIMAL_STRONG":       senzing.SzSearchByAttributesMinimalStrong,
+	"SZ_SEARCH_BY_ATTRIBUTES_STRONG":               senzing.SzSearchByAttributesStrong,
+	"SZ_SEARCH_INCLUDE_ALL_ENTITIES":               senzing.SzSearchIncludeAllEntities,
+	"SZ_SEARCH_INCLUDE_NAME_ONLY":                  senzing.SzSearchIncludeNameOnly,
+	"SZ_SEARCH_INCLUDE_POSSIBLY_RELATED":           senzing.SzSearchIncludePossiblyRelated,
+	"SZ_SEARCH_INCLUDE_POSSIBLY_SAME":              senzing.SzSearchIncludePossiblySame,
+	"SZ_SEARCH_INCLUDE_RESOLVED":                   senzing.SzSearchIncludeResolved,
+	"SZ_SEARCH_INCLUDE_STATS":                      senzing.SzSearchIncludeStats,
+	"SZ_VIRTUAL_ENTITY_DEFAULT_FLAGS":              senzing.SzVirtualEntityDefaultFlags,
+	"SZ_WHY_ENTITIES_DEFAULT_FLAGS":                senzing.SzWhyEntitiesDefaultFlags,
+	"SZ_WHY_RECORDS_DEFAULT_FLAGS":                 senzing.SzWhyRecordsDefaultFlags,
+	"SZ_WHY_RECORD_IN_ENTITY_DEFAULT_FLAGS":        senzing.SzWhyRecordInEntityIDefaultFlags,
+	"SZ_WITH_INFO":                                 senzing.SzWithInfo,
+}
@@ -0,0 +1,136 @@
+package gateway
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var pathParameterPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+// ----------------------------------------------------------------------------
+// Methods - Gateway
+// ----------------------------------------------------------------------------
+
+/*
+The OpenAPI method returns the OpenAPI 3.0 document of the routes, as served at PathOpenAPI.
+*/
+func (gateway *Gateway) OpenAPI() map[string]any {
+	paths := map[string]any{}
+	for _, route := range routes {
+		if route.write && !gateway.AllowWrites {
+			continue
+		}
+		pathItem, ok := paths[gateway.Prefix+route.pattern].(map[string]any)
+		if !ok {
+			pathItem = map[string]any{}
+			paths[gateway.Prefix+route.pattern] = pathItem
+		}
+		pathItem[strings.ToLower(route.method)] = openAPIOperation(route)
+	}
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Senzing SzEngine gateway",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Problem": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"detail":           map[string]any{"type": "string"},
+						"senzingErrorCode": map[string]any{"type": "integer"},
+						"status":           map[string]any{"type": "integer"},
+						"title":            map[string]any{"type": "string"},
+						"type":             map[string]any{"type": "string"},
+						"types":            map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func openAPIOperation(route route) map[string]any {
+	parameters := []any{}
+	for _, match := range pathParameterPattern.FindAllStringSubmatch(route.pattern, -1) {
+		schemaType := "string"
+		if strings.HasPrefix(match[1], "entityID") {
+			schemaType = "integer"
+		}
+		parameters = append(parameters, map[string]any{
+			"in":       "path",
+			"name":     match[1],
+			"required": true,
+			"schema":   map[string]any{"type": schemaType},
+		})
+	}
+	for _, parameter := range route.query {
+		parameters = append(parameters, map[string]any{
+			"description": parameter.description,
+			"in":          "query",
+			"name":        parameter.name,
+			"required":    parameter.required,
+			"schema":      map[string]any{"type": parameter.schemaType},
+		})
+	}
+	parameters = append(parameters, map[string]any{
+		"description": "Comma-separated flag names, e.g. SZ_ENTITY_INCLUDE_ENTITY_NAME, or integers. Defaults to " + flagName(route.defaultFlags) + ".",
+		"explode":     false,
+		"in":          "query",
+		"name":        "flags",
+		"schema":      map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		"style":       "form",
+	})
+	jsonContent := map[string]any{ContentTypeJSON: map[string]any{"schema": map[string]any{"type": "object"}}}
+	responses := map[string]any{
+		"200": map[string]any{"description": "The " + route.operation + " result.", "content": jsonContent},
+		"default": map[string]any{
+			"description": "An error.",
+			"content": map[string]any{
+				ContentTypeProblem: map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Problem"}},
+			},
+		},
+	}
+	if route.withInfo {
+		responses["204"] = map[string]any{"description": "Done, with no SZ_WITH_INFO requested."}
+	}
+	result := map[string]any{
+		"operationId": route.operation,
+		"parameters":  parameters,
+		"responses":   responses,
+		"summary":     route.summary,
+	}
+	if len(route.body) > 0 {
+		result["requestBody"] = map[string]any{
+			"content":     jsonContent,
+			"description": route.body,
+			"required":    true,
+		}
+	}
+	return result
+}
+
+// The name of a flag value, or the value itself if it has no name.
+func flagName(flags int64) string {
+	result := ""
+	for name, value := range FlagNames {
+		if value == flags && (len(result) == 0 || name < result) {
+			result = name
+		}
+	}
+	if len(result) == 0 {
+		return strconv.FormatInt(flags, 10)
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Query parameters shared by several routes.
+var (
+	parameterMaxDegrees = parameter{
+		description: fmt.Sprintf("Maximum number of degrees of separation. Defaults to %d.", DefaultMaxDegrees),
+		name:        "maxDegrees",
+		schemaType:  "integer",
+	}
+	parameterRequiredDataSources = parameter{
+		description: "Comma-separated data source codes, at least one of which must be on the path.",
+		name:        "requiredDataSources",
+		schemaType:  "string",
+	}
+)
+
+var routes = []route{
+	{
+		method:       http.MethodGet,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}",
+		operation:    "GetRecord",
+		summary:      "Get a record.",
+		defaultFlags: senzing.SzRecordDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.GetRecord(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineGetRecord),
+	},
+	{
+		method:       http.MethodPut,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}",
+		operation:    "AddRecord",
+		summary:      "Add or replace a record. Answers 204 unless SZ_WITH_INFO is requested.",
+		withInfo:     true,
+		write:        true,
+		body:         "The record definition JSON.",
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.AddRecord(ctx, args.path["dataSourceCode"], args.path["recordID"], args.body, args.flags)
+		},
+		typed: typed(response.SzEngineAddRecord),
+	},
+	{
+		method:       http.MethodDelete,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}",
+		operation:    "DeleteRecord",
+		summary:      "Delete a record. Answers 204 unless SZ_WITH_INFO is requested.",
+		withInfo:     true,
+		write:        true,
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.DeleteRecord(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineDeleteRecord),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}/entity",
+		operation:    "GetEntityByRecordID",
+		summary:      "Get the entity a record belongs to.",
+		defaultFlags: senzing.SzEntityDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.GetEntityByRecordID(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineGetEntityByRecordID),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}/interesting",
+		operation:    "FindInterestingEntitiesByRecordID",
+		summary:      "Find entities of interest to the entity of a record.",
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.FindInterestingEntitiesByRecordID(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineFindInterestingEntitiesByRecordID),
+	},
+	{
+		method:       http.MethodPost,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}/reevaluate",
+		operation:    "ReevaluateRecord",
+		summary:      "Reevaluate a record. Answers 204 unless SZ_WITH_INFO is requested.",
+		withInfo:     true,
+		write:        true,
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.ReevaluateRecord(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineReevaluateRecord),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}/why",
+		operation:    "WhyRecordInEntity",
+		summary:      "Explain why a record belongs to its entity.",
+		defaultFlags: senzing.SzWhyRecordInEntityIDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.WhyRecordInEntity(ctx, args.path["dataSourceCode"], args.path["recordID"], args.flags)
+		},
+		typed: typed(response.SzEngineWhyRecordInEntity),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/data-sources/{dataSourceCode}/records/{recordID}/why/{dataSourceCode2}/{recordID2}",
+		operation:    "WhyRecords",
+		summary:      "Explain how two records relate.",
+		defaultFlags: senzing.SzWhyRecordsDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.WhyRecords(ctx, args.path["dataSourceCode"], args.path["recordID"], args.path["dataSourceCode2"], args.path["recordID2"], args.flags)
+		},
+		typed: typed(response.SzEngineWhyRecords),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/entities/{entityID}",
+		operation:    "GetEntityByEntityID",
+		summary:      "Get an entity.",
+		defaultFlags: senzing.SzEntityDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.GetEntityByEntityID(ctx, entityID, args.flags)
+		},
+		typed: typed(response.SzEngineGetEntityByEntityID),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/entities/{entityID}/how",
+		operation:    "HowEntityByEntityID",
+		summary:      "Explain how an entity was resolved.",
+		defaultFlags: senzing.SzHowEntityDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.HowEntityByEntityID(ctx, entityID, args.flags)
+		},
+		typed: typed(response.SzEngineHowEntityByEntityID),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/entities/{entityID}/interesting",
+		operation:    "FindInterestingEntitiesByEntityID",
+		summary:      "Find entities of interest to an entity.",
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, args.flags)
+		},
+		typed: typed(response.SzEngineFindInterestingEntitiesByEntityID),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/entities/{entityID}/path/{entityID2}",
+		operation:    "FindPathByEntityID",
+		summary:      "Find a path of relationships between two entities.",
+		defaultFlags: senzing.SzFindPathDefaultFlags,
+		query: []parameter{
+			parameterMaxDegrees,
+			{description: "Comma-separated entity IDs to avoid.", name: "avoidEntityIDs", schemaType: "string"},
+			parameterRequiredDataSources,
+		},
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			entityID2 := args.integer("entityID2", 0)
+			maxDegrees := args.integer("maxDegrees", DefaultMaxDegrees)
+			avoidEntityIDs := args.entityIDs("avoidEntityIDs")
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.FindPathByEntityID(ctx, entityID, entityID2, maxDegrees, avoidEntityIDs, args.dataSources("requiredDataSources"), args.flags)
+		},
+		typed: typed(response.SzEngineFindPathByEntityID),
+	},
+	{
+		method:       http.MethodPost,
+		pattern:      "/entities/{entityID}/reevaluate",
+		operation:    "ReevaluateEntity",
+		summary:      "Reevaluate an entity. Answers 204 unless SZ_WITH_INFO is requested.",
+		withInfo:     true,
+		write:        true,
+		defaultFlags: senzing.SzNoFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.ReevaluateEntity(ctx, entityID, args.flags)
+		},
+		typed: typed(response.SzEngineReevaluateEntity),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/entities/{entityID}/why/{entityID2}",
+		operation:    "WhyEntities",
+		summary:      "Explain how two entities relate.",
+		defaultFlags: senzing.SzWhyEntitiesDefaultFlags,
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityID := args.integer("entityID", 0)
+			entityID2 := args.integer("entityID2", 0)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.WhyEntities(ctx, entityID, entityID2, args.flags)
+		},
+		typed: typed(response.SzEngineWhyEntities),
+	},
+	{
+		method:       http.MethodGet,
+		pattern:      "/network",
+		operation:    "FindNetworkByEntityID",
+		summary:      "Find the network of relationships among entities.",
+		defaultFlags: senzing.SzFindNetworkDefaultFlags,
+		query: []parameter{
+			{description: "Comma-separated entity IDs.", name: "entityIDs", required: true, schemaType: "string"},
+			parameterMaxDegrees,
+			{description: fmt.Sprintf("Degrees of relationship to build out from the entities. Defaults to %d.", DefaultBuildOutDegree), name: "buildOutDegree", schemaType: "integer"},
+			{description: fmt.Sprintf("Maximum number of entities to build out. Defaults to %d.", DefaultBuildOutMaxEntities), name: "buildOutMaxEntities", schemaType: "integer"},
+		},
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			entityIDs := args.entityIDs("entityIDs")
+			maxDegrees := args.integer("maxDegrees", DefaultMaxDegrees)
+			buildOutDegree := args.integer("buildOutDegree", DefaultBuildOutDegree)
+			buildOutMaxEntities := args.integer("buildOutMaxEntities", DefaultBuildOutMaxEntities)
+			if args.err != nil {
+				return "", args.err
+			}
+			return szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, args.flags)
+		},
+		typed: typed(response.SzEngineFindNetworkByEntityID),
+	},
+	{
+		method:       http.MethodPost,
+		pattern:      "/search",
+		operation:    "SearchByAttributes",
+		summary:      "Search for entities by attributes.",
+		body:         "The attributes JSON, e.g. {\"NAME_FULL\": \"Robert Smith\"}.",
+		defaultFlags: senzing.SzSearchByAttributesDefaultFlags,
+		query: []parameter{
+			{description: "The search profile. Defaults to the engine's.", name: "searchProfile", schemaType: "string"},
+		},
+		handle: func(ctx context.Context, szEngine senzing.SzEngine, args *arguments) (string, error) {
+			return szEngine.SearchByAttributes(ctx, args.body, args.query["searchProfile"], args.flags)
+		},
+		typed: typed(response.SzEngineSearchByAttributes),
+	},
+}
+
+// ----------------------------------------------------------------------------
+// Methods - arguments
+// ----------------------------------------------------------------------------
+
+// Comma-separated data source codes as {"DATA_SOURCES":[...]}, or "" if the parameter is empty.
+func (args *arguments) dataSources(name string) string {
+	values := splitList(args.query[name])
+	if len(values) == 0 {
+		return ""
+	}
+	result, _ := json.Marshal(map[string][]string{"DATA_SOURCES": values})
+	return string(result)
+}
+
+// Comma-separated entity IDs as {"ENTITIES":[{"ENTITY_ID":n},...]}, or "" if the parameter is empty.
+func (args *arguments) entityIDs(name string) string {
+	entities := []map[string]int64{}
+	for _, value := range splitList(args.query[name]) {
+		entityID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			args.fail(name, value)
+			return ""
+		}
+		entities = append(entities, map[string]int64{"ENTITY_ID": entityID})
+	}
+	if len(entities) == 0 {
+		return ""
+	}
+	result, _ := json.Marshal(map[string]any{"ENTITIES": entities})
+	return string(result)
+}
+
+func (args *arguments) fail(name string, value string) {
+	if args.err == nil {
+		args.err = errors.Join(szerror.ErrSzBadInput, fmt.Errorf("invalid %s %q", name, value))
+	}
+}
+
+// A path or query parameter as an integer, or fallback if it is absent.
+func (args *arguments) integer(name string, fallback int64) int64 {
+	value, ok := args.path[name]
+	if !ok {
+		value, ok = args.query[name]
+	}
+	if !ok || len(value) == 0 {
+		return fallback
+	}
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		args.fail(name, value)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func splitList(list string) []string {
+	result := []string{}
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); len(value) > 0 {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// Adapt a function of the response package to route.typed.
+func typed[T any](parse func(ctx context.Context, jsonString string) (T, error)) func(ctx context.Context, jsonString string) (any, error) {
+	return func(ctx context.Context, jsonString string) (any, error) {
+		return parse(ctx, jsonString)
+	}
+}
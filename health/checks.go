@@ -0,0 +1,212 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/license"
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewActiveConfigCheck function returns a liveness and readiness check calling SzEngine.GetActiveConfigID.
+
+Input
+  - szEngine: An initialized SzEngine.
+*/
+func NewActiveConfigCheck(szEngine senzing.SzEngine) *Check {
+	return &Check{
+		Name:      CheckActiveConfig,
+		Liveness:  true,
+		Readiness: true,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			configID, err := szEngine.GetActiveConfigID(ctx)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			return StatusPass, "", map[string]any{"configId": configID}, nil
+		},
+	}
+}
+
+/*
+The NewChecker function returns a Checker with the standard set of checks.
+
+Input
+  - szDiagnostic: An initialized SzDiagnostic.
+  - szProduct: An initialized SzProduct.
+  - szEngine: An initialized SzEngine.
+  - recordCounter: Counts the records compared with the license record limit. May be nil.
+*/
+func NewChecker(szDiagnostic senzing.SzDiagnostic, szProduct senzing.SzProduct, szEngine senzing.SzEngine, recordCounter license.RecordCounter) *Checker {
+	return &Checker{
+		Checks: []*Check{
+			NewVersionCheck(szProduct),
+			NewActiveConfigCheck(szEngine),
+			NewDatastoreInfoCheck(szDiagnostic),
+			NewDatastorePerformanceCheck(szDiagnostic, DefaultDatastorePerformanceWindow, 1),
+			NewLicenseCheck(szProduct, DefaultLicenseWarningDays, recordCounter, DefaultLicenseWarningUsage),
+		},
+	}
+}
+
+/*
+The NewDatastoreInfoCheck function returns a readiness check calling SzDiagnostic.GetDatastoreInfo.
+
+Input
+  - szDiagnostic: An initialized SzDiagnostic.
+*/
+func NewDatastoreInfoCheck(szDiagnostic senzing.SzDiagnostic) *Check {
+	return &Check{
+		Name:      CheckDatastoreInfo,
+		Readiness: true,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			datastoreInfoJSON, err := szDiagnostic.GetDatastoreInfo(ctx)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			datastoreInfo, err := response.SzDiagnosticGetDatastoreInfo(ctx, datastoreInfoJSON)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			dataStores := []map[string]any{}
+			for _, dataStore := range datastoreInfo.DataStores {
+				dataStores = append(dataStores, map[string]any{"id": dataStore.ID, "type": dataStore.Type})
+			}
+			if len(dataStores) == 0 {
+				return StatusFail, "no datastores reported", nil, nil
+			}
+			return StatusPass, "", map[string]any{"dataStores": dataStores}, nil
+		},
+	}
+}
+
+/*
+The NewDatastorePerformanceCheck function returns a readiness check calling SzDiagnostic.CheckDatastorePerformance.
+Because the probe writes to the datastore, its result is cached for DefaultDatastorePerformanceTTL.
+
+Input
+  - szDiagnostic: An initialized SzDiagnostic.
+  - secondsToRun: How long CheckDatastorePerformance runs. Keep this short.
+  - minimumRecordsInserted: Fewer inserted records than this is reported as StatusWarn.
+*/
+func NewDatastorePerformanceCheck(szDiagnostic senzing.SzDiagnostic, secondsToRun int, minimumRecordsInserted int64) *Check {
+	return &Check{
+		Name:      CheckDatastorePerformance,
+		Readiness: true,
+		CacheTTL:  DefaultDatastorePerformanceTTL,
+		Timeout:   time.Duration(secondsToRun)*time.Second + DefaultTimeout,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			performanceJSON, err := szDiagnostic.CheckDatastorePerformance(ctx, secondsToRun)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			performance, err := response.SzDiagnosticCheckDatastorePerformance(ctx, performanceJSON)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			details := map[string]any{
+				"insertTime":         performance.InsertTime,
+				"numRecordsInserted": performance.NumRecordsInserted,
+			}
+			if performance.NumRecordsInserted < minimumRecordsInserted {
+				return StatusWarn, fmt.Sprintf("inserted %d records; expected at least %d", performance.NumRecordsInserted, minimumRecordsInserted), details, nil
+			}
+			return StatusPass, "", details, nil
+		},
+	}
+}
+
+/*
+The NewLicenseCheck function returns a readiness check calling SzProduct.GetLicense.
+An expired license, or one whose record limit is reached, is StatusFail.
+One expiring within warningDays, or whose record count is at least warningUsage of the record limit, is StatusWarn.
+
+Input
+  - szProduct: An initialized SzProduct.
+  - warningDays: Number of days before expiry at which to start warning.
+  - recordCounter: Counts the records compared with the license record limit. If nil, the record count is not checked.
+  - warningUsage: Fraction of the record limit at which to start warning.
+*/
+func NewLicenseCheck(szProduct senzing.SzProduct, warningDays int, recordCounter license.RecordCounter, warningUsage float64) *Check {
+	return &Check{
+		Name:      CheckLicense,
+		Readiness: true,
+		CacheTTL:  DefaultLicenseTTL,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			licenseJSON, err := szProduct.GetLicense(ctx)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			parsedLicense, err := license.Parse(ctx, licenseJSON)
+			if err != nil {
+				return StatusWarn, err.Error(), nil, nil
+			}
+			now := time.Now()
+			daysRemaining := parsedLicense.DaysRemaining(now)
+			details := map[string]any{
+				"daysRemaining": daysRemaining,
+				"expireDate":    parsedLicense.ExpireDate.Format(license.DateLayout),
+				"licenseType":   parsedLicense.LicenseType,
+				"recordLimit":   parsedLicense.RecordLimit,
+			}
+			if parsedLicense.Expired(now) {
+				return StatusFail, "license has expired", details, nil
+			}
+			if recordCounter != nil && parsedLicense.RecordLimit > 0 {
+				recordCount, err := recordCounter.RecordCount(ctx)
+				if err != nil {
+					return StatusWarn, fmt.Sprintf("cannot count records: %v", err), details, nil
+				}
+				headroom := parsedLicense.Headroom(recordCount)
+				usage := float64(recordCount) / float64(parsedLicense.RecordLimit)
+				details["headroom"] = headroom
+				details["recordCount"] = recordCount
+				details["usage"] = usage
+				switch {
+				case headroom == 0:
+					return StatusFail, fmt.Sprintf("license record limit of %d reached", parsedLicense.RecordLimit), details, nil
+				case usage >= warningUsage:
+					return StatusWarn, fmt.Sprintf("%d records of the license record limit of %d", recordCount, parsedLicense.RecordLimit), details, nil
+				}
+			}
+			if daysRemaining <= warningDays {
+				return StatusWarn, fmt.Sprintf("license expires in %d days", daysRemaining), details, nil
+			}
+			return StatusPass, "", details, nil
+		},
+	}
+}
+
+/*
+The NewVersionCheck function returns a liveness check calling SzProduct.GetVersion.
+
+Input
+  - szProduct: An initialized SzProduct.
+*/
+func NewVersionCheck(szProduct senzing.SzProduct) *Check {
+	return &Check{
+		Name:     CheckVersion,
+		Liveness: true,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			versionJSON, err := szProduct.GetVersion(ctx)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			version, err := response.SzProductGetVersion(ctx, versionJSON)
+			if err != nil {
+				return StatusFail, "", nil, err
+			}
+			return StatusPass, "", map[string]any{
+				"buildVersion": version.BuildVersion,
+				"version":      version.Version,
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,4 @@
+/*
+The health package combines SzDiagnostic, SzProduct and SzEngine probes into liveness and readiness checks.
+*/
+package health
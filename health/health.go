@@ -0,0 +1,193 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Check
+// ----------------------------------------------------------------------------
+
+/*
+The Run method returns the result of the check.
+A result younger than CacheTTL is returned without calling the probe.
+At most one probe call is in flight at a time; concurrent callers share its result.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (check *Check) Run(ctx context.Context) Result {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	cacheTTL := check.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultCacheTTL
+	}
+
+	check.mutex.Lock()
+	if check.cached != nil && time.Since(check.cached.Time) < cacheTTL {
+		result := *check.cached
+		result.Cached = true
+		check.mutex.Unlock()
+		return result
+	}
+	if check.running == nil {
+		check.running = make(chan struct{})
+		go check.probe(context.WithoutCancel(ctx), timeout, check.running)
+	}
+	running := check.running
+	check.mutex.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-running:
+		check.mutex.Lock()
+		defer check.mutex.Unlock()
+		return *check.cached
+	case <-timer.C:
+		return check.failure(fmt.Errorf("timed out after %s", timeout))
+	case <-ctx.Done():
+		return check.failure(ctx.Err())
+	}
+}
+
+func (check *Check) failure(err error) Result {
+	return Result{
+		Name:   check.Name,
+		Status: StatusFail,
+		Error:  err.Error(),
+		Time:   time.Now(),
+	}
+}
+
+func (check *Check) probe(ctx context.Context, timeout time.Duration, running chan struct{}) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+	status, message, details, err := check.Probe(ctx)
+	result := Result{
+		Name:       check.Name,
+		Status:     status,
+		Message:    message,
+		Details:    details,
+		DurationMS: time.Since(start).Milliseconds(),
+		Time:       time.Now(),
+	}
+	if len(result.Status) == 0 {
+		result.Status = StatusPass
+	}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+
+	check.mutex.Lock()
+	check.cached = &result
+	check.running = nil
+	check.mutex.Unlock()
+	close(running)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Checker
+// ----------------------------------------------------------------------------
+
+/*
+The Handler method returns an http.Handler serving JSON reports compatible with Kubernetes probes.
+Requests for paths ending in PathLiveness or PathReadiness get the corresponding report;
+any other path gets the report of all checks.
+The response status is 200 unless the report's status is StatusFail, in which case it is 503.
+*/
+func (checker *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet && request.Method != http.MethodHead {
+			responseWriter.Header().Set("Allow", "GET, HEAD")
+			http.Error(responseWriter, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		var report Report
+		switch {
+		case strings.HasSuffix(request.URL.Path, PathLiveness):
+			report = checker.Liveness(request.Context())
+		case strings.HasSuffix(request.URL.Path, PathReadiness):
+			report = checker.Readiness(request.Context())
+		default:
+			report = checker.Health(request.Context())
+		}
+		statusCode := http.StatusOK
+		if report.Status == StatusFail {
+			statusCode = http.StatusServiceUnavailable
+		}
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.Header().Set("Cache-Control", "no-store")
+		responseWriter.WriteHeader(statusCode)
+		if request.Method == http.MethodGet {
+			_ = json.NewEncoder(responseWriter).Encode(report)
+		}
+	})
+}
+
+// The Health method runs every check.
+func (checker *Checker) Health(ctx context.Context) Report {
+	return checker.run(ctx, func(check *Check) bool { return true })
+}
+
+// The Liveness method runs the checks marked Liveness.
+func (checker *Checker) Liveness(ctx context.Context) Report {
+	return checker.run(ctx, func(check *Check) bool { return check.Liveness })
+}
+
+// The Readiness method runs the checks marked Readiness.
+func (checker *Checker) Readiness(ctx context.Context) Report {
+	return checker.run(ctx, func(check *Check) bool { return check.Readiness })
+}
+
+func (checker *Checker) run(ctx context.Context, include func(*Check) bool) Report {
+	checks := []*Check{}
+	for _, check := range checker.Checks {
+		if include(check) {
+			checks = append(checks, check)
+		}
+	}
+	results := make([]Result, len(checks))
+	done := make(chan struct{})
+	for index, check := range checks {
+		go func(index int, check *Check) {
+			results[index] = check.Run(ctx)
+			done <- struct{}{}
+		}(index, check)
+	}
+	for range checks {
+		<-done
+	}
+
+	result := Report{
+		Checks: results,
+		Status: StatusPass,
+		Time:   time.Now(),
+	}
+	for _, checkResult := range results {
+		result.Status = worst(result.Status, checkResult.Status)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func worst(status1 Status, status2 Status) Status {
+	rank := map[Status]int{StatusPass: 0, StatusWarn: 1, StatusFail: 2}
+	if rank[status2] > rank[status1] {
+		return status2
+	}
+	return status1
+}
@@ -0,0 +1,182 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/license"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzDiagnostic struct {
+	senzing.SzDiagnostic
+	datastoreErr error
+}
+
+func (diagnostic *mockSzDiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
+	_ = ctx
+	_ = secondsToRun
+	return `{"numRecordsInserted":76667,"insertTime":1000}`, nil
+}
+
+func (diagnostic *mockSzDiagnostic) GetDatastoreInfo(ctx context.Context) (string, error) {
+	_ = ctx
+	return `{"dataStores":[{"id":"CORE","type":"sqlite3","location":"/tmp/sqlite/G2C.db"}]}`, diagnostic.datastoreErr
+}
+
+type mockSzEngine struct {
+	senzing.SzEngine
+}
+
+func (engine *mockSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+	return 4019066234, nil
+}
+
+type mockSzProduct struct {
+	senzing.SzProduct
+	expireDate string
+}
+
+func (product *mockSzProduct) GetLicense(ctx context.Context) (string, error) {
+	_ = ctx
+	return `{"customer":"Test","contract":"Test","issueDate":"2024-06-10","licenseType":"EVAL","licenseLevel":"STANDARD","billing":"MONTHLY","expireDate":"` + product.expireDate + `","recordLimit":50000}`, nil
+}
+
+func (product *mockSzProduct) GetVersion(ctx context.Context) (string, error) {
+	_ = ctx
+	return `{"PRODUCT_NAME":"Senzing API","VERSION":"4.0.0","BUILD_VERSION":"4.0.0.24162","BUILD_DATE":"2024-06-10","BUILD_NUMBER":"2024_06_10__14_29","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"},"SCHEMA_VERSION":{"ENGINE_SCHEMA_VERSION":"4.0","MINIMUM_REQUIRED_SCHEMA_VERSION":"4.0","MAXIMUM_REQUIRED_SCHEMA_VERSION":"4.99"}}`, nil
+}
+
+type mockRecordCounter struct {
+	recordCount int64
+	err         error
+}
+
+func (counter *mockRecordCounter) RecordCount(ctx context.Context) (int64, error) {
+	_ = ctx
+	return counter.recordCount, counter.err
+}
+
+func newTestChecker(expireDate string, datastoreErr error, recordCounter license.RecordCounter) *Checker {
+	return NewChecker(&mockSzDiagnostic{datastoreErr: datastoreErr}, &mockSzProduct{expireDate: expireDate}, &mockSzEngine{}, recordCounter)
+}
+
+func daysFromNow(days int) string {
+	return time.Now().AddDate(0, 0, days).Format(time.DateOnly)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestHealth_Checker_Readiness(test *testing.T) {
+	ctx := context.TODO()
+	testCases := []struct {
+		name          string
+		expireDate    string
+		datastoreErr  error
+		recordCounter license.RecordCounter
+		expected      Status
+	}{
+		{name: "pass", expireDate: daysFromNow(365), expected: StatusPass},
+		{name: "license-expiring", expireDate: daysFromNow(5), expected: StatusWarn},
+		{name: "license-expired", expireDate: daysFromNow(-5), expected: StatusFail},
+		{name: "license-unparseable", expireDate: "someday", expected: StatusWarn},
+		{name: "records-below-warning", expireDate: daysFromNow(365), recordCounter: &mockRecordCounter{recordCount: 1000}, expected: StatusPass},
+		{name: "records-near-limit", expireDate: daysFromNow(365), recordCounter: &mockRecordCounter{recordCount: 46000}, expected: StatusWarn},
+		{name: "records-at-limit", expireDate: daysFromNow(365), recordCounter: &mockRecordCounter{recordCount: 50000}, expected: StatusFail},
+		{name: "records-uncountable", expireDate: daysFromNow(365), recordCounter: &mockRecordCounter{err: errors.New("no stats")}, expected: StatusWarn},
+		{name: "datastore-error", expireDate: daysFromNow(365), datastoreErr: errors.New("connection refused"), expected: StatusFail},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			report := newTestChecker(testCase.expireDate, testCase.datastoreErr, testCase.recordCounter).Readiness(ctx)
+			assert.Equal(test, testCase.expected, report.Status, "%+v", report)
+			assert.Len(test, report.Checks, 4)
+		})
+	}
+}
+
+func TestHealth_Checker_Liveness(test *testing.T) {
+	ctx := context.TODO()
+	report := newTestChecker(daysFromNow(-5), nil, nil).Liveness(ctx)
+	assert.Equal(test, StatusPass, report.Status)
+	require.Len(test, report.Checks, 2)
+	assert.Equal(test, CheckVersion, report.Checks[0].Name)
+	assert.Equal(test, "4.0.0", report.Checks[0].Details["version"])
+}
+
+func TestHealth_Check_Run_cached(test *testing.T) {
+	ctx := context.TODO()
+	var calls atomic.Int32
+	check := &Check{
+		Name:     "counter",
+		CacheTTL: time.Hour,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			calls.Add(1)
+			return "", "", nil, nil
+		},
+	}
+	first := check.Run(ctx)
+	second := check.Run(ctx)
+	assert.Equal(test, StatusPass, first.Status)
+	assert.False(test, first.Cached)
+	assert.True(test, second.Cached)
+	assert.Equal(test, int32(1), calls.Load())
+}
+
+func TestHealth_Check_Run_timeout(test *testing.T) {
+	ctx := context.TODO()
+	release := make(chan struct{})
+	defer close(release)
+	check := &Check{
+		Name:    "slow",
+		Timeout: 10 * time.Millisecond,
+		Probe: func(ctx context.Context) (Status, string, map[string]any, error) {
+			<-release
+			return StatusPass, "", nil, nil
+		},
+	}
+	result := check.Run(ctx)
+	assert.Equal(test, StatusFail, result.Status)
+	assert.Contains(test, result.Error, "timed out")
+}
+
+func TestHealth_Checker_Handler(test *testing.T) {
+	server := httptest.NewServer(newTestChecker(daysFromNow(-5), nil, nil).Handler())
+	defer server.Close()
+	testCases := []struct {
+		path     string
+		expected int
+	}{
+		{path: PathLiveness, expected: http.StatusOK},
+		{path: PathReadiness, expected: http.StatusServiceUnavailable},
+		{path: PathHealth, expected: http.StatusServiceUnavailable},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.path, func(test *testing.T) {
+			response, err := http.Get(server.URL + testCase.path)
+			require.NoError(test, err)
+			defer response.Body.Close()
+			assert.Equal(test, testCase.expected, response.StatusCode)
+			assert.Equal(test, "application/json", response.Header.Get("Content-Type"))
+			report := Report{}
+			require.NoError(test, json.NewDecoder(response.Body).Decode(&report))
+			assert.NotEmpty(test, report.Checks)
+		})
+	}
+
+	response, err := http.Post(server.URL+PathLiveness, "application/json", nil)
+	require.NoError(test, err)
+	defer response.Body.Close()
+	assert.Equal(test, http.StatusMethodNotAllowed, response.StatusCode)
+}
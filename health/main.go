@@ -0,0 +1,97 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Status is the outcome of a health check.
+type Status string
+
+// Probe is the function a Check calls to determine health.
+// An error is reported as StatusFail.
+type Probe func(ctx context.Context) (Status, string, map[string]any, error)
+
+// A Check is a single, named health probe with a timeout and a cached result.
+type Check struct {
+	// CacheTTL is how long a result is reused before the probe is called again.
+	CacheTTL time.Duration
+	// Liveness is true if the check contributes to the liveness report.
+	Liveness bool
+	Name     string
+	Probe    Probe
+	// Readiness is true if the check contributes to the readiness report.
+	Readiness bool
+	// Timeout bounds how long the probe may run. Defaults to DefaultTimeout.
+	Timeout time.Duration
+
+	cached  *Result
+	mutex   sync.Mutex
+	running chan struct{}
+}
+
+// A Checker runs a set of checks.
+type Checker struct {
+	Checks []*Check
+}
+
+// Report is the combined result of several checks.
+type Report struct {
+	Checks []Result  `json:"checks"`
+	Status Status    `json:"status"`
+	Time   time.Time `json:"time"`
+}
+
+// Result is the outcome of one Check.
+type Result struct {
+	Cached     bool           `json:"cached"`
+	Details    map[string]any `json:"details,omitempty"`
+	DurationMS int64          `json:"durationMs"`
+	Error      string         `json:"error,omitempty"`
+	Message    string         `json:"message,omitempty"`
+	Name       string         `json:"name"`
+	Status     Status         `json:"status"`
+	Time       time.Time      `json:"time"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Names of the standard checks.
+const (
+	CheckActiveConfig         = "activeConfig"
+	CheckDatastoreInfo        = "datastoreInfo"
+	CheckDatastorePerformance = "datastorePerformance"
+	CheckLicense              = "license"
+	CheckVersion              = "version"
+)
+
+// URL paths served by Checker.Handler().
+const (
+	PathHealth    = "/healthz"
+	PathLiveness  = "/livez"
+	PathReadiness = "/readyz"
+)
+
+// Default values.
+const (
+	DefaultCacheTTL                   = 10 * time.Second
+	DefaultDatastorePerformanceTTL    = 5 * time.Minute
+	DefaultDatastorePerformanceWindow = 1
+	DefaultLicenseTTL                 = time.Hour
+	DefaultLicenseWarningDays         = 30
+	DefaultLicenseWarningUsage        = 0.9
+	DefaultTimeout                    = 5 * time.Second
+)
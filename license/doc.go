@@ -0,0 +1,4 @@
+/*
+The license package interprets the result of SzProduct.GetLicense and warns before the license expires or its record limit is reached.
+*/
+package license
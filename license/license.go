@@ -0,0 +1,380 @@
+package license
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/enginestats"
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - EventType
+// ----------------------------------------------------------------------------
+
+// The String method returns the name of the event type.
+func (eventType EventType) String() string {
+	result, ok := eventTypeNames[eventType]
+	if !ok {
+		result = fmt.Sprintf("EventType(%d)", int(eventType))
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods - License
+// ----------------------------------------------------------------------------
+
+/*
+The DaysRemaining method returns the number of whole days the license remains valid.
+The license is valid through the end of its expiry date; 0 means it expires today
+and a negative number means it has expired.
+
+Input
+  - now: The time to measure from.
+*/
+func (license *License) DaysRemaining(now time.Time) int {
+	return int(math.Floor(license.expiresAt().Sub(now).Hours() / 24))
+}
+
+/*
+The Expired method reports whether the license has expired.
+
+Input
+  - now: The time to compare with.
+*/
+func (license *License) Expired(now time.Time) bool {
+	return !now.Before(license.expiresAt())
+}
+
+/*
+The Headroom method returns how many more records may be added.
+It returns -1 if the license has no record limit.
+
+Input
+  - recordCount: The number of records in the datastore.
+*/
+func (license *License) Headroom(recordCount int64) int64 {
+	if license.RecordLimit <= 0 {
+		return -1
+	}
+	return max(license.RecordLimit-recordCount, 0)
+}
+
+func (license *License) expiresAt() time.Time {
+	return license.ExpireDate.AddDate(0, 0, 1)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Monitor
+// ----------------------------------------------------------------------------
+
+/*
+The Check method gets the license and record count, updates the monitor's Status,
+and returns the events for thresholds crossed since the previous check.
+Each threshold is reported once per license; a renewed license resets them.
+Events are also passed to Callback, if set.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (monitor *Monitor) Check(ctx context.Context) (*Status, []Event, error) {
+	licenseJSON, err := monitor.SzProduct.GetLicense(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	license, err := Parse(ctx, licenseJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	status := &Status{
+		DaysRemaining: license.DaysRemaining(now),
+		Expired:       license.Expired(now),
+		Headroom:      -1,
+		License:       license,
+		Time:          now,
+	}
+	if monitor.RecordCounter != nil {
+		status.RecordCount, err = monitor.RecordCounter.RecordCount(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		status.Headroom = license.Headroom(status.RecordCount)
+		if license.RecordLimit > 0 {
+			status.Usage = float64(status.RecordCount) / float64(license.RecordLimit)
+			status.LimitReached = status.Usage >= monitor.hardLimit()
+		}
+	}
+
+	monitor.mutex.Lock()
+	if monitor.emitted == nil || !monitor.expireDate.Equal(license.ExpireDate) {
+		monitor.emitted = map[string]bool{}
+		monitor.expireDate = license.ExpireDate
+	}
+	events := monitor.events(status)
+	monitor.status = status
+	monitor.mutex.Unlock()
+
+	if monitor.Callback != nil {
+		for _, event := range events {
+			monitor.Callback(ctx, event)
+		}
+	}
+	return status, events, nil
+}
+
+// The LimitReached method reports whether the most recent check found the license expired or its hard limit reached.
+func (monitor *Monitor) LimitReached() bool {
+	status := monitor.Status()
+	return status != nil && (status.Expired || status.LimitReached)
+}
+
+/*
+The Start method checks the license every Interval, starting immediately, until ctx is cancelled.
+Events are sent on the returned channel, which is closed when ctx is cancelled.
+A failed check is sent as an Event of type EventError.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (monitor *Monitor) Start(ctx context.Context) chan Event {
+	result := make(chan Event)
+	interval := monitor.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	go func() {
+		defer close(result)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			_, events, err := monitor.Check(ctx)
+			if err != nil {
+				events = []Event{{Type: EventError, Error: err, Message: err.Error()}}
+			}
+			for _, event := range events {
+				select {
+				case result <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return result
+}
+
+// The Status method returns the result of the most recent check, or nil if there has been none.
+func (monitor *Monitor) Status() *Status {
+	monitor.mutex.RLock()
+	defer monitor.mutex.RUnlock()
+	return monitor.status
+}
+
+func (monitor *Monitor) events(status *Status) []Event {
+	result := []Event{}
+	newEvent := func(eventType EventType, threshold float64, message string) Event {
+		return Event{
+			Type:          eventType,
+			Threshold:     threshold,
+			Message:       message,
+			DaysRemaining: status.DaysRemaining,
+			RecordCount:   status.RecordCount,
+			RecordLimit:   status.License.RecordLimit,
+		}
+	}
+
+	// Expiry: report only the tightest threshold crossed.
+
+	daysThresholds := monitor.DaysThresholds
+	if daysThresholds == nil {
+		daysThresholds = DefaultDaysThresholds
+	}
+	daysThresholds = append([]int{}, daysThresholds...)
+	sort.Sort(sort.Reverse(sort.IntSlice(daysThresholds)))
+	if status.Expired {
+		if !monitor.emitted["expired"] {
+			result = append(result, newEvent(EventExpired, 0, fmt.Sprintf("license expired on %s", status.License.ExpireDate.Format(DateLayout))))
+		}
+		monitor.emitted["expired"] = true
+	} else {
+		var crossed *Event
+		for _, threshold := range daysThresholds {
+			key := fmt.Sprintf("days:%d", threshold)
+			if status.DaysRemaining > threshold || monitor.emitted[key] {
+				continue
+			}
+			monitor.emitted[key] = true
+			event := newEvent(EventExpiring, float64(threshold), fmt.Sprintf("license expires in %d days, on %s", status.DaysRemaining, status.License.ExpireDate.Format(DateLayout)))
+			crossed = &event
+		}
+		if crossed != nil {
+			result = append(result, *crossed)
+		}
+	}
+
+	// Capacity: report only the highest threshold crossed.
+
+	if status.License.RecordLimit <= 0 || monitor.RecordCounter == nil {
+		return result
+	}
+	capacityThresholds := monitor.CapacityThresholds
+	if capacityThresholds == nil {
+		capacityThresholds = DefaultCapacityThresholds
+	}
+	capacityThresholds = append([]float64{}, capacityThresholds...)
+	sort.Float64s(capacityThresholds)
+	var crossed *Event
+	for _, threshold := range capacityThresholds {
+		key := fmt.Sprintf("capacity:%g", threshold)
+		if status.Usage < threshold || monitor.emitted[key] {
+			continue
+		}
+		monitor.emitted[key] = true
+		event := newEvent(EventCapacity, threshold, fmt.Sprintf("%d of %d licensed records used (%.0f%%)", status.RecordCount, status.License.RecordLimit, status.Usage*100))
+		crossed = &event
+	}
+	if crossed != nil {
+		result = append(result, *crossed)
+	}
+	if status.LimitReached && !monitor.emitted["limit"] {
+		monitor.emitted["limit"] = true
+		result = append(result, newEvent(EventLimitReached, monitor.hardLimit(), fmt.Sprintf("record limit reached: %d of %d licensed records used", status.RecordCount, status.License.RecordLimit)))
+	}
+	return result
+}
+
+func (monitor *Monitor) hardLimit() float64 {
+	if monitor.HardLimit <= 0 {
+		return DefaultHardLimit
+	}
+	return monitor.HardLimit
+}
+
+// ----------------------------------------------------------------------------
+// Methods - StatsRecordCounter
+// ----------------------------------------------------------------------------
+
+/*
+The AddSample method updates the count from an enginestats.Sample.
+Use it as, or from, an enginestats.Sampler Callback so that only one component calls GetStats.
+
+Input
+  - ctx: A context to control lifecycle.
+  - sample: A sample taken by an enginestats.Sampler.
+*/
+func (counter *StatsRecordCounter) AddSample(ctx context.Context, sample enginestats.Sample) {
+	_ = ctx
+	if sample.Error != nil {
+		return
+	}
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+	counter.count += sample.Deltas[enginestats.CounterAddedRecords] - sample.Deltas[enginestats.CounterDeletedRecords]
+}
+
+/*
+The RecordCount method returns the estimated number of records in the datastore, an upper bound.
+If SzEngine is set, GetStats is called first to bring the estimate up to date; this requires Cumulative.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (counter *StatsRecordCounter) RecordCount(ctx context.Context) (int64, error) {
+	if counter.SzEngine != nil {
+		if !counter.Cumulative {
+			return 0, errors.Join(szerror.ErrSzConfiguration, errors.New("StatsRecordCounter.SzEngine requires Cumulative, as GetStats resets the engine's counters; use AddSample instead"))
+		}
+		statsJSON, err := counter.SzEngine.GetStats(ctx)
+		if err != nil {
+			return 0, err
+		}
+		stats, err := enginestats.Parse(ctx, statsJSON)
+		if err != nil {
+			return 0, err
+		}
+		counter.Update(stats)
+	}
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+	return counter.count, nil
+}
+
+/*
+The Update method updates the count from the result of one GetStats call.
+
+Input
+  - stats: The parsed result of SzEngine.GetStats().
+*/
+func (counter *StatsRecordCounter) Update(stats *enginestats.Stats) {
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+	deltas := enginestats.Deltas(counter.previous, stats, counter.Cumulative)
+	counter.count += deltas[enginestats.CounterAddedRecords] - deltas[enginestats.CounterDeletedRecords]
+	counter.previous = stats
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewStatsRecordCounter function returns a StatsRecordCounter.
+
+Input
+  - szEngine: The SzEngine to call for statistics, or nil to rely on AddSample() and Update().
+  - cumulative: True when GetStats counters accumulate across calls; RecordCount() requires it if szEngine is set.
+  - recordCount: The number of records in the datastore when counting starts.
+*/
+func NewStatsRecordCounter(szEngine senzing.SzEngine, cumulative bool, recordCount int64) *StatsRecordCounter {
+	return &StatsRecordCounter{
+		Cumulative: cumulative,
+		SzEngine:   szEngine,
+		count:      recordCount,
+	}
+}
+
+/*
+The Parse function parses the JSON returned by SzProduct.GetLicense.
+
+Input
+  - ctx: A context to control lifecycle.
+  - jsonString: The JSON returned by SzProduct.GetLicense().
+*/
+func Parse(ctx context.Context, jsonString string) (*License, error) {
+	productLicense, err := response.SzProductGetLicense(ctx, jsonString)
+	if err != nil {
+		return nil, err
+	}
+	result := &License{
+		Billing:      productLicense.Billing,
+		Contract:     productLicense.Contract,
+		Customer:     productLicense.Customer,
+		LicenseLevel: productLicense.LicenseLevel,
+		LicenseType:  productLicense.LicenseType,
+		RecordLimit:  productLicense.RecordLimit,
+	}
+	result.ExpireDate, err = time.Parse(DateLayout, productLicense.ExpireDate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse expireDate: %w", err)
+	}
+	if len(productLicense.IssueDate) > 0 {
+		result.IssueDate, err = time.Parse(DateLayout, productLicense.IssueDate)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse issueDate: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package license
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/enginestats"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const licenseTemplate = `{"customer":"Test","contract":"Test","issueDate":"2024-06-10","licenseType":"EVAL","licenseLevel":"STANDARD","billing":"MONTHLY","expireDate":"%s","recordLimit":%d}`
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	addedRecords int64
+	stats        string
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordID
+	_ = recordDefinition
+	_ = flags
+	engine.addedRecords++
+	return "", nil
+}
+
+func (engine *mockSzEngine) GetStats(ctx context.Context) (string, error) {
+	_ = ctx
+	return engine.stats, nil
+}
+
+type mockSzProduct struct {
+	senzing.SzProduct
+	license string
+}
+
+func (product *mockSzProduct) GetLicense(ctx context.Context) (string, error) {
+	_ = ctx
+	return product.license, nil
+}
+
+type fixedRecordCounter int64
+
+func (counter *fixedRecordCounter) RecordCount(ctx context.Context) (int64, error) {
+	_ = ctx
+	return int64(*counter), nil
+}
+
+func licenseJSON(days int, recordLimit int64) string {
+	return fmt.Sprintf(licenseTemplate, time.Now().AddDate(0, 0, days).Format(DateLayout), recordLimit)
+}
+
+func eventTypes(events []Event) []EventType {
+	result := []EventType{}
+	for _, event := range events {
+		result = append(result, event.Type)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLicense_Parse(test *testing.T) {
+	ctx := context.TODO()
+	license, err := Parse(ctx, fmt.Sprintf(licenseTemplate, "2025-07-15", 50000))
+	require.NoError(test, err)
+	assert.Equal(test, time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC), license.ExpireDate)
+	assert.Equal(test, int64(50000), license.RecordLimit)
+	assert.Equal(test, 0, license.DaysRemaining(time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)))
+	assert.False(test, license.Expired(time.Date(2025, 7, 15, 23, 0, 0, 0, time.UTC)))
+	assert.True(test, license.Expired(time.Date(2025, 7, 16, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(test, int64(10000), license.Headroom(40000))
+	assert.Equal(test, int64(0), license.Headroom(60000))
+
+	_, err = Parse(ctx, fmt.Sprintf(licenseTemplate, "never", 0))
+	require.Error(test, err)
+}
+
+func TestLicense_Monitor_Check_expiry(test *testing.T) {
+	ctx := context.TODO()
+	product := &mockSzProduct{license: licenseJSON(20, 0)}
+	callbacks := []Event{}
+	monitor := &Monitor{
+		SzProduct: product,
+		Callback: func(ctx context.Context, event Event) {
+			_ = ctx
+			callbacks = append(callbacks, event)
+		},
+	}
+
+	status, events, err := monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(-1), status.Headroom)
+	require.Len(test, events, 1)
+	assert.Equal(test, EventExpiring, events[0].Type)
+	assert.InDelta(test, 30, events[0].Threshold, 0)
+
+	_, events, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, events, "thresholds are reported once")
+
+	product.license = licenseJSON(-1, 0)
+	_, events, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []EventType{EventExpired}, eventTypes(events))
+	assert.True(test, monitor.LimitReached())
+	assert.Len(test, callbacks, 2)
+
+	product.license = licenseJSON(365, 0)
+	_, events, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Empty(test, events)
+	assert.False(test, monitor.LimitReached())
+}
+
+func TestLicense_Monitor_Check_capacity(test *testing.T) {
+	ctx := context.TODO()
+	recordCount := fixedRecordCounter(850)
+	monitor := &Monitor{
+		SzProduct:     &mockSzProduct{license: licenseJSON(365, 1000)},
+		RecordCounter: &recordCount,
+	}
+	status, events, err := monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(150), status.Headroom)
+	require.Equal(test, []EventType{EventCapacity}, eventTypes(events))
+	assert.InDelta(test, 0.80, events[0].Threshold, 0)
+
+	recordCount = 1000
+	_, events, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, []EventType{EventCapacity, EventLimitReached}, eventTypes(events))
+	assert.InDelta(test, 0.95, events[0].Threshold, 0)
+	assert.True(test, monitor.LimitReached())
+}
+
+func TestLicense_Monitor_Start(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	monitor := &Monitor{
+		SzProduct: &mockSzProduct{license: licenseJSON(3, 0)},
+		Interval:  time.Millisecond,
+	}
+	events := monitor.Start(ctx)
+	event := <-events
+	assert.Equal(test, EventExpiring, event.Type)
+	assert.Equal(test, "Expiring", event.Type.String())
+	cancel()
+	for range events {
+	}
+
+	ctx, cancel = context.WithCancel(context.TODO())
+	defer cancel()
+	monitor = &Monitor{SzProduct: &mockSzProduct{license: "{"}}
+	event = <-monitor.Start(ctx)
+	assert.Equal(test, EventError, event.Type)
+	require.Error(test, event.Error)
+}
+
+func TestLicense_NewGuardedSzEngine(test *testing.T) {
+	ctx := context.TODO()
+	recordCount := fixedRecordCounter(0)
+	product := &mockSzProduct{license: licenseJSON(365, 10)}
+	monitor := &Monitor{
+		SzProduct:     product,
+		RecordCounter: &recordCount,
+		HardLimit:     0.9,
+	}
+	engine := &mockSzEngine{}
+	guarded := NewGuardedSzEngine(engine, monitor)
+
+	_, err := guarded.AddRecord(ctx, "TEST", "1", "{}", senzing.SzWithoutInfo)
+	require.NoError(test, err, "no status yet")
+
+	_, _, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	_, err = guarded.AddRecord(ctx, "TEST", "2", "{}", senzing.SzWithoutInfo)
+	require.NoError(test, err)
+
+	recordCount = 9
+	_, _, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	_, err = guarded.AddRecord(ctx, "TEST", "3", "{}", senzing.SzWithoutInfo)
+	require.ErrorIs(test, err, szerror.ErrSzLicense)
+	assert.Equal(test, 9000, szerror.Code(err.Error()))
+
+	product.license = licenseJSON(-1, 10)
+	_, _, err = monitor.Check(ctx)
+	require.NoError(test, err)
+	_, err = guarded.AddRecord(ctx, "TEST", "4", "{}", senzing.SzWithoutInfo)
+	require.ErrorIs(test, err, szerror.ErrSzLicense)
+	assert.Equal(test, 999, szerror.Code(err.Error()))
+	assert.Equal(test, int64(2), engine.addedRecords)
+}
+
+func TestLicense_StatsRecordCounter(test *testing.T) {
+	ctx := context.TODO()
+	engine := &mockSzEngine{stats: `{"workload":{"addedRecords":10,"deletedRecords":3}}`}
+	_, err := NewStatsRecordCounter(engine, false, 100).RecordCount(ctx)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration, "GetStats would reset the counters of other callers")
+	counter := NewStatsRecordCounter(engine, true, 100)
+	actual, err := counter.RecordCount(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(100), actual, "the first cumulative sample is the baseline")
+	engine.stats = `{"workload":{"addedRecords":20,"deletedRecords":5}}`
+	actual, err = counter.RecordCount(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(108), actual)
+
+	counter = NewStatsRecordCounter(nil, false, 100)
+	counter.AddSample(ctx, enginestats.Sample{Deltas: map[string]int64{enginestats.CounterAddedRecords: 5}})
+	actual, err = counter.RecordCount(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(105), actual)
+}
@@ -0,0 +1,141 @@
+package license
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/enginestats"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// EventType identifies the kind of Event emitted by a Monitor.
+type EventType int
+
+// An Event is emitted by a Monitor the first time a threshold is crossed.
+type Event struct {
+	DaysRemaining int
+	Error         error
+	Message       string
+	RecordCount   int64
+	RecordLimit   int64
+	// Threshold is the number of days for EventExpiring, or the fraction of the record limit for EventCapacity.
+	Threshold float64
+	Type      EventType
+}
+
+// License is the parsed result of SzProduct.GetLicense.
+type License struct {
+	Billing      string
+	Contract     string
+	Customer     string
+	ExpireDate   time.Time
+	IssueDate    time.Time
+	LicenseLevel string
+	LicenseType  string
+	// RecordLimit is the maximum number of records. Zero means unlimited.
+	RecordLimit int64
+}
+
+// A Monitor periodically checks the license and emits events as thresholds are crossed.
+type Monitor struct {
+	// CapacityThresholds are fractions of the record limit at which EventCapacity is emitted.
+	// Defaults to DefaultCapacityThresholds.
+	CapacityThresholds []float64
+	// Callback, if set, is called with each Event.
+	Callback func(ctx context.Context, event Event)
+	// DaysThresholds are the days remaining at which EventExpiring is emitted.
+	// Defaults to DefaultDaysThresholds.
+	DaysThresholds []int
+	// HardLimit is the fraction of the record limit at which AddRecord is refused by a guarded SzEngine.
+	// Defaults to 1.
+	HardLimit float64
+	// Interval between checks made by Start(). Defaults to DefaultInterval.
+	Interval time.Duration
+	// RecordCounter supplies the number of records in the datastore.
+	// If nil, record-limit headroom is not computed.
+	RecordCounter RecordCounter
+	SzProduct     senzing.SzProduct
+
+	emitted    map[string]bool
+	expireDate time.Time
+	mutex      sync.RWMutex
+	status     *Status
+}
+
+// RecordCounter returns the number of records in the datastore.
+type RecordCounter interface {
+	RecordCount(ctx context.Context) (int64, error)
+}
+
+// Status is the result of the most recent Monitor check.
+type Status struct {
+	DaysRemaining int
+	Expired       bool
+	// Headroom is the number of records that can still be added. -1 means unlimited.
+	Headroom     int64
+	License      *License
+	LimitReached bool
+	RecordCount  int64
+	Time         time.Time
+	// Usage is RecordCount as a fraction of the record limit.
+	Usage float64
+}
+
+// StatsRecordCounter estimates the datastore record count from SzEngine.GetStats workload counters.
+// Starting from an initial count, it adds "addedRecords" and subtracts "deletedRecords".
+// The estimate is an upper bound: "addedRecords" also counts records replacing existing ones.
+type StatsRecordCounter struct {
+	// Cumulative is true when GetStats counters accumulate across calls.
+	Cumulative bool
+	// SzEngine is called for statistics by RecordCount(). It requires Cumulative, as otherwise
+	// each call would reset the counters seen by other callers of GetStats, such as an enginestats.Sampler.
+	// If nil, the counter only changes through AddSample() and Update().
+	SzEngine senzing.SzEngine
+
+	count    int64
+	mutex    sync.Mutex
+	previous *enginestats.Stats
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+const (
+	EventExpiring EventType = iota + 1
+	EventExpired
+	EventCapacity
+	EventLimitReached
+	EventError
+)
+
+// The layout of "expireDate" and "issueDate" in SzProduct.GetLicense output.
+const DateLayout = time.DateOnly
+
+// Default values for Monitor fields left unset.
+const (
+	DefaultHardLimit = 1.0
+	DefaultInterval  = time.Hour
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var (
+	DefaultCapacityThresholds = []float64{0.80, 0.95}
+	DefaultDaysThresholds     = []int{30, 7, 1}
+)
+
+var eventTypeNames = map[EventType]string{
+	EventCapacity:     "Capacity",
+	EventError:        "Error",
+	EventExpired:      "Expired",
+	EventExpiring:     "Expiring",
+	EventLimitReached: "LimitReached",
+}
@@ -0,0 +1,69 @@
+package license
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// guardedSzEngine refuses AddRecord calls once its Monitor reports the license limit reached.
+type guardedSzEngine struct {
+	senzing.SzEngine
+	monitor *Monitor
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Senzing error codes returned when AddRecord is refused.
+const (
+	errorCodeLicenseExpired = 999
+	errorCodeRecordLimit    = 9000
+)
+
+// ----------------------------------------------------------------------------
+// Interface methods
+// ----------------------------------------------------------------------------
+
+/*
+The AddRecord method calls the wrapped SzEngine's AddRecord unless the license has expired
+or the record hard limit has been reached, in which case an SzLicense error is returned.
+*/
+func (engine *guardedSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	if status := engine.monitor.Status(); status != nil {
+		switch {
+		case status.Expired:
+			return "", szerror.New(errorCodeLicenseExpired, fmt.Sprintf("%dE|License has expired", errorCodeLicenseExpired))
+		case status.LimitReached:
+			return "", szerror.New(errorCodeRecordLimit, fmt.Sprintf("%dE|LIMIT: Maximum number of records ingested: %d", errorCodeRecordLimit, status.License.RecordLimit))
+		}
+	}
+	return engine.SzEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewGuardedSzEngine function wraps an SzEngine so that AddRecord is refused
+once the monitor's most recent check found the license expired or its hard limit reached.
+All other methods are passed through.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - monitor: The Monitor whose Status() is consulted.
+*/
+func NewGuardedSzEngine(szEngine senzing.SzEngine, monitor *Monitor) senzing.SzEngine {
+	return &guardedSzEngine{
+		SzEngine: szEngine,
+		monitor:  monitor,
+	}
+}
@@ -0,0 +1,13 @@
+/*
+The limiter package bounds the load placed on the Senzing engine by callers of an SzEngine.
+
+NewSzEngine wraps an SzEngine so that each call waits for room in its method's Class:
+ClassWrite for loading and redo processing, ClassRead for entity, record and attribute lookups,
+and ClassAnalysis for network, path, how, why and export calls.
+For each class a Limit sets the number of concurrent calls, a token-bucket rate and the length of the queue of waiting calls.
+Waiting calls are admitted in the order they arrived and give up when their context is done.
+Limiter.Stats reports the calls in progress and the depth of each queue.
+
+For example, an HTTP service may give analysis calls one concurrent call so that ad-hoc queries cannot starve a loader.
+*/
+package limiter
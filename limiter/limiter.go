@@ -0,0 +1,266 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Class
+// ----------------------------------------------------------------------------
+
+func (class Class) String() string {
+	if name, ok := classNames[class]; ok {
+		return name
+	}
+	return fmt.Sprintf("Class(%d)", int(class))
+}
+
+// MarshalText encodes a Class by name, so that it may be a JSON map key.
+func (class Class) MarshalText() ([]byte, error) {
+	if _, ok := classNames[class]; !ok {
+		return nil, fmt.Errorf("unknown class %d", int(class))
+	}
+	return []byte(class.String()), nil
+}
+
+// UnmarshalText decodes a Class from its name.
+func (class *Class) UnmarshalText(text []byte) error {
+	for candidate, name := range classNames {
+		if name == string(text) {
+			*class = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown class %q", text)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Limiter
+// ----------------------------------------------------------------------------
+
+/*
+The Stats method returns the stats of each Class that has been called.
+*/
+func (limiter *Limiter) Stats() map[Class]Stats {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	result := make(map[Class]Stats, len(limiter.pools))
+	for class, pool := range limiter.pools {
+		stats := pool.stats
+		stats.Queued = len(pool.queue)
+		result[class] = stats
+	}
+	return result
+}
+
+// Wait until a call of method may proceed. The returned function must be called when it has finished.
+func (limiter *Limiter) acquire(ctx context.Context, method string) (func(), error) {
+	class := limiter.class(method)
+	limiter.mutex.Lock()
+	pool := limiter.pool(class)
+	now := time.Now()
+	release := func() {
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+		pool.stats.Active--
+		limiter.dispatch(pool)
+	}
+	if len(pool.queue) == 0 && pool.admit(now) {
+		limiter.mutex.Unlock()
+		return release, nil
+	}
+	if pool.limit.MaxQueue > 0 && len(pool.queue) >= pool.limit.MaxQueue {
+		pool.stats.Rejected++
+		limiter.mutex.Unlock()
+		return nil, &QueueFullError{Class: class}
+	}
+	waiter := &waiter{ready: make(chan struct{})}
+	pool.queue = append(pool.queue, waiter)
+	pool.stats.MaxQueued = max(pool.stats.MaxQueued, len(pool.queue))
+	limiter.schedule(pool)
+	limiter.mutex.Unlock()
+
+	select {
+	case <-waiter.ready:
+		limiter.mutex.Lock()
+		pool.stats.Waited += time.Since(now)
+		limiter.mutex.Unlock()
+		return release, nil
+	case <-ctx.Done():
+		limiter.mutex.Lock()
+		pool.stats.Cancelled++
+		if waiter.admitted {
+			pool.stats.Active--
+			limiter.dispatch(pool)
+		} else {
+			for index, queued := range pool.queue {
+				if queued == waiter {
+					pool.queue = append(pool.queue[:index], pool.queue[index+1:]...)
+					break
+				}
+			}
+		}
+		limiter.mutex.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (limiter *Limiter) class(method string) Class {
+	if class, ok := limiter.Classes[method]; ok {
+		return class
+	}
+	return MethodClasses[method]
+}
+
+// Admit waiting calls in order while the pool's limits allow. Must be called with the mutex held.
+func (limiter *Limiter) dispatch(pool *pool) {
+	now := time.Now()
+	for len(pool.queue) > 0 && pool.admit(now) {
+		waiter := pool.queue[0]
+		pool.queue[0] = nil
+		pool.queue = pool.queue[1:]
+		waiter.admitted = true
+		close(waiter.ready)
+	}
+	limiter.schedule(pool)
+}
+
+func (limiter *Limiter) do(ctx context.Context, method string, run func() error) error {
+	release, err := limiter.acquire(ctx, method)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return run()
+}
+
+// Release the slot when the channel is closed, as an export holds the engine until then.
+func (limiter *Limiter) iterate(ctx context.Context, method string, run func() chan senzing.StringFragment) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment)
+	release, err := limiter.acquire(ctx, method)
+	if err != nil {
+		go func() {
+			defer close(result)
+			select {
+			case result <- senzing.StringFragment{Error: err}:
+			case <-ctx.Done():
+			}
+		}()
+		return result
+	}
+	fragments := run()
+	go func() {
+		defer close(result)
+		defer release()
+		for fragment := range fragments {
+			select {
+			case result <- fragment:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return result
+}
+
+// Must be called with the mutex held.
+func (limiter *Limiter) pool(class Class) *pool {
+	if limiter.pools == nil {
+		limiter.pools = map[Class]*pool{}
+	}
+	result, ok := limiter.pools[class]
+	if !ok {
+		result = &pool{limit: limiter.Limits[class]}
+		result.tokens = result.burst()
+		limiter.pools[class] = result
+	}
+	return result
+}
+
+// When the head of the queue waits only for a token, wake the dispatcher when one is due.
+// Must be called with the mutex held.
+func (limiter *Limiter) schedule(pool *pool) {
+	if len(pool.queue) == 0 || pool.timer != nil || pool.limit.Rate <= 0 || pool.tokens >= 1 {
+		return
+	}
+	if pool.limit.Concurrency > 0 && pool.stats.Active >= pool.limit.Concurrency {
+		return
+	}
+	delay := time.Duration((1 - pool.tokens) / pool.limit.Rate * float64(time.Second))
+	pool.timer = time.AfterFunc(delay, func() {
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+		pool.timer = nil
+		limiter.dispatch(pool)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Methods - pool
+// ----------------------------------------------------------------------------
+
+// Take a slot and a token if both are available.
+func (pool *pool) admit(now time.Time) bool {
+	if pool.limit.Concurrency > 0 && pool.stats.Active >= pool.limit.Concurrency {
+		return false
+	}
+	if pool.limit.Rate > 0 {
+		if !pool.refilled.IsZero() {
+			elapsed := now.Sub(pool.refilled).Seconds()
+			pool.tokens = math.Min(pool.burst(), pool.tokens+elapsed*pool.limit.Rate)
+		}
+		pool.refilled = now
+		if pool.tokens < 1 {
+			return false
+		}
+		pool.tokens--
+	}
+	pool.stats.Active++
+	pool.stats.Admitted++
+	return true
+}
+
+func (pool *pool) burst() float64 {
+	if pool.limit.Burst > 0 {
+		return float64(pool.limit.Burst)
+	}
+	return math.Max(1, math.Floor(pool.limit.Rate))
+}
+
+// ----------------------------------------------------------------------------
+// Methods - QueueFullError
+// ----------------------------------------------------------------------------
+
+func (err *QueueFullError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrQueueFull, err.Class)
+}
+
+// Is reports whether target is ErrQueueFull.
+func (err *QueueFullError) Is(target error) bool {
+	return target == ErrQueueFull //nolint:errorlint
+}
+
+// Unwrap returns szerror.ErrSzRetryable, so that a *QueueFullError is classified as retryable.
+func (err *QueueFullError) Unwrap() error {
+	return szerror.ErrSzRetryable
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func call[T any](ctx context.Context, limiter *Limiter, method string, run func() (T, error)) (T, error) {
+	var result T
+	release, err := limiter.acquire(ctx, method)
+	if err != nil {
+		return result, err
+	}
+	defer release()
+	return run()
+}
@@ -0,0 +1,271 @@
+package limiter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	calls   []string
+	gate    chan struct{}
+	mutex   sync.Mutex
+	started chan string
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = dataSourceCode
+	_ = recordDefinition
+	_ = flags
+	engine.record("AddRecord " + recordID)
+	return "{}", nil
+}
+
+func (engine *mockSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	_ = ctx
+	_ = flags
+	engine.record("ExportJSONEntityReportIterator")
+	result := make(chan senzing.StringFragment)
+	go func() {
+		defer close(result)
+		result <- senzing.StringFragment{Value: "{}"}
+	}()
+	return result
+}
+
+func (engine *mockSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	_ = ctx
+	_ = entityID2
+	_ = flags
+	name := "WhyEntities " + string(rune('0'+entityID1))
+	if engine.started != nil {
+		engine.started <- name
+	}
+	if engine.gate != nil {
+		<-engine.gate
+	}
+	engine.record(name)
+	return "{}", nil
+}
+
+func (engine *mockSzEngine) record(call string) {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	engine.calls = append(engine.calls, call)
+}
+
+func (engine *mockSzEngine) recorded() []string {
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	return append([]string{}, engine.calls...)
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+// Wait until the Limiter reports queued calls of class.
+func waitForQueued(test *testing.T, limiter *Limiter, class Class, queued int) {
+	test.Helper()
+	require.Eventually(test, func() bool {
+		return limiter.Stats()[class].Queued == queued
+	}, time.Second, time.Millisecond)
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLimiter_Concurrency(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{Limits: map[Class]Limit{ClassAnalysis: {Concurrency: 1}}}
+	engine := &mockSzEngine{gate: make(chan struct{}), started: make(chan string, 4)}
+	szEngine := NewSzEngine(engine, limiter)
+
+	var group sync.WaitGroup
+	for entityID := int64(1); entityID <= 3; entityID++ {
+		group.Add(1)
+		go func(entityID int64) {
+			defer group.Done()
+			_, err := szEngine.WhyEntities(ctx, entityID, 9, senzing.SzNoFlags)
+			assert.NoError(test, err)
+		}(entityID)
+		if entityID == 1 {
+			assert.Equal(test, "WhyEntities 1", <-engine.started)
+		} else {
+			waitForQueued(test, limiter, ClassAnalysis, int(entityID-1))
+		}
+	}
+
+	stats := limiter.Stats()[ClassAnalysis]
+	assert.Equal(test, 1, stats.Active)
+	assert.Equal(test, 2, stats.Queued)
+
+	_, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+
+	for i := 0; i < 3; i++ {
+		engine.gate <- struct{}{}
+	}
+	group.Wait()
+	assert.Equal(test, []string{"AddRecord 1", "WhyEntities 1", "WhyEntities 2", "WhyEntities 3"}, engine.recorded())
+
+	stats = limiter.Stats()[ClassAnalysis]
+	assert.Equal(test, 0, stats.Active)
+	assert.Equal(test, 0, stats.Queued)
+	assert.Equal(test, 2, stats.MaxQueued)
+	assert.Equal(test, uint64(3), stats.Admitted)
+	assert.Positive(test, stats.Waited)
+	assert.Equal(test, uint64(1), limiter.Stats()[ClassWrite].Admitted)
+}
+
+func TestLimiter_Cancelled(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{Limits: map[Class]Limit{ClassAnalysis: {Concurrency: 1}}}
+	engine := &mockSzEngine{gate: make(chan struct{}), started: make(chan string, 1)}
+	szEngine := NewSzEngine(engine, limiter)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = szEngine.WhyEntities(ctx, 1, 9, senzing.SzNoFlags)
+	}()
+	<-engine.started
+
+	waiting, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err := szEngine.WhyEntities(waiting, 2, 9, senzing.SzNoFlags)
+	require.ErrorIs(test, err, context.DeadlineExceeded)
+
+	stats := limiter.Stats()[ClassAnalysis]
+	assert.Equal(test, uint64(1), stats.Cancelled)
+	assert.Equal(test, 0, stats.Queued)
+
+	engine.gate <- struct{}{}
+	<-done
+	assert.Equal(test, []string{"WhyEntities 1"}, engine.recorded())
+	assert.Equal(test, 0, limiter.Stats()[ClassAnalysis].Active)
+}
+
+func TestLimiter_QueueFull(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{Limits: map[Class]Limit{ClassAnalysis: {Concurrency: 1, MaxQueue: 1}}}
+	engine := &mockSzEngine{gate: make(chan struct{}), started: make(chan string, 2)}
+	szEngine := NewSzEngine(engine, limiter)
+
+	var group sync.WaitGroup
+	for entityID := int64(1); entityID <= 2; entityID++ {
+		group.Add(1)
+		go func(entityID int64) {
+			defer group.Done()
+			_, _ = szEngine.WhyEntities(ctx, entityID, 9, senzing.SzNoFlags)
+		}(entityID)
+	}
+	<-engine.started
+	waitForQueued(test, limiter, ClassAnalysis, 1)
+
+	_, err := szEngine.WhyEntities(ctx, 3, 9, senzing.SzNoFlags)
+	require.ErrorIs(test, err, ErrQueueFull)
+	assert.True(test, szerror.IsRetryable(err))
+	assert.Equal(test, "limiter queue is full: analysis", err.Error())
+	assert.Equal(test, uint64(1), limiter.Stats()[ClassAnalysis].Rejected)
+
+	engine.gate <- struct{}{}
+	engine.gate <- struct{}{}
+	group.Wait()
+}
+
+func TestLimiter_Rate(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{Limits: map[Class]Limit{ClassWrite: {Rate: 50}}}
+	szEngine := NewSzEngine(&mockSzEngine{}, limiter)
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		_, err := szEngine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzNoFlags)
+		require.NoError(test, err)
+	}
+	assert.GreaterOrEqual(test, time.Since(start), 180*time.Millisecond)
+	assert.Equal(test, uint64(60), limiter.Stats()[ClassWrite].Admitted)
+}
+
+func TestLimiter_Classes(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{
+		Classes: map[string]Class{"WhyEntities": ClassRead},
+		Limits:  map[Class]Limit{ClassAnalysis: {Concurrency: 1}},
+	}
+	engine := &mockSzEngine{}
+	szEngine := NewSzEngine(engine, limiter)
+	_, err := szEngine.WhyEntities(ctx, 1, 2, senzing.SzNoFlags)
+	require.NoError(test, err)
+	stats := limiter.Stats()
+	assert.Equal(test, uint64(1), stats[ClassRead].Admitted)
+	assert.NotContains(test, stats, ClassAnalysis)
+}
+
+func TestLimiter_Iterator(test *testing.T) {
+	ctx := context.TODO()
+	limiter := &Limiter{Limits: map[Class]Limit{ClassAnalysis: {Concurrency: 1, MaxQueue: 1}}}
+	szEngine := NewSzEngine(&mockSzEngine{}, limiter)
+
+	fragments := szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags)
+	assert.Equal(test, 1, limiter.Stats()[ClassAnalysis].Active)
+	for fragment := range fragments {
+		require.NoError(test, fragment.Error)
+	}
+	require.Eventually(test, func() bool {
+		return limiter.Stats()[ClassAnalysis].Active == 0
+	}, time.Second, time.Millisecond)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	held := szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags)
+	cancel()
+	for fragment := range szEngine.ExportJSONEntityReportIterator(cancelled, senzing.SzNoFlags) {
+		require.ErrorIs(test, fragment.Error, context.Canceled)
+	}
+	for fragment := range held {
+		require.NoError(test, fragment.Error)
+	}
+}
+
+func TestLimiter_zero(test *testing.T) {
+	ctx := context.TODO()
+	var limiter Limiter
+	_, err := NewSzEngine(&mockSzEngine{}, &limiter).WhyEntities(ctx, 1, 2, senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, uint64(1), limiter.Stats()[ClassAnalysis].Admitted)
+}
+
+func TestLimiter_Stats_json(test *testing.T) {
+	limiter := &Limiter{}
+	_, err := NewSzEngine(&mockSzEngine{}, limiter).AddRecord(context.TODO(), "TEST", "1", "{}", senzing.SzNoFlags)
+	require.NoError(test, err)
+	encoded, err := json.Marshal(limiter.Stats())
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"write":{"active":0,"admitted":1,"cancelled":0,"maxQueued":0,"queued":0,"rejected":0,"waited":0}}`, string(encoded))
+}
+
+func TestLimiter_Class_text(test *testing.T) {
+	for class, name := range classNames {
+		text, err := class.MarshalText()
+		require.NoError(test, err)
+		assert.Equal(test, name, string(text))
+		var decoded Class
+		require.NoError(test, decoded.UnmarshalText(text))
+		assert.Equal(test, class, decoded)
+	}
+	assert.Equal(test, "Class(9)", Class(9).String())
+	_, err := Class(9).MarshalText()
+	require.Error(test, err)
+	var decoded Class
+	require.Error(test, decoded.UnmarshalText([]byte("bulk")))
+}
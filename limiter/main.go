@@ -0,0 +1,135 @@
+package limiter
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Class groups SzEngine methods that share a Limit.
+type Class int
+
+// A Limit bounds the calls of one Class. Zero values mean no limit.
+type Limit struct {
+	// Burst is the capacity of the token bucket. Defaults to Rate, and at least 1.
+	Burst int
+	// Concurrency is the number of calls in progress at once.
+	Concurrency int
+	// MaxQueue is the number of calls that may wait. Further calls fail with a *QueueFullError.
+	MaxQueue int
+	// Rate is the number of calls admitted per second.
+	Rate float64
+}
+
+// A Limiter admits calls through the SzEngine wrappers that share it.
+// The zero value admits every call. Limits and Classes must not be changed after first use.
+type Limiter struct {
+	// Classes overrides the Class of methods, by method name, from MethodClasses.
+	Classes map[string]Class
+	// Limits by Class. A class without an entry is not limited.
+	Limits map[Class]Limit
+
+	mutex sync.Mutex
+	pools map[Class]*pool
+}
+
+// A QueueFullError is returned when a call finds the queue of its Class at Limit.MaxQueue.
+// It matches ErrQueueFull and szerror.ErrSzRetryable with errors.Is.
+type QueueFullError struct {
+	Class Class
+}
+
+// Stats of the calls of one Class.
+type Stats struct {
+	// Active is the number of calls in progress.
+	Active int `json:"active"`
+	// Admitted is the number of calls admitted.
+	Admitted uint64 `json:"admitted"`
+	// Cancelled is the number of calls whose context was done while waiting.
+	Cancelled uint64 `json:"cancelled"`
+	// MaxQueued is the largest number of calls that have waited at once.
+	MaxQueued int `json:"maxQueued"`
+	// Queued is the number of calls waiting.
+	Queued int `json:"queued"`
+	// Rejected is the number of calls that found the queue full.
+	Rejected uint64 `json:"rejected"`
+	// Waited is the total time admitted calls spent waiting.
+	Waited time.Duration `json:"waited"`
+}
+
+// Admission state of one Class.
+type pool struct {
+	limit    Limit
+	queue    []*waiter
+	refilled time.Time
+	stats    Stats
+	timer    *time.Timer
+	tokens   float64
+}
+
+// A call waiting to be admitted.
+type waiter struct {
+	admitted bool
+	ready    chan struct{}
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Classes of SzEngine methods.
+const (
+	ClassOther Class = iota
+	ClassWrite
+	ClassRead
+	ClassAnalysis
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrQueueFull matches every *QueueFullError.
+var ErrQueueFull = errors.New("limiter queue is full")
+
+// MethodClasses is the default Class of SzEngine methods. Methods not listed are ClassOther.
+// Destroy is never limited.
+var MethodClasses = map[string]Class{
+	"AddRecord":                         ClassWrite,
+	"CountRedoRecords":                  ClassRead,
+	"DeleteRecord":                      ClassWrite,
+	"ExportCsvEntityReport":             ClassAnalysis,
+	"ExportCsvEntityReportIterator":     ClassAnalysis,
+	"ExportJSONEntityReport":            ClassAnalysis,
+	"ExportJSONEntityReportIterator":    ClassAnalysis,
+	"FindInterestingEntitiesByEntityID": ClassAnalysis,
+	"FindInterestingEntitiesByRecordID": ClassAnalysis,
+	"FindNetworkByEntityID":             ClassAnalysis,
+	"FindNetworkByRecordID":             ClassAnalysis,
+	"FindPathByEntityID":                ClassAnalysis,
+	"FindPathByRecordID":                ClassAnalysis,
+	"GetEntityByEntityID":               ClassRead,
+	"GetEntityByRecordID":               ClassRead,
+	"GetRecord":                         ClassRead,
+	"GetRedoRecord":                     ClassWrite,
+	"GetVirtualEntityByRecordID":        ClassRead,
+	"HowEntityByEntityID":               ClassAnalysis,
+	"ProcessRedoRecord":                 ClassWrite,
+	"ReevaluateEntity":                  ClassWrite,
+	"ReevaluateRecord":                  ClassWrite,
+	"SearchByAttributes":                ClassRead,
+	"WhyEntities":                       ClassAnalysis,
+	"WhyRecordInEntity":                 ClassAnalysis,
+	"WhyRecords":                        ClassAnalysis,
+}
+
+var classNames = map[Class]string{
+	ClassAnalysis: "analysis",
+	ClassOther:    "other",
+	ClassRead:     "read",
+	ClassWrite:    "write",
+}
@@ -0,0 +1,237 @@
+package limiter
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzEngine whose calls are admitted by a Limiter.
+type limitedSzEngine struct {
+	limiter  *Limiter
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *limitedSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "AddRecord", func() (string, error) {
+		return client.szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+	})
+}
+
+func (client *limitedSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	return client.limiter.do(ctx, "CloseExport", func() error {
+		return client.szEngine.CloseExport(ctx, exportHandle)
+	})
+}
+
+func (client *limitedSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	return call(ctx, client.limiter, "CountRedoRecords", func() (int64, error) {
+		return client.szEngine.CountRedoRecords(ctx)
+	})
+}
+
+func (client *limitedSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "DeleteRecord", func() (string, error) {
+		return client.szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) Destroy(ctx context.Context) error {
+	return client.szEngine.Destroy(ctx)
+}
+
+func (client *limitedSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	return call(ctx, client.limiter, "ExportCsvEntityReport", func() (uintptr, error) {
+		return client.szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+	})
+}
+
+func (client *limitedSzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	return client.limiter.iterate(ctx, "ExportCsvEntityReportIterator", func() chan senzing.StringFragment {
+		return client.szEngine.ExportCsvEntityReportIterator(ctx, csvColumnList, flags)
+	})
+}
+
+func (client *limitedSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	return call(ctx, client.limiter, "ExportJSONEntityReport", func() (uintptr, error) {
+		return client.szEngine.ExportJSONEntityReport(ctx, flags)
+	})
+}
+
+func (client *limitedSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	return client.limiter.iterate(ctx, "ExportJSONEntityReportIterator", func() chan senzing.StringFragment {
+		return client.szEngine.ExportJSONEntityReportIterator(ctx, flags)
+	})
+}
+
+func (client *limitedSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	return call(ctx, client.limiter, "FetchNext", func() (string, error) {
+		return client.szEngine.FetchNext(ctx, exportHandle)
+	})
+}
+
+func (client *limitedSzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindInterestingEntitiesByEntityID", func() (string, error) {
+		return client.szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *limitedSzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindInterestingEntitiesByRecordID", func() (string, error) {
+		return client.szEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindNetworkByEntityID", func() (string, error) {
+		return client.szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	})
+}
+
+func (client *limitedSzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindNetworkByRecordID", func() (string, error) {
+		return client.szEngine.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	})
+}
+
+func (client *limitedSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindPathByEntityID", func() (string, error) {
+		return client.szEngine.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+	})
+}
+
+func (client *limitedSzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "FindPathByRecordID", func() (string, error) {
+		return client.szEngine.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+	})
+}
+
+func (client *limitedSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	return call(ctx, client.limiter, "GetActiveConfigID", func() (int64, error) {
+		return client.szEngine.GetActiveConfigID(ctx)
+	})
+}
+
+func (client *limitedSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "GetEntityByEntityID", func() (string, error) {
+		return client.szEngine.GetEntityByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *limitedSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "GetEntityByRecordID", func() (string, error) {
+		return client.szEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "GetRecord", func() (string, error) {
+		return client.szEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	return call(ctx, client.limiter, "GetRedoRecord", func() (string, error) {
+		return client.szEngine.GetRedoRecord(ctx)
+	})
+}
+
+func (client *limitedSzEngine) GetStats(ctx context.Context) (string, error) {
+	return call(ctx, client.limiter, "GetStats", func() (string, error) {
+		return client.szEngine.GetStats(ctx)
+	})
+}
+
+func (client *limitedSzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "GetVirtualEntityByRecordID", func() (string, error) {
+		return client.szEngine.GetVirtualEntityByRecordID(ctx, recordList, flags)
+	})
+}
+
+func (client *limitedSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "HowEntityByEntityID", func() (string, error) {
+		return client.szEngine.HowEntityByEntityID(ctx, entityID, flags)
+	})
+}
+
+func (client *limitedSzEngine) PrimeEngine(ctx context.Context) error {
+	return client.limiter.do(ctx, "PrimeEngine", func() error {
+		return client.szEngine.PrimeEngine(ctx)
+	})
+}
+
+func (client *limitedSzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "ProcessRedoRecord", func() (string, error) {
+		return client.szEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+	})
+}
+
+func (client *limitedSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "ReevaluateEntity", func() (string, error) {
+		return client.szEngine.ReevaluateEntity(ctx, entityID, flags)
+	})
+}
+
+func (client *limitedSzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "ReevaluateRecord", func() (string, error) {
+		return client.szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	return client.limiter.do(ctx, "Reinitialize", func() error {
+		return client.szEngine.Reinitialize(ctx, configID)
+	})
+}
+
+func (client *limitedSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "SearchByAttributes", func() (string, error) {
+		return client.szEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+	})
+}
+
+func (client *limitedSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	return call(ctx, client.limiter, "WhyEntities", func() (string, error) {
+		return client.szEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+	})
+}
+
+func (client *limitedSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "WhyRecordInEntity", func() (string, error) {
+		return client.szEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+	})
+}
+
+func (client *limitedSzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	return call(ctx, client.limiter, "WhyRecords", func() (string, error) {
+		return client.szEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzEngine function wraps an SzEngine so that each call waits to be admitted by limiter.
+A call whose context is done while waiting returns the context's error without calling szEngine.
+Iterator methods hold their admission until the channel is closed. Destroy is never limited.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - limiter: The Limiter, which may be shared by several wrappers.
+*/
+func NewSzEngine(szEngine senzing.SzEngine, limiter *Limiter) senzing.SzEngine {
+	return &limitedSzEngine{
+		limiter:  limiter,
+		szEngine: szEngine,
+	}
+}
@@ -0,0 +1,8 @@
+/*
+The logging package renders the IDMessages catalogs of the sz* packages as log/slog records,
+so that every implementation logs the same message IDs, levels and statuses.
+
+Each component has a log level that can be changed at runtime with SetLogLevel,
+or at startup from the SENZING_LOG_LEVEL environment variable with SetLogLevelsFromEnvironment.
+*/
+package logging
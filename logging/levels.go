@@ -0,0 +1,200 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Components function returns the names of the components that have a log level, sorted.
+*/
+func Components() []string {
+	componentLevelsMutex.RLock()
+	defer componentLevelsMutex.RUnlock()
+	result := make([]string, 0, len(componentLevels))
+	for component := range componentLevels {
+		result = append(result, component)
+	}
+	sort.Strings(result)
+	return result
+}
+
+/*
+The GetLogLevel function returns the name of a component's log level.
+
+Input
+  - component: The name of the component, e.g. "szengine".
+*/
+func GetLogLevel(component string) (string, error) {
+	levelVar, ok := lookupLevelVar(component)
+	if !ok {
+		return "", fmt.Errorf("unknown component %q", component)
+	}
+	return LevelName(levelVar.Level()), nil
+}
+
+/*
+The LevelVar function returns the runtime log level of a component, registering the component if it is new.
+It can be used as slog.HandlerOptions.Level so that a handler follows SetLogLevel.
+
+Input
+  - component: The name of the component, e.g. "szengine".
+*/
+func LevelVar(component string) *slog.LevelVar {
+	component = strings.ToLower(component)
+	if levelVar, ok := lookupLevelVar(component); ok {
+		return levelVar
+	}
+	componentLevelsMutex.Lock()
+	defer componentLevelsMutex.Unlock()
+	levelVar, ok := componentLevels[component]
+	if !ok {
+		levelVar = newLevelVar()
+		componentLevels[component] = levelVar
+	}
+	return levelVar
+}
+
+/*
+The ParseLevel function parses a level name: TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC, in any case.
+
+Input
+  - name: The name of the level.
+*/
+func ParseLevel(name string) (slog.Level, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	for level, levelName := range levelNames {
+		if name == levelName {
+			return level, nil
+		}
+	}
+	var result slog.Level
+	switch name {
+	case "DEBUG", "INFO", "WARN", "ERROR":
+		if err := result.UnmarshalText([]byte(name)); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+	return result, fmt.Errorf("unknown log level %q", name)
+}
+
+/*
+The SetLogLevel function changes a component's log level.
+It takes effect immediately for Loggers and for handlers using LevelVar(component).
+
+Input
+  - component: The name of the component, e.g. "szengine".
+  - levelName: TRACE, DEBUG, INFO, WARN, ERROR, FATAL or PANIC.
+*/
+func SetLogLevel(component string, levelName string) error {
+	level, err := ParseLevel(levelName)
+	if err != nil {
+		return err
+	}
+	levelVar, ok := lookupLevelVar(component)
+	if !ok {
+		return fmt.Errorf("unknown component %q", component)
+	}
+	levelVar.Set(level)
+	return nil
+}
+
+/*
+The SetLogLevels function changes the log levels of several components.
+The specification is a comma-separated list of level names and component=level pairs.
+A level name without a component applies to every component, e.g. "WARN,szengine=TRACE".
+Nothing is changed if any part of the specification is invalid.
+
+Input
+  - specification: The log levels to set.
+*/
+func SetLogLevels(specification string) error {
+	levels := map[string]slog.Level{}
+	for _, part := range strings.Split(specification, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		component, levelName, found := strings.Cut(part, "=")
+		if !found {
+			level, err := ParseLevel(part)
+			if err != nil {
+				return err
+			}
+			for _, component := range Components() {
+				levels[component] = level
+			}
+			continue
+		}
+		component = strings.ToLower(strings.TrimSpace(component))
+		if _, ok := lookupLevelVar(component); !ok {
+			return fmt.Errorf("unknown component %q", component)
+		}
+		level, err := ParseLevel(levelName)
+		if err != nil {
+			return err
+		}
+		levels[component] = level
+	}
+	for component, level := range levels {
+		LevelVar(component).Set(level)
+	}
+	return nil
+}
+
+/*
+The SetLogLevelsFromEnvironment function applies SetLogLevels to the value of EnvironmentVariable, if it is set.
+*/
+func SetLogLevelsFromEnvironment() error {
+	specification, ok := os.LookupEnv(EnvironmentVariable)
+	if !ok {
+		return nil
+	}
+	if err := SetLogLevels(specification); err != nil {
+		return fmt.Errorf("%s: %w", EnvironmentVariable, err)
+	}
+	return nil
+}
+
+/*
+The VerboseLogging function returns the verboseLogging value to pass to a component's native Initialize:
+senzing.SzVerboseLogging when the component's level is TRACE or DEBUG, otherwise senzing.SzNoLogging.
+The native library reads the value only when it is initialized, so a change takes effect on the next
+Destroy and Initialize.
+
+Input
+  - component: The name of the component, e.g. "szengine".
+*/
+func VerboseLogging(component string) int64 {
+	if LevelVar(component).Level() <= slog.LevelDebug {
+		return senzing.SzVerboseLogging
+	}
+	return senzing.SzNoLogging
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func lookupLevelVar(component string) (*slog.LevelVar, bool) {
+	componentLevelsMutex.RLock()
+	defer componentLevelsMutex.RUnlock()
+	result, ok := componentLevels[strings.ToLower(component)]
+	return result, ok
+}
+
+func newLevelVar() *slog.LevelVar {
+	result := &slog.LevelVar{}
+	result.Set(DefaultLevel)
+	return result
+}
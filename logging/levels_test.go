@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetLevels(test *testing.T) {
+	test.Cleanup(func() {
+		for _, component := range Components() {
+			LevelVar(component).Set(DefaultLevel)
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLogging_ParseLevel(test *testing.T) {
+	testCases := []struct {
+		name     string
+		expected slog.Level
+		isError  bool
+	}{
+		{name: "TRACE", expected: LevelTrace},
+		{name: "debug", expected: slog.LevelDebug},
+		{name: " Info ", expected: slog.LevelInfo},
+		{name: "WARN", expected: slog.LevelWarn},
+		{name: "ERROR", expected: slog.LevelError},
+		{name: "FATAL", expected: LevelFatal},
+		{name: "PANIC", expected: LevelPanic},
+		{name: "INFO+2", isError: true},
+		{name: "VERBOSE", isError: true},
+		{name: "", isError: true},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, err := ParseLevel(testCase.name)
+			if testCase.isError {
+				require.Error(test, err)
+				return
+			}
+			require.NoError(test, err)
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestLogging_SetLogLevel(test *testing.T) {
+	resetLevels(test)
+	assert.Subset(test, Components(), []string{"szconfig", "szconfigmanager", "szdiagnostic", "szengine", "szproduct"})
+	actual, err := GetLogLevel("szengine")
+	require.NoError(test, err)
+	assert.Equal(test, "INFO", actual)
+	assert.Equal(test, senzing.SzNoLogging, VerboseLogging("szengine"))
+
+	require.NoError(test, SetLogLevel("SzEngine", "trace"))
+	actual, err = GetLogLevel("szengine")
+	require.NoError(test, err)
+	assert.Equal(test, "TRACE", actual)
+	assert.Equal(test, senzing.SzVerboseLogging, VerboseLogging("szengine"))
+
+	require.Error(test, SetLogLevel("szengine", "LOUD"))
+	require.Error(test, SetLogLevel("nosuch", "INFO"))
+	_, err = GetLogLevel("nosuch")
+	require.Error(test, err)
+}
+
+func TestLogging_SetLogLevels(test *testing.T) {
+	resetLevels(test)
+	require.NoError(test, SetLogLevels("WARN, szengine=TRACE"))
+	for component, expected := range map[string]string{"szconfig": "WARN", "szengine": "TRACE", "szproduct": "WARN"} {
+		actual, err := GetLogLevel(component)
+		require.NoError(test, err)
+		assert.Equal(test, expected, actual, component)
+	}
+
+	require.Error(test, SetLogLevels("ERROR,nosuch=INFO"))
+	require.Error(test, SetLogLevels("ERROR,szengine=LOUD"))
+	actual, err := GetLogLevel("szconfig")
+	require.NoError(test, err)
+	assert.Equal(test, "WARN", actual, "an invalid specification changes nothing")
+}
+
+func TestLogging_SetLogLevelsFromEnvironment(test *testing.T) {
+	resetLevels(test)
+	test.Setenv(EnvironmentVariable, "szdiagnostic=DEBUG")
+	require.NoError(test, SetLogLevelsFromEnvironment())
+	actual, err := GetLogLevel("szdiagnostic")
+	require.NoError(test, err)
+	assert.Equal(test, "DEBUG", actual)
+
+	test.Setenv(EnvironmentVariable, "LOUD")
+	require.ErrorContains(test, SetLogLevelsFromEnvironment(), EnvironmentVariable)
+}
+
+func TestLogging_LevelVar(test *testing.T) {
+	resetLevels(test)
+	levelVar := LevelVar("custom")
+	assert.Equal(test, DefaultLevel, levelVar.Level())
+	assert.Same(test, levelVar, LevelVar("Custom"))
+	require.NoError(test, SetLogLevel("custom", "ERROR"))
+	assert.Equal(test, slog.LevelError, levelVar.Level())
+}
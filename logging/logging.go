@@ -0,0 +1,189 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Catalog
+// ----------------------------------------------------------------------------
+
+/*
+The Component method returns the name of the component, which is Prefix without its trailing ".".
+*/
+func (catalog Catalog) Component() string {
+	return strings.TrimSuffix(catalog.Prefix, ".")
+}
+
+/*
+The ID method returns the stable identifier of a message, which is Prefix followed by the message number
+(e.g. "szengine.4001").
+
+Input
+  - messageNumber: The key of the message in IDMessages.
+*/
+func (catalog Catalog) ID(messageNumber int) string {
+	return fmt.Sprintf("%s%d", catalog.Prefix, messageNumber)
+}
+
+/*
+The Record method renders a message as a slog.Record.
+Arguments are formatted into the message template in order.
+Arguments of type slog.Attr are not formatted; they are added to the record as attributes.
+The first error argument is also added as the "error" attribute.
+
+Input
+  - messageNumber: The key of the message in IDMessages.
+  - args: Values for the template, and optionally slog.Attr values.
+*/
+func (catalog Catalog) Record(messageNumber int, args ...any) slog.Record {
+	level := Level(messageNumber)
+	values := []any{}
+	attrs := []slog.Attr{
+		slog.String(KeyID, catalog.ID(messageNumber)),
+		slog.String(KeyComponent, catalog.Component()),
+		slog.String(KeyStatus, catalog.Status(messageNumber)),
+	}
+	var firstError error
+	for _, arg := range args {
+		switch value := arg.(type) {
+		case slog.Attr:
+			attrs = append(attrs, value)
+			continue
+		case error:
+			if firstError == nil {
+				firstError = value
+			}
+		}
+		values = append(values, arg)
+	}
+	if firstError != nil {
+		attrs = append(attrs, slog.String(KeyError, firstError.Error()))
+	}
+	var message string
+	if template, ok := catalog.IDMessages[messageNumber]; ok {
+		message = fmt.Sprintf(template, values...)
+	} else {
+		message = strings.TrimSpace(fmt.Sprintln(append([]any{catalog.ID(messageNumber)}, values...)...))
+	}
+	result := slog.NewRecord(time.Now(), level, message, 0)
+	result.AddAttrs(attrs...)
+	return result
+}
+
+/*
+The Status method returns the status of a message from IDStatuses.
+If the message has no status, the name of its level is returned (e.g. "ERROR").
+
+Input
+  - messageNumber: The key of the message in IDMessages.
+*/
+func (catalog Catalog) Status(messageNumber int) string {
+	if status, ok := catalog.IDStatuses[messageNumber]; ok {
+		return status
+	}
+	return LevelName(Level(messageNumber))
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Logger
+// ----------------------------------------------------------------------------
+
+/*
+The Enabled method reports whether a message would be recorded:
+its level must be at least the component's log level, and enabled by the handler.
+
+Input
+  - ctx: A context to control lifecycle.
+  - messageNumber: The key of the message in IDMessages.
+*/
+func (logger *Logger) Enabled(ctx context.Context, messageNumber int) bool {
+	level := Level(messageNumber)
+	return level >= logger.level.Level() && logger.handler.Enabled(ctx, level)
+}
+
+/*
+The Log method renders a message and passes it to the handler, if its level is enabled.
+See Catalog.Record for how arguments are used.
+
+Input
+  - ctx: A context to control lifecycle.
+  - messageNumber: The key of the message in IDMessages.
+  - args: Values for the template, and optionally slog.Attr values.
+*/
+func (logger *Logger) Log(ctx context.Context, messageNumber int, args ...any) error {
+	if !logger.Enabled(ctx, messageNumber) {
+		return nil
+	}
+	return logger.handler.Handle(ctx, logger.catalog.Record(messageNumber, args...))
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Level function returns the level of a message, based on the range its number falls in:
+0-999 trace, 1000s debug, 2000s info, 3000s warn, 4000s error, 5000s fatal, 6000s panic
+and 8000s (method names) trace.
+
+Input
+  - messageNumber: The key of the message in IDMessages.
+*/
+func Level(messageNumber int) slog.Level {
+	for _, levelRange := range levelRanges {
+		if messageNumber >= levelRange.low && messageNumber <= levelRange.high {
+			return levelRange.level
+		}
+	}
+	return slog.LevelInfo
+}
+
+/*
+The LevelName function returns the name of a level, including TRACE, FATAL and PANIC.
+
+Input
+  - level: A slog level.
+*/
+func LevelName(level slog.Level) string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+	return level.String()
+}
+
+/*
+The New function returns a Logger for a component's catalog.
+The Logger follows the component's log level; see SetLogLevel.
+
+Input
+  - catalog: The component's messages, e.g. SzEngine.
+  - handler: Where records are written. If nil, slog.Default().Handler() is used.
+*/
+func New(catalog Catalog, handler slog.Handler) *Logger {
+	if handler == nil {
+		handler = slog.Default().Handler()
+	}
+	return &Logger{
+		catalog: catalog,
+		handler: handler,
+		level:   LevelVar(catalog.Component()),
+	}
+}
+
+/*
+The ReplaceLevelNames function can be used as slog.HandlerOptions.ReplaceAttr
+so that handlers print TRACE, FATAL and PANIC instead of "DEBUG-4", "ERROR+4" and "ERROR+8".
+*/
+func ReplaceLevelNames(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) == 0 && attr.Key == slog.LevelKey {
+		if level, ok := attr.Value.Any().(slog.Level); ok {
+			attr.Value = slog.StringValue(LevelName(level))
+		}
+	}
+	return attr
+}
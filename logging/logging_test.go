@@ -0,0 +1,111 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(catalog Catalog, level slog.Level) (*Logger, *bytes.Buffer) {
+	buffer := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: ReplaceLevelNames,
+	})
+	return New(catalog, handler), buffer
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestLogging_Level(test *testing.T) {
+	testCases := []struct {
+		messageNumber int
+		expected      string
+	}{
+		{1, "TRACE"},
+		{708, "TRACE"},
+		{1001, "DEBUG"},
+		{2001, "INFO"},
+		{3001, "WARN"},
+		{4001, "ERROR"},
+		{5001, "FATAL"},
+		{6001, "PANIC"},
+		{7001, "INFO"},
+		{8001, "TRACE"},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(test, testCase.expected, LevelName(Level(testCase.messageNumber)), testCase.messageNumber)
+	}
+}
+
+func TestLogging_Catalog_Record(test *testing.T) {
+	catalog := Catalog{
+		IDMessages: map[int]string{4001: "test.Destroy() failed. Return code: %d"},
+		IDStatuses: map[int]string{4001: "destroy-failed"},
+		Prefix:     "test.",
+	}
+	record := catalog.Record(4001, -2, slog.Int("attempt", 3))
+	assert.Equal(test, slog.LevelError, record.Level)
+	assert.Equal(test, "test.Destroy() failed. Return code: -2", record.Message)
+	attrs := map[string]string{}
+	record.Attrs(func(attr slog.Attr) bool {
+		attrs[attr.Key] = attr.Value.String()
+		return true
+	})
+	assert.Equal(test, map[string]string{
+		"attempt":    "3",
+		KeyComponent: "test",
+		KeyID:        "test.4001",
+		KeyStatus:    "destroy-failed",
+	}, attrs)
+
+	assert.Equal(test, "WARN", catalog.Status(3001))
+	record = catalog.Record(3001, "a", 1)
+	assert.Equal(test, "test.3001 a 1", record.Message)
+}
+
+func TestLogging_Logger_Log(test *testing.T) {
+	ctx := context.TODO()
+	logger, buffer := newTestLogger(SzProduct, slog.LevelDebug)
+
+	require.NoError(test, logger.Log(ctx, 1))
+	assert.Empty(test, buffer.String(), "trace is below the handler level")
+	assert.False(test, logger.Enabled(ctx, 1))
+
+	require.NoError(test, logger.Log(ctx, 4001, -1))
+	entry := map[string]any{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), &entry))
+	assert.Equal(test, "ERROR", entry[slog.LevelKey])
+	assert.Equal(test, "szproduct.4001", entry[KeyID])
+	assert.Equal(test, "szproduct", entry[KeyComponent])
+	assert.Equal(test, "ERROR", entry[KeyStatus])
+	assert.Equal(test, "szproduct.G2Product_destroy() failed. Return code: -1", entry[slog.MessageKey])
+
+	logger, buffer = newTestLogger(SzProduct, LevelTrace)
+	require.NoError(test, logger.Log(ctx, 4, errors.New("boom")))
+	assert.Empty(test, buffer.String(), "trace is below the component level")
+	require.NoError(test, SetLogLevel("szproduct", "TRACE"))
+	defer LevelVar("szproduct").Set(DefaultLevel)
+	require.NoError(test, logger.Log(ctx, 4, errors.New("boom")))
+	entry = map[string]any{}
+	require.NoError(test, json.Unmarshal(buffer.Bytes(), &entry))
+	assert.Equal(test, "TRACE", entry[slog.LevelKey])
+	assert.Equal(test, "boom", entry[KeyError])
+	assert.Equal(test, "Exit  szproduct.Destroy() returned (boom).", entry[slog.MessageKey])
+}
+
+func TestLogging_Catalogs(test *testing.T) {
+	for _, catalog := range []Catalog{SzConfig, SzConfigManager, SzDiagnostic, SzEngine, SzProduct} {
+		assert.NotEmpty(test, catalog.IDMessages, catalog.Prefix)
+		assert.NotNil(test, catalog.IDStatuses, catalog.Prefix)
+		assert.Equal(test, catalog.Prefix, catalog.Component()+".")
+	}
+}
@@ -0,0 +1,126 @@
+package logging
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/senzing-garage/sz-sdk-go/szconfig"
+	"github.com/senzing-garage/sz-sdk-go/szconfigmanager"
+	"github.com/senzing-garage/sz-sdk-go/szdiagnostic"
+	"github.com/senzing-garage/sz-sdk-go/szengine"
+	"github.com/senzing-garage/sz-sdk-go/szproduct"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Catalog is the message catalog of one component.
+type Catalog struct {
+	// IDMessages maps message numbers to fmt templates.
+	IDMessages map[int]string
+	// IDStatuses maps message numbers to statuses. Messages without an entry take the name of their level.
+	IDStatuses map[int]string
+	// Prefix is the component's log message prefix (e.g. "szengine.").
+	Prefix string
+}
+
+// A Logger writes catalog messages to a slog.Handler.
+type Logger struct {
+	catalog Catalog
+	handler slog.Handler
+	level   *slog.LevelVar
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Levels beyond those defined by log/slog.
+const (
+	LevelTrace = slog.Level(-8)
+	LevelFatal = slog.Level(12)
+	LevelPanic = slog.Level(16)
+)
+
+// DefaultLevel is the level of each component until it is changed by SetLogLevel.
+const DefaultLevel = slog.LevelInfo
+
+// EnvironmentVariable holds log levels for SetLogLevelsFromEnvironment,
+// e.g. "INFO" or "WARN,szengine=TRACE".
+const EnvironmentVariable = "SENZING_LOG_LEVEL"
+
+// Attribute keys added to each record.
+const (
+	KeyComponent = "component"
+	KeyError     = "error"
+	KeyID        = "id"
+	KeyStatus    = "status"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Catalogs of the sz* packages.
+var (
+	SzConfig = Catalog{
+		IDMessages: szconfig.IDMessages,
+		IDStatuses: szconfig.IDStatuses,
+		Prefix:     szconfig.Prefix,
+	}
+	SzConfigManager = Catalog{
+		IDMessages: szconfigmanager.IDMessages,
+		IDStatuses: szconfigmanager.IDStatuses,
+		Prefix:     szconfigmanager.Prefix,
+	}
+	SzDiagnostic = Catalog{
+		IDMessages: szdiagnostic.IDMessages,
+		IDStatuses: szdiagnostic.IDStatuses,
+		Prefix:     szdiagnostic.Prefix,
+	}
+	SzEngine = Catalog{
+		IDMessages: szengine.IDMessages,
+		IDStatuses: szengine.IDStatuses,
+		Prefix:     szengine.Prefix,
+	}
+	SzProduct = Catalog{
+		IDMessages: szproduct.IDMessages,
+		IDStatuses: szproduct.IDStatuses,
+		Prefix:     szproduct.Prefix,
+	}
+)
+
+// Level of each range of message numbers. Numbers outside every range are LevelInfo.
+var levelRanges = []struct {
+	low   int
+	high  int
+	level slog.Level
+}{
+	{0, 999, LevelTrace},
+	{1000, 1999, slog.LevelDebug},
+	{2000, 2999, slog.LevelInfo},
+	{3000, 3999, slog.LevelWarn},
+	{4000, 4999, slog.LevelError},
+	{5000, 5999, LevelFatal},
+	{6000, 6999, LevelPanic},
+	{8000, 8999, LevelTrace}, // Method names, used in observer notifications.
+}
+
+// Runtime log level of each component, by component name.
+var (
+	componentLevels = map[string]*slog.LevelVar{
+		SzConfig.Component():        newLevelVar(),
+		SzConfigManager.Component(): newLevelVar(),
+		SzDiagnostic.Component():    newLevelVar(),
+		SzEngine.Component():        newLevelVar(),
+		SzProduct.Component():       newLevelVar(),
+	}
+	componentLevelsMutex sync.RWMutex
+)
+
+var levelNames = map[slog.Level]string{
+	LevelTrace: "TRACE",
+	LevelFatal: "FATAL",
+	LevelPanic: "PANIC",
+}
@@ -0,0 +1,89 @@
+package observer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Common state of the Sz interface wrappers.
+type decorator struct {
+	component   string
+	componentID int
+	messageIDs  map[string]int
+	notifier    *Notifier
+}
+
+// ----------------------------------------------------------------------------
+// Methods - decorator
+// ----------------------------------------------------------------------------
+
+func (decorator *decorator) notify(ctx context.Context, method string, start time.Time, err error) {
+	if !decorator.notifier.HasObservers() {
+		return
+	}
+	event := Event{
+		Component:   decorator.component,
+		ComponentID: decorator.componentID,
+		Duration:    time.Since(start),
+		MessageID:   decorator.messageIDs[method],
+		Method:      method,
+		Time:        start,
+	}
+	if err != nil {
+		event.Error = err.Error()
+		event.ErrorCode = szerror.Code(event.Error)
+	}
+	decorator.notifier.Notify(ctx, event)
+}
+
+// Forward fragments, notifying when the channel is closed or ctx is cancelled.
+// The event carries the first error sent on the channel.
+func (decorator *decorator) notifyIterator(ctx context.Context, method string, start time.Time, fragments chan senzing.StringFragment) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment)
+	go func() {
+		defer close(result)
+		var err error
+		for fragment := range fragments {
+			if fragment.Error != nil && err == nil {
+				err = fragment.Error
+			}
+			select {
+			case result <- fragment:
+			case <-ctx.Done():
+				decorator.notify(ctx, method, start, ctx.Err())
+				return
+			}
+		}
+		decorator.notify(ctx, method, start, err)
+	}()
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// Index a component's 8xxx method names by method.
+func newDecorator(catalog logging.Catalog, componentID int, notifier *Notifier) decorator {
+	result := decorator{
+		component:   catalog.Component(),
+		componentID: componentID,
+		messageIDs:  map[string]int{},
+		notifier:    notifier,
+	}
+	for messageID, template := range catalog.IDMessages {
+		if messageID >= 8000 && messageID < 9000 && strings.HasPrefix(template, catalog.Prefix) {
+			result.messageIDs[strings.TrimPrefix(template, catalog.Prefix)] = messageID
+		}
+	}
+	return result
+}
@@ -0,0 +1,8 @@
+/*
+The observer package notifies registered observers of SDK method calls.
+
+Wrap any of the Sz interfaces with NewSzConfig, NewSzConfigManager, NewSzDiagnostic, NewSzEngine or NewSzProduct.
+Each call through the wrapper sends an Event to a Notifier, which delivers it to each Observer asynchronously.
+An observer that falls behind has events dropped rather than slowing down the SDK.
+*/
+package observer
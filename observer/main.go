@@ -0,0 +1,79 @@
+package observer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An Event describes one SDK method call.
+type Event struct {
+	// Component is the name of the package whose interface was called, e.g. "szengine".
+	Component   string `json:"component"`
+	ComponentID int    `json:"componentId"`
+	// Duration of the call. For iterator methods, until the channel was closed.
+	Duration time.Duration `json:"duration"`
+	// Error is the text of the error returned, if any.
+	Error string `json:"error,omitempty"`
+	// ErrorCode is the Senzing error code parsed from Error, or 0.
+	ErrorCode int `json:"errorCode,omitempty"`
+	// MessageID is the number of the method's 8xxx entry in the component's IDMessages.
+	MessageID int    `json:"messageId"`
+	Method    string `json:"method"`
+	// Origin identifies the process or service making the call. Set by Notifier.Origin.
+	Origin string    `json:"origin,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// A Notifier delivers events to registered observers.
+// Each observer has its own buffer and goroutine, so a slow observer does not delay the others.
+type Notifier struct {
+	// BufferSize is the number of events buffered for each observer. Defaults to DefaultBufferSize.
+	BufferSize int
+	// Origin is copied into each Event.
+	Origin string
+
+	dropped       atomic.Uint64
+	mutex         sync.RWMutex
+	subscriptions map[string]*subscription
+}
+
+// An Observer receives events from a Notifier.
+type Observer interface {
+	GetObserverID(ctx context.Context) string
+	UpdateObserver(ctx context.Context, event Event)
+}
+
+type subscription struct {
+	done     chan struct{}
+	events   chan delivery
+	observer Observer
+}
+
+type delivery struct {
+	ctx   context.Context
+	event Event
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default values.
+const (
+	DefaultBufferSize = 1000
+)
+
+// Component identifiers used in Event.ComponentID.
+const (
+	ComponentIDSzConfig        = 6001
+	ComponentIDSzConfigManager = 6002
+	ComponentIDSzDiagnostic    = 6003
+	ComponentIDSzEngine        = 6004
+	ComponentIDSzProduct       = 6005
+)
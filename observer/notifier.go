@@ -0,0 +1,145 @@
+package observer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Notifier
+// ----------------------------------------------------------------------------
+
+/*
+The Close method unregisters every observer, waiting for each to receive its buffered events.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (notifier *Notifier) Close(ctx context.Context) {
+	notifier.mutex.Lock()
+	subscriptions := notifier.subscriptions
+	notifier.subscriptions = nil
+	notifier.mutex.Unlock()
+	for _, subscription := range subscriptions {
+		subscription.stop(ctx)
+	}
+}
+
+// The Dropped method returns the number of events dropped because an observer's buffer was full.
+func (notifier *Notifier) Dropped() uint64 {
+	return notifier.dropped.Load()
+}
+
+// The HasObservers method reports whether any observer is registered.
+func (notifier *Notifier) HasObservers() bool {
+	notifier.mutex.RLock()
+	defer notifier.mutex.RUnlock()
+	return len(notifier.subscriptions) > 0
+}
+
+/*
+The Notify method sends an event to every registered observer without blocking.
+If an observer's buffer is full, the event is dropped for that observer and counted by Dropped().
+
+Input
+  - ctx: The context of the SDK call. Observers receive it without its cancellation.
+  - event: The event to send.
+*/
+func (notifier *Notifier) Notify(ctx context.Context, event Event) {
+	if len(event.Origin) == 0 {
+		event.Origin = notifier.Origin
+	}
+	message := delivery{
+		ctx:   context.WithoutCancel(ctx),
+		event: event,
+	}
+	notifier.mutex.RLock()
+	defer notifier.mutex.RUnlock()
+	for _, subscription := range notifier.subscriptions {
+		select {
+		case subscription.events <- message:
+		default:
+			notifier.dropped.Add(1)
+		}
+	}
+}
+
+/*
+The RegisterObserver method adds an observer.
+Observers are identified by GetObserverID(); registering the same ID twice is an error.
+
+Input
+  - ctx: A context to control lifecycle.
+  - observer: The observer to add.
+*/
+func (notifier *Notifier) RegisterObserver(ctx context.Context, observer Observer) error {
+	if observer == nil {
+		return errors.New("observer is nil")
+	}
+	observerID := observer.GetObserverID(ctx)
+	notifier.mutex.Lock()
+	defer notifier.mutex.Unlock()
+	if _, ok := notifier.subscriptions[observerID]; ok {
+		return fmt.Errorf("observer %q is already registered", observerID)
+	}
+	if notifier.subscriptions == nil {
+		notifier.subscriptions = map[string]*subscription{}
+	}
+	bufferSize := notifier.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	subscription := &subscription{
+		done:     make(chan struct{}),
+		events:   make(chan delivery, bufferSize),
+		observer: observer,
+	}
+	notifier.subscriptions[observerID] = subscription
+	go subscription.run()
+	return nil
+}
+
+/*
+The UnregisterObserver method removes an observer, waiting for it to receive its buffered events.
+
+Input
+  - ctx: A context to control lifecycle. If it is cancelled, buffered events may not all be delivered before returning.
+  - observer: The observer to remove.
+*/
+func (notifier *Notifier) UnregisterObserver(ctx context.Context, observer Observer) error {
+	if observer == nil {
+		return errors.New("observer is nil")
+	}
+	observerID := observer.GetObserverID(ctx)
+	notifier.mutex.Lock()
+	subscription, ok := notifier.subscriptions[observerID]
+	delete(notifier.subscriptions, observerID)
+	notifier.mutex.Unlock()
+	if !ok {
+		return fmt.Errorf("observer %q is not registered", observerID)
+	}
+	subscription.stop(ctx)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - subscription
+// ----------------------------------------------------------------------------
+
+func (subscription *subscription) run() {
+	defer close(subscription.done)
+	for message := range subscription.events {
+		subscription.observer.UpdateObserver(message.ctx, message.event)
+	}
+}
+
+// Stop accepting events and wait for those buffered to be delivered.
+// The caller must already have removed the subscription from the Notifier.
+func (subscription *subscription) stop(ctx context.Context) {
+	close(subscription.events)
+	select {
+	case <-subscription.done:
+	case <-ctx.Done():
+	}
+}
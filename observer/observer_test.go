@@ -0,0 +1,188 @@
+package observer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testObserver struct {
+	id      string
+	events  []Event
+	mutex   sync.Mutex
+	release chan struct{}
+	waiting chan struct{}
+}
+
+func (observer *testObserver) GetObserverID(ctx context.Context) string {
+	_ = ctx
+	return observer.id
+}
+
+func (observer *testObserver) UpdateObserver(ctx context.Context, event Event) {
+	_ = ctx
+	if observer.release != nil {
+		observer.waiting <- struct{}{}
+		<-observer.release
+	}
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	observer.events = append(observer.events, event)
+}
+
+func (observer *testObserver) received() []Event {
+	observer.mutex.Lock()
+	defer observer.mutex.Unlock()
+	return append([]Event{}, observer.events...)
+}
+
+type mockSzEngine struct {
+	senzing.SzEngine
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = recordID
+	_ = recordDefinition
+	_ = flags
+	if dataSourceCode == "BAD" {
+		return "", errors.New("SENZ0023|Conflicting DATA_SOURCE values 'BAD' and 'TEST'")
+	}
+	return "{}", nil
+}
+
+func (engine *mockSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	_ = ctx
+	_ = flags
+	result := make(chan senzing.StringFragment, 2)
+	result <- senzing.StringFragment{Value: "{}"}
+	result <- senzing.StringFragment{Error: errors.New("SENZ0033|Unknown record")}
+	close(result)
+	return result
+}
+
+type mockSzProduct struct {
+	senzing.SzProduct
+}
+
+func (product *mockSzProduct) GetVersion(ctx context.Context) (string, error) {
+	_ = ctx
+	return "{}", nil
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestObserver_Notifier_RegisterObserver(test *testing.T) {
+	ctx := context.TODO()
+	notifier := &Notifier{Origin: "test"}
+	observer := &testObserver{id: "1"}
+	assert.False(test, notifier.HasObservers())
+	require.NoError(test, notifier.RegisterObserver(ctx, observer))
+	require.Error(test, notifier.RegisterObserver(ctx, observer))
+	require.Error(test, notifier.RegisterObserver(ctx, nil))
+	assert.True(test, notifier.HasObservers())
+
+	notifier.Notify(ctx, Event{Method: "A"})
+	notifier.Notify(ctx, Event{Method: "B", Origin: "other"})
+	require.NoError(test, notifier.UnregisterObserver(ctx, observer))
+	require.Error(test, notifier.UnregisterObserver(ctx, observer))
+	assert.False(test, notifier.HasObservers())
+
+	events := observer.received()
+	require.Len(test, events, 2, "buffered events are delivered before UnregisterObserver returns")
+	assert.Equal(test, "test", events[0].Origin)
+	assert.Equal(test, "other", events[1].Origin)
+
+	notifier.Notify(ctx, Event{Method: "C"})
+	assert.Len(test, observer.received(), 2)
+}
+
+func TestObserver_Notifier_Dropped(test *testing.T) {
+	ctx := context.TODO()
+	notifier := &Notifier{BufferSize: 1}
+	slow := &testObserver{id: "slow", release: make(chan struct{}), waiting: make(chan struct{}, 10)}
+	fast := &testObserver{id: "fast"}
+	require.NoError(test, notifier.RegisterObserver(ctx, slow))
+	require.NoError(test, notifier.RegisterObserver(ctx, fast))
+
+	notifier.Notify(ctx, Event{Method: "A"})
+	<-slow.waiting
+	require.Eventually(test, func() bool { return len(fast.received()) == 1 }, time.Second, time.Millisecond)
+	notifier.Notify(ctx, Event{Method: "B"}) // Buffered for slow, whose goroutine is blocked on A.
+	require.Eventually(test, func() bool { return len(fast.received()) == 2 }, time.Second, time.Millisecond)
+	notifier.Notify(ctx, Event{Method: "C"}) // Dropped for slow.
+	assert.Equal(test, uint64(1), notifier.Dropped())
+
+	close(slow.release)
+	notifier.Close(ctx)
+	assert.Len(test, slow.received(), 2)
+	assert.Len(test, fast.received(), 3)
+	assert.False(test, notifier.HasObservers())
+}
+
+func TestObserver_NewSzEngine(test *testing.T) {
+	ctx := context.TODO()
+	notifier := &Notifier{}
+	observer := &testObserver{id: "1"}
+	engine := NewSzEngine(&mockSzEngine{}, notifier)
+
+	_, err := engine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzWithoutInfo)
+	require.NoError(test, err, "no observers")
+
+	require.NoError(test, notifier.RegisterObserver(ctx, observer))
+	result, err := engine.AddRecord(ctx, "TEST", "1", "{}", senzing.SzWithoutInfo)
+	require.NoError(test, err)
+	assert.Equal(test, "{}", result)
+	_, err = engine.AddRecord(ctx, "BAD", "1", "{}", senzing.SzWithoutInfo)
+	require.Error(test, err)
+	for fragment := range engine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		_ = fragment
+	}
+	notifier.Close(ctx)
+
+	events := observer.received()
+	require.Len(test, events, 3)
+	assert.Equal(test, Event{
+		Component:   "szengine",
+		ComponentID: ComponentIDSzEngine,
+		Duration:    events[0].Duration,
+		MessageID:   8001,
+		Method:      "AddRecord",
+		Time:        events[0].Time,
+	}, events[0])
+	assert.Equal(test, 23, events[1].ErrorCode)
+	assert.Contains(test, events[1].Error, "Conflicting DATA_SOURCE")
+	assert.Equal(test, "ExportJSONEntityReportIterator", events[2].Method)
+	assert.Equal(test, 8009, events[2].MessageID)
+	assert.Equal(test, 33, events[2].ErrorCode)
+}
+
+func TestObserver_NewSzProduct(test *testing.T) {
+	ctx := context.TODO()
+	notifier := &Notifier{}
+	observer := &testObserver{id: "1"}
+	require.NoError(test, notifier.RegisterObserver(ctx, observer))
+	product := NewSzProduct(&mockSzProduct{}, notifier)
+	_, err := product.GetVersion(ctx)
+	require.NoError(test, err)
+	notifier.Close(ctx)
+	events := observer.received()
+	require.Len(test, events, 1)
+	assert.Equal(test, "szproduct", events[0].Component)
+	assert.Equal(test, 8004, events[0].MessageID)
+}
+
+func TestObserver_Constructors(test *testing.T) {
+	notifier := &Notifier{}
+	assert.Implements(test, (*senzing.SzConfig)(nil), NewSzConfig(nil, notifier))
+	assert.Implements(test, (*senzing.SzConfigManager)(nil), NewSzConfigManager(nil, notifier))
+	assert.Implements(test, (*senzing.SzDiagnostic)(nil), NewSzDiagnostic(nil, notifier))
+}
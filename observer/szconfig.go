@@ -0,0 +1,97 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzConfig that notifies a Notifier of each call.
+type observedSzConfig struct {
+	decorator
+	szConfig senzing.SzConfig
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *observedSzConfig) AddDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) (string, error) {
+	start := time.Now()
+	result, err := client.szConfig.AddDataSource(ctx, configHandle, dataSourceCode)
+	client.notify(ctx, "AddDataSource", start, err)
+	return result, err
+}
+
+func (client *observedSzConfig) CloseConfig(ctx context.Context, configHandle uintptr) error {
+	start := time.Now()
+	err := client.szConfig.CloseConfig(ctx, configHandle)
+	client.notify(ctx, "CloseConfig", start, err)
+	return err
+}
+
+func (client *observedSzConfig) CreateConfig(ctx context.Context) (uintptr, error) {
+	start := time.Now()
+	result, err := client.szConfig.CreateConfig(ctx)
+	client.notify(ctx, "CreateConfig", start, err)
+	return result, err
+}
+
+func (client *observedSzConfig) DeleteDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) error {
+	start := time.Now()
+	err := client.szConfig.DeleteDataSource(ctx, configHandle, dataSourceCode)
+	client.notify(ctx, "DeleteDataSource", start, err)
+	return err
+}
+
+func (client *observedSzConfig) Destroy(ctx context.Context) error {
+	start := time.Now()
+	err := client.szConfig.Destroy(ctx)
+	client.notify(ctx, "Destroy", start, err)
+	return err
+}
+
+func (client *observedSzConfig) ExportConfig(ctx context.Context, configHandle uintptr) (string, error) {
+	start := time.Now()
+	result, err := client.szConfig.ExportConfig(ctx, configHandle)
+	client.notify(ctx, "ExportConfig", start, err)
+	return result, err
+}
+
+func (client *observedSzConfig) GetDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	start := time.Now()
+	result, err := client.szConfig.GetDataSources(ctx, configHandle)
+	client.notify(ctx, "GetDataSources", start, err)
+	return result, err
+}
+
+func (client *observedSzConfig) ImportConfig(ctx context.Context, configDefinition string) (uintptr, error) {
+	start := time.Now()
+	result, err := client.szConfig.ImportConfig(ctx, configDefinition)
+	client.notify(ctx, "ImportConfig", start, err)
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzConfig function returns an SzConfig that notifies notifier of each call to szConfig.
+
+Input
+  - szConfig: The SzConfig to wrap.
+  - notifier: Where events are sent.
+*/
+func NewSzConfig(szConfig senzing.SzConfig, notifier *Notifier) senzing.SzConfig {
+	return &observedSzConfig{
+		decorator: newDecorator(logging.SzConfig, ComponentIDSzConfig, notifier),
+		szConfig:  szConfig,
+	}
+}
@@ -0,0 +1,90 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzConfigManager that notifies a Notifier of each call.
+type observedSzConfigManager struct {
+	decorator
+	szConfigManager senzing.SzConfigManager
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *observedSzConfigManager) AddConfig(ctx context.Context, configDefinition string, configComments string) (int64, error) {
+	start := time.Now()
+	result, err := client.szConfigManager.AddConfig(ctx, configDefinition, configComments)
+	client.notify(ctx, "AddConfig", start, err)
+	return result, err
+}
+
+func (client *observedSzConfigManager) Destroy(ctx context.Context) error {
+	start := time.Now()
+	err := client.szConfigManager.Destroy(ctx)
+	client.notify(ctx, "Destroy", start, err)
+	return err
+}
+
+func (client *observedSzConfigManager) GetConfig(ctx context.Context, configID int64) (string, error) {
+	start := time.Now()
+	result, err := client.szConfigManager.GetConfig(ctx, configID)
+	client.notify(ctx, "GetConfig", start, err)
+	return result, err
+}
+
+func (client *observedSzConfigManager) GetConfigs(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szConfigManager.GetConfigs(ctx)
+	client.notify(ctx, "GetConfigs", start, err)
+	return result, err
+}
+
+func (client *observedSzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := client.szConfigManager.GetDefaultConfigID(ctx)
+	client.notify(ctx, "GetDefaultConfigID", start, err)
+	return result, err
+}
+
+func (client *observedSzConfigManager) ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error {
+	start := time.Now()
+	err := client.szConfigManager.ReplaceDefaultConfigID(ctx, currentDefaultConfigID, newDefaultConfigID)
+	client.notify(ctx, "ReplaceDefaultConfigID", start, err)
+	return err
+}
+
+func (client *observedSzConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	start := time.Now()
+	err := client.szConfigManager.SetDefaultConfigID(ctx, configID)
+	client.notify(ctx, "SetDefaultConfigID", start, err)
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzConfigManager function returns an SzConfigManager that notifies notifier of each call to szConfigManager.
+
+Input
+  - szConfigManager: The SzConfigManager to wrap.
+  - notifier: Where events are sent.
+*/
+func NewSzConfigManager(szConfigManager senzing.SzConfigManager, notifier *Notifier) senzing.SzConfigManager {
+	return &observedSzConfigManager{
+		decorator:       newDecorator(logging.SzConfigManager, ComponentIDSzConfigManager, notifier),
+		szConfigManager: szConfigManager,
+	}
+}
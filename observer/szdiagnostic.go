@@ -0,0 +1,83 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzDiagnostic that notifies a Notifier of each call.
+type observedSzDiagnostic struct {
+	decorator
+	szDiagnostic senzing.SzDiagnostic
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *observedSzDiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
+	start := time.Now()
+	result, err := client.szDiagnostic.CheckDatastorePerformance(ctx, secondsToRun)
+	client.notify(ctx, "CheckDatastorePerformance", start, err)
+	return result, err
+}
+
+func (client *observedSzDiagnostic) Destroy(ctx context.Context) error {
+	start := time.Now()
+	err := client.szDiagnostic.Destroy(ctx)
+	client.notify(ctx, "Destroy", start, err)
+	return err
+}
+
+func (client *observedSzDiagnostic) GetDatastoreInfo(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szDiagnostic.GetDatastoreInfo(ctx)
+	client.notify(ctx, "GetDatastoreInfo", start, err)
+	return result, err
+}
+
+func (client *observedSzDiagnostic) GetFeature(ctx context.Context, featureID int64) (string, error) {
+	start := time.Now()
+	result, err := client.szDiagnostic.GetFeature(ctx, featureID)
+	client.notify(ctx, "GetFeature", start, err)
+	return result, err
+}
+
+func (client *observedSzDiagnostic) PurgeRepository(ctx context.Context) error {
+	start := time.Now()
+	err := client.szDiagnostic.PurgeRepository(ctx)
+	client.notify(ctx, "PurgeRepository", start, err)
+	return err
+}
+
+func (client *observedSzDiagnostic) Reinitialize(ctx context.Context, configID int64) error {
+	start := time.Now()
+	err := client.szDiagnostic.Reinitialize(ctx, configID)
+	client.notify(ctx, "Reinitialize", start, err)
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzDiagnostic function returns an SzDiagnostic that notifies notifier of each call to szDiagnostic.
+
+Input
+  - szDiagnostic: The SzDiagnostic to wrap.
+  - notifier: Where events are sent.
+*/
+func NewSzDiagnostic(szDiagnostic senzing.SzDiagnostic, notifier *Notifier) senzing.SzDiagnostic {
+	return &observedSzDiagnostic{
+		decorator:    newDecorator(logging.SzDiagnostic, ComponentIDSzDiagnostic, notifier),
+		szDiagnostic: szDiagnostic,
+	}
+}
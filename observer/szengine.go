@@ -0,0 +1,266 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzEngine that notifies a Notifier of each call.
+type observedSzEngine struct {
+	decorator
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *observedSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, flags)
+	client.notify(ctx, "AddRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	start := time.Now()
+	err := client.szEngine.CloseExport(ctx, exportHandle)
+	client.notify(ctx, "CloseExport", start, err)
+	return err
+}
+
+func (client *observedSzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := client.szEngine.CountRedoRecords(ctx)
+	client.notify(ctx, "CountRedoRecords", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.DeleteRecord(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "DeleteRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) Destroy(ctx context.Context) error {
+	start := time.Now()
+	err := client.szEngine.Destroy(ctx)
+	client.notify(ctx, "Destroy", start, err)
+	return err
+}
+
+func (client *observedSzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	start := time.Now()
+	result, err := client.szEngine.ExportCsvEntityReport(ctx, csvColumnList, flags)
+	client.notify(ctx, "ExportCsvEntityReport", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	return client.notifyIterator(ctx, "ExportCsvEntityReportIterator", time.Now(), client.szEngine.ExportCsvEntityReportIterator(ctx, csvColumnList, flags))
+}
+
+func (client *observedSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	start := time.Now()
+	result, err := client.szEngine.ExportJSONEntityReport(ctx, flags)
+	client.notify(ctx, "ExportJSONEntityReport", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	return client.notifyIterator(ctx, "ExportJSONEntityReportIterator", time.Now(), client.szEngine.ExportJSONEntityReportIterator(ctx, flags))
+}
+
+func (client *observedSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FetchNext(ctx, exportHandle)
+	client.notify(ctx, "FetchNext", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindInterestingEntitiesByEntityID(ctx, entityID, flags)
+	client.notify(ctx, "FindInterestingEntitiesByEntityID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindInterestingEntitiesByRecordID(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "FindInterestingEntitiesByRecordID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindNetworkByEntityID(ctx, entityIDs, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	client.notify(ctx, "FindNetworkByEntityID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindNetworkByRecordID(ctx, recordKeys, maxDegrees, buildOutDegree, buildOutMaxEntities, flags)
+	client.notify(ctx, "FindNetworkByRecordID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindPathByEntityID(ctx, startEntityID, endEntityID, maxDegrees, avoidEntityIDs, requiredDataSources, flags)
+	client.notify(ctx, "FindPathByEntityID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.FindPathByRecordID(ctx, startDataSourceCode, startRecordID, endDataSourceCode, endRecordID, maxDegrees, avoidRecordKeys, requiredDataSources, flags)
+	client.notify(ctx, "FindPathByRecordID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetActiveConfigID(ctx)
+	client.notify(ctx, "GetActiveConfigID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetEntityByEntityID(ctx, entityID, flags)
+	client.notify(ctx, "GetEntityByEntityID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetEntityByRecordID(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "GetEntityByRecordID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetRecord(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "GetRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetRedoRecord(ctx)
+	client.notify(ctx, "GetRedoRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetStats(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetStats(ctx)
+	client.notify(ctx, "GetStats", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.GetVirtualEntityByRecordID(ctx, recordList, flags)
+	client.notify(ctx, "GetVirtualEntityByRecordID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.HowEntityByEntityID(ctx, entityID, flags)
+	client.notify(ctx, "HowEntityByEntityID", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) PrimeEngine(ctx context.Context) error {
+	start := time.Now()
+	err := client.szEngine.PrimeEngine(ctx)
+	client.notify(ctx, "PrimeEngine", start, err)
+	return err
+}
+
+func (client *observedSzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.ProcessRedoRecord(ctx, redoRecord, flags)
+	client.notify(ctx, "ProcessRedoRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.ReevaluateEntity(ctx, entityID, flags)
+	client.notify(ctx, "ReevaluateEntity", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.ReevaluateRecord(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "ReevaluateRecord", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	start := time.Now()
+	err := client.szEngine.Reinitialize(ctx, configID)
+	client.notify(ctx, "Reinitialize", start, err)
+	return err
+}
+
+func (client *observedSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.SearchByAttributes(ctx, attributes, searchProfile, flags)
+	client.notify(ctx, "SearchByAttributes", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.WhyEntities(ctx, entityID1, entityID2, flags)
+	client.notify(ctx, "WhyEntities", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.WhyRecordInEntity(ctx, dataSourceCode, recordID, flags)
+	client.notify(ctx, "WhyRecordInEntity", start, err)
+	return result, err
+}
+
+func (client *observedSzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	start := time.Now()
+	result, err := client.szEngine.WhyRecords(ctx, dataSourceCode1, recordID1, dataSourceCode2, recordID2, flags)
+	client.notify(ctx, "WhyRecords", start, err)
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzEngine function returns an SzEngine that notifies notifier of each call to szEngine.
+
+Input
+  - szEngine: The SzEngine to wrap.
+  - notifier: Where events are sent.
+*/
+func NewSzEngine(szEngine senzing.SzEngine, notifier *Notifier) senzing.SzEngine {
+	return &observedSzEngine{
+		decorator: newDecorator(logging.SzEngine, ComponentIDSzEngine, notifier),
+		szEngine:  szEngine,
+	}
+}
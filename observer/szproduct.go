@@ -0,0 +1,62 @@
+package observer
+
+import (
+	"context"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/logging"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An SzProduct that notifies a Notifier of each call.
+type observedSzProduct struct {
+	decorator
+	szProduct senzing.SzProduct
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *observedSzProduct) Destroy(ctx context.Context) error {
+	start := time.Now()
+	err := client.szProduct.Destroy(ctx)
+	client.notify(ctx, "Destroy", start, err)
+	return err
+}
+
+func (client *observedSzProduct) GetLicense(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szProduct.GetLicense(ctx)
+	client.notify(ctx, "GetLicense", start, err)
+	return result, err
+}
+
+func (client *observedSzProduct) GetVersion(ctx context.Context) (string, error) {
+	start := time.Now()
+	result, err := client.szProduct.GetVersion(ctx)
+	client.notify(ctx, "GetVersion", start, err)
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewSzProduct function returns an SzProduct that notifies notifier of each call to szProduct.
+
+Input
+  - szProduct: The SzProduct to wrap.
+  - notifier: Where events are sent.
+*/
+func NewSzProduct(szProduct senzing.SzProduct, notifier *Notifier) senzing.SzProduct {
+	return &observedSzProduct{
+		decorator: newDecorator(logging.SzProduct, ComponentIDSzProduct, notifier),
+		szProduct: szProduct,
+	}
+}
@@ -0,0 +1,15 @@
+/*
+The purge package deletes every record of one data source.
+
+A Purger finds the records of the data source by exporting all entities with their record data,
+deletes them with DeleteRecord using several goroutines, retrying errors classified as retryable,
+and exports again to verify that none remain. Records still found, for example because a loader added them meanwhile,
+are deleted in a further pass, up to MaxPasses.
+
+With RemoveDataSource set, the data source is then deleted from the default configuration,
+which is replaced by the new configuration, and the engine is reinitialized with it.
+Other processes using the repository must reinitialize themselves.
+
+Each export reads the whole repository, so a purge takes time proportional to the repository, not to the data source.
+*/
+package purge
@@ -0,0 +1,82 @@
+package purge
+
+import (
+	"errors"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Progress of a purge, reported through Purger.OnProgress.
+type Progress struct {
+	DataSourceCode string `json:"dataSourceCode"`
+	// Deleted is the number of records deleted in all passes so far.
+	Deleted int `json:"deleted"`
+	// Failed is the number of records that could not be deleted in this pass.
+	Failed int `json:"failed"`
+	// Found is the number of records found by the export of this pass.
+	Found int `json:"found"`
+	// Pass is the number of the current pass, from 1.
+	Pass int `json:"pass"`
+}
+
+// A Purger deletes the records of a data source.
+type Purger struct {
+	// Concurrency is the number of goroutines calling DeleteRecord. Defaults to DefaultConcurrency.
+	Concurrency int
+	// Config and ConfigManager are required by RemoveDataSource.
+	Config        senzing.SzConfig
+	ConfigManager senzing.SzConfigManager
+	Engine        senzing.SzEngine
+	// MaxPasses is the number of times records found are deleted before giving up. Defaults to DefaultMaxPasses.
+	MaxPasses int
+	// OnProgress, if set, is called after each export and each record deleted or failed.
+	// Calls are not concurrent.
+	OnProgress func(progress Progress)
+	// RemoveDataSource deletes the data source from the default configuration once no records remain.
+	RemoveDataSource bool
+	// Retries is the number of times a retryable error from DeleteRecord is retried. Defaults to DefaultRetries.
+	Retries int
+	// RetryInterval is the delay before the first retry, doubling for each further retry. Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+}
+
+// The Result of a purge.
+type Result struct {
+	// ConfigID is the new default configuration, if RemoveDataSource was set and succeeded.
+	ConfigID int64 `json:"configId,omitempty"`
+	// Deleted is the number of records deleted.
+	Deleted int `json:"deleted"`
+	// Failed holds the last error for each record that could not be deleted in the last pass.
+	Failed map[string]error `json:"-"`
+	// Passes is the number of deletion passes made.
+	Passes int `json:"passes"`
+	// Remaining is the number of records found by the last export.
+	Remaining int `json:"remaining"`
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Default values.
+const (
+	DefaultConcurrency   = 8
+	DefaultMaxPasses     = 3
+	DefaultRetries       = 3
+	DefaultRetryInterval = time.Second
+)
+
+// Flags for the export finding the records of a data source.
+const exportFlags = senzing.SzExportIncludeAllEntities | senzing.SzEntityIncludeRecordData
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// ErrIncomplete is returned when records of the data source remain after MaxPasses.
+var ErrIncomplete = errors.New("purge incomplete")
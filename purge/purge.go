@@ -0,0 +1,262 @@
+package purge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Purger
+// ----------------------------------------------------------------------------
+
+/*
+The PurgeDataSource method deletes every record of a data source, verifying that none remain.
+If records remain after MaxPasses, the error is ErrIncomplete and the Result tells which could not be deleted.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: The data source whose records are deleted.
+*/
+func (purger *Purger) PurgeDataSource(ctx context.Context, dataSourceCode string) (*Result, error) {
+	result := &Result{Failed: map[string]error{}}
+	progress := Progress{DataSourceCode: dataSourceCode}
+	for pass := 1; ; pass++ {
+		recordIDs, err := purger.findRecords(ctx, dataSourceCode)
+		if err != nil {
+			return result, err
+		}
+		result.Remaining = len(recordIDs)
+		if len(recordIDs) == 0 {
+			break
+		}
+		if pass > purger.maxPasses() {
+			return result, fmt.Errorf("%w: %d records of %s remain after %d passes", ErrIncomplete, len(recordIDs), dataSourceCode, result.Passes)
+		}
+		result.Passes = pass
+		progress.Failed = 0
+		progress.Found = len(recordIDs)
+		progress.Pass = pass
+		purger.report(progress)
+		if err := purger.deleteRecords(ctx, dataSourceCode, recordIDs, result, &progress); err != nil {
+			return result, err
+		}
+	}
+	if purger.RemoveDataSource {
+		configID, err := purger.removeDataSource(ctx, dataSourceCode)
+		if err != nil {
+			return result, err
+		}
+		result.ConfigID = configID
+	}
+	return result, nil
+}
+
+func (purger *Purger) concurrency() int {
+	if purger.Concurrency > 0 {
+		return purger.Concurrency
+	}
+	return DefaultConcurrency
+}
+
+// Delete a record, retrying retryable errors. A record already deleted is not an error.
+func (purger *Purger) deleteRecord(ctx context.Context, dataSourceCode string, recordID string) error {
+	delay := purger.retryInterval()
+	for attempt := 0; ; attempt++ {
+		_, err := purger.Engine.DeleteRecord(ctx, dataSourceCode, recordID, senzing.SzNoFlags)
+		switch {
+		case err == nil, errors.Is(err, szerror.ErrSzNotFound):
+			return nil
+		case attempt >= purger.retries() || !szerror.IsRetryable(err):
+			return err
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay *= 2
+	}
+}
+
+// Delete records in parallel, recording the outcome of each in result and progress.
+func (purger *Purger) deleteRecords(ctx context.Context, dataSourceCode string, recordIDs []string, result *Result, progress *Progress) error {
+	recordIDChannel := make(chan string)
+	var (
+		group sync.WaitGroup
+		mutex sync.Mutex
+	)
+	for i := 0; i < min(purger.concurrency(), len(recordIDs)); i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for recordID := range recordIDChannel {
+				err := purger.deleteRecord(ctx, dataSourceCode, recordID)
+				mutex.Lock()
+				if err == nil {
+					delete(result.Failed, recordID)
+					result.Deleted++
+					progress.Deleted++
+				} else {
+					result.Failed[recordID] = err
+					progress.Failed++
+				}
+				purger.report(*progress)
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, recordID := range recordIDs {
+		select {
+		case recordIDChannel <- recordID:
+		case <-ctx.Done():
+		}
+	}
+	close(recordIDChannel)
+	group.Wait()
+	return ctx.Err()
+}
+
+// Export all entities, returning the IDs of the records of the data source.
+func (purger *Purger) findRecords(ctx context.Context, dataSourceCode string) ([]string, error) {
+	exportHandle, err := purger.Engine.ExportJSONEntityReport(ctx, exportFlags)
+	if err != nil {
+		return nil, err
+	}
+	recordIDs, err := purger.readExport(ctx, exportHandle, dataSourceCode)
+	return recordIDs, errors.Join(err, purger.Engine.CloseExport(ctx, exportHandle))
+}
+
+func (purger *Purger) maxPasses() int {
+	if purger.MaxPasses > 0 {
+		return purger.MaxPasses
+	}
+	return DefaultMaxPasses
+}
+
+// Read an export to its end, one entity per line.
+func (purger *Purger) readExport(ctx context.Context, exportHandle uintptr, dataSourceCode string) ([]string, error) {
+	var (
+		buffered string
+		result   []string
+	)
+	seen := map[string]struct{}{}
+	for {
+		fragment, err := purger.Engine.FetchNext(ctx, exportHandle)
+		if err != nil {
+			return nil, err
+		}
+		buffered += fragment
+		lines := strings.Split(buffered, "\n")
+		if len(fragment) > 0 {
+			buffered = lines[len(lines)-1]
+			lines = lines[:len(lines)-1]
+		}
+		for _, line := range lines {
+			recordIDs, err := entityRecordIDs(line, dataSourceCode)
+			if err != nil {
+				return nil, err
+			}
+			for _, recordID := range recordIDs {
+				if _, ok := seen[recordID]; !ok {
+					seen[recordID] = struct{}{}
+					result = append(result, recordID)
+				}
+			}
+		}
+		if len(fragment) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// Delete the data source from the default configuration and make the result the default.
+func (purger *Purger) removeDataSource(ctx context.Context, dataSourceCode string) (int64, error) {
+	if purger.Config == nil || purger.ConfigManager == nil {
+		return 0, errors.New("removing a data source requires Config and ConfigManager")
+	}
+	currentConfigID, err := purger.ConfigManager.GetDefaultConfigID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	configDefinition, err := purger.ConfigManager.GetConfig(ctx, currentConfigID)
+	if err != nil {
+		return 0, err
+	}
+	configHandle, err := purger.Config.ImportConfig(ctx, configDefinition)
+	if err != nil {
+		return 0, err
+	}
+	err = purger.Config.DeleteDataSource(ctx, configHandle, dataSourceCode)
+	if err == nil {
+		configDefinition, err = purger.Config.ExportConfig(ctx, configHandle)
+	}
+	err = errors.Join(err, purger.Config.CloseConfig(ctx, configHandle))
+	if err != nil {
+		return 0, err
+	}
+	configID, err := purger.ConfigManager.AddConfig(ctx, configDefinition, fmt.Sprintf("Removed data source %s", dataSourceCode))
+	if err != nil {
+		return 0, err
+	}
+	if err := purger.ConfigManager.ReplaceDefaultConfigID(ctx, currentConfigID, configID); err != nil {
+		return 0, err
+	}
+	return configID, purger.Engine.Reinitialize(ctx, configID)
+}
+
+func (purger *Purger) report(progress Progress) {
+	if purger.OnProgress != nil {
+		purger.OnProgress(progress)
+	}
+}
+
+func (purger *Purger) retries() int {
+	if purger.Retries > 0 {
+		return purger.Retries
+	}
+	return DefaultRetries
+}
+
+func (purger *Purger) retryInterval() time.Duration {
+	if purger.RetryInterval > 0 {
+		return purger.RetryInterval
+	}
+	return DefaultRetryInterval
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// The IDs of the records of a data source in an exported entity. Only the record keys are decoded.
+func entityRecordIDs(line string, dataSourceCode string) ([]string, error) {
+	if len(strings.TrimSpace(line)) == 0 {
+		return nil, nil
+	}
+	var entity struct {
+		ResolvedEntity struct {
+			Records []struct {
+				DataSource string `json:"DATA_SOURCE"`
+				RecordID   string `json:"RECORD_ID"`
+			} `json:"RECORDS"`
+		} `json:"RESOLVED_ENTITY"`
+	}
+	if err := json.Unmarshal([]byte(line), &entity); err != nil {
+		return nil, fmt.Errorf("cannot parse exported entity: %w", err)
+	}
+	var result []string
+	for _, record := range entity.ResolvedEntity.Records {
+		if record.DataSource == dataSourceCode {
+			result = append(result, record.RecordID)
+		}
+	}
+	return result, nil
+}
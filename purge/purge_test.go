@@ -0,0 +1,314 @@
+package purge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordKey struct {
+	dataSourceCode string
+	recordID       string
+}
+
+// An engine holding entities of records, exported a few bytes at a time.
+type mockSzEngine struct {
+	senzing.SzEngine
+	closed       int
+	entities     [][]recordKey
+	exports      map[uintptr]string
+	failures     map[string]int
+	mutex        sync.Mutex
+	notFound     map[string]bool
+	permanent    map[string]bool
+	readd        map[string]bool
+	reinitialize int64
+}
+
+func (engine *mockSzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_ = ctx
+	delete(engine.exports, exportHandle)
+	engine.closed++
+	return nil
+}
+
+func (engine *mockSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	engine.mutex.Lock()
+	defer engine.mutex.Unlock()
+	switch {
+	case engine.failures[recordID] > 0:
+		engine.failures[recordID]--
+		return "", szerror.New(10, "SENZ0010E|Retry timeout exceeded RES_ENT_ID locklist [1]")
+	case engine.permanent[recordID]:
+		return "", szerror.New(1010, "SENZ1010E|Transaction Error 'test'")
+	}
+	key := recordKey{dataSourceCode: dataSourceCode, recordID: recordID}
+	for index, records := range engine.entities {
+		for position, record := range records {
+			if record == key {
+				engine.entities[index] = append(records[:position:position], records[position+1:]...)
+			}
+		}
+	}
+	if engine.notFound[recordID] {
+		return "", szerror.New(33, "SENZ0033E|Unknown record")
+	}
+	if engine.readd[recordID] {
+		delete(engine.readd, recordID)
+		engine.entities = append(engine.entities, []recordKey{key})
+	}
+	return "", nil
+}
+
+func (engine *mockSzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	_ = ctx
+	if flags&senzing.SzEntityIncludeRecordData == 0 {
+		return 0, fmt.Errorf("flags %d omit record data", flags)
+	}
+	var export strings.Builder
+	for index, records := range engine.entities {
+		type record struct {
+			DataSource string `json:"DATA_SOURCE"`
+			RecordID   string `json:"RECORD_ID"`
+		}
+		entity := map[string]any{"RESOLVED_ENTITY": map[string]any{"ENTITY_ID": index + 1, "RECORDS": []record{}}}
+		for _, key := range records {
+			resolved := entity["RESOLVED_ENTITY"].(map[string]any)
+			resolved["RECORDS"] = append(resolved["RECORDS"].([]record), record{DataSource: key.dataSourceCode, RecordID: key.recordID})
+		}
+		line, err := json.Marshal(entity)
+		if err != nil {
+			return 0, err
+		}
+		export.Write(line)
+		export.WriteString("\n")
+	}
+	if engine.exports == nil {
+		engine.exports = map[uintptr]string{}
+	}
+	exportHandle := uintptr(len(engine.exports) + 1)
+	engine.exports[exportHandle] = export.String()
+	return exportHandle, nil
+}
+
+func (engine *mockSzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	_ = ctx
+	export := engine.exports[exportHandle]
+	fragment := export[:min(len(export), 7)]
+	engine.exports[exportHandle] = export[len(fragment):]
+	return fragment, nil
+}
+
+func (engine *mockSzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	_ = ctx
+	engine.reinitialize = configID
+	return nil
+}
+
+func (engine *mockSzEngine) count(dataSourceCode string) int {
+	result := 0
+	for _, records := range engine.entities {
+		for _, record := range records {
+			if record.dataSourceCode == dataSourceCode {
+				result++
+			}
+		}
+	}
+	return result
+}
+
+type mockSzConfig struct {
+	senzing.SzConfig
+	calls []string
+}
+
+func (config *mockSzConfig) CloseConfig(ctx context.Context, configHandle uintptr) error {
+	_ = ctx
+	config.calls = append(config.calls, fmt.Sprintf("CloseConfig %d", configHandle))
+	return nil
+}
+
+func (config *mockSzConfig) DeleteDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) error {
+	_ = ctx
+	config.calls = append(config.calls, fmt.Sprintf("DeleteDataSource %d %s", configHandle, dataSourceCode))
+	return nil
+}
+
+func (config *mockSzConfig) ExportConfig(ctx context.Context, configHandle uintptr) (string, error) {
+	_ = ctx
+	config.calls = append(config.calls, fmt.Sprintf("ExportConfig %d", configHandle))
+	return `{"new":true}`, nil
+}
+
+func (config *mockSzConfig) ImportConfig(ctx context.Context, configDefinition string) (uintptr, error) {
+	_ = ctx
+	config.calls = append(config.calls, "ImportConfig "+configDefinition)
+	return 5, nil
+}
+
+type mockSzConfigManager struct {
+	senzing.SzConfigManager
+	calls []string
+}
+
+func (configManager *mockSzConfigManager) AddConfig(ctx context.Context, configDefinition string, configComments string) (int64, error) {
+	_ = ctx
+	configManager.calls = append(configManager.calls, fmt.Sprintf("AddConfig %s %s", configDefinition, configComments))
+	return 200, nil
+}
+
+func (configManager *mockSzConfigManager) GetConfig(ctx context.Context, configID int64) (string, error) {
+	_ = ctx
+	configManager.calls = append(configManager.calls, fmt.Sprintf("GetConfig %d", configID))
+	return `{"old":true}`, nil
+}
+
+func (configManager *mockSzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	_ = ctx
+	configManager.calls = append(configManager.calls, "GetDefaultConfigID")
+	return 100, nil
+}
+
+func (configManager *mockSzConfigManager) ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error {
+	_ = ctx
+	configManager.calls = append(configManager.calls, fmt.Sprintf("ReplaceDefaultConfigID %d %d", currentDefaultConfigID, newDefaultConfigID))
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func newEngine() *mockSzEngine {
+	return &mockSzEngine{
+		entities: [][]recordKey{
+			{{"TEST", "1"}, {"OTHER", "1"}},
+			{{"TEST", "2"}, {"TEST", "3"}},
+			{{"OTHER", "2"}},
+			{{"TEST", "4"}},
+			{{"TEST", "5"}, {"TEST", "6"}, {"OTHER", "3"}},
+		},
+		failures:  map[string]int{},
+		notFound:  map[string]bool{},
+		permanent: map[string]bool{},
+		readd:     map[string]bool{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestPurger_PurgeDataSource(test *testing.T) {
+	ctx := context.TODO()
+	engine := newEngine()
+	engine.failures["2"] = 2
+	engine.notFound["6"] = true
+	var reports []Progress
+	purger := &Purger{
+		Concurrency:   3,
+		Engine:        engine,
+		OnProgress:    func(progress Progress) { reports = append(reports, progress) },
+		RetryInterval: time.Millisecond,
+	}
+	result, err := purger.PurgeDataSource(ctx, "TEST")
+	require.NoError(test, err)
+	assert.Equal(test, 6, result.Deleted)
+	assert.Equal(test, 1, result.Passes)
+	assert.Zero(test, result.Remaining)
+	assert.Empty(test, result.Failed)
+	assert.Zero(test, engine.count("TEST"))
+	assert.Equal(test, 3, engine.count("OTHER"))
+	assert.Equal(test, 2, engine.closed)
+
+	require.Len(test, reports, 7)
+	assert.Equal(test, Progress{DataSourceCode: "TEST", Found: 6, Pass: 1}, reports[0])
+	assert.Equal(test, Progress{DataSourceCode: "TEST", Deleted: 6, Found: 6, Pass: 1}, reports[6])
+}
+
+func TestPurger_PurgeDataSource_passes(test *testing.T) {
+	ctx := context.TODO()
+	engine := newEngine()
+	engine.readd["4"] = true
+	purger := &Purger{Engine: engine}
+	result, err := purger.PurgeDataSource(ctx, "TEST")
+	require.NoError(test, err)
+	assert.Equal(test, 2, result.Passes)
+	assert.Equal(test, 7, result.Deleted)
+	assert.Zero(test, engine.count("TEST"))
+}
+
+func TestPurger_PurgeDataSource_incomplete(test *testing.T) {
+	ctx := context.TODO()
+	engine := newEngine()
+	engine.permanent["3"] = true
+	engine.failures["5"] = 100
+	purger := &Purger{Engine: engine, MaxPasses: 2, Retries: 1, RetryInterval: time.Millisecond}
+	result, err := purger.PurgeDataSource(ctx, "TEST")
+	require.ErrorIs(test, err, ErrIncomplete)
+	assert.Equal(test, "purge incomplete: 2 records of TEST remain after 2 passes", err.Error())
+	assert.Equal(test, 2, result.Remaining)
+	require.Len(test, result.Failed, 2)
+	require.ErrorIs(test, result.Failed["3"], szerror.ErrSzUnrecoverable)
+	require.ErrorIs(test, result.Failed["5"], szerror.ErrSzRetryable)
+	assert.Equal(test, 96, engine.failures["5"])
+}
+
+func TestPurger_PurgeDataSource_cancelled(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	engine := newEngine()
+	engine.failures["1"] = 100
+	purger := &Purger{
+		Concurrency:   1,
+		Engine:        engine,
+		OnProgress:    func(progress Progress) { cancel() },
+		RetryInterval: time.Hour,
+	}
+	_, err := purger.PurgeDataSource(ctx, "TEST")
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestPurger_PurgeDataSource_RemoveDataSource(test *testing.T) {
+	ctx := context.TODO()
+	engine := newEngine()
+	config := &mockSzConfig{}
+	configManager := &mockSzConfigManager{}
+	purger := &Purger{Config: config, ConfigManager: configManager, Engine: engine, RemoveDataSource: true}
+	result, err := purger.PurgeDataSource(ctx, "TEST")
+	require.NoError(test, err)
+	assert.Equal(test, int64(200), result.ConfigID)
+	assert.Equal(test, int64(200), engine.reinitialize)
+	assert.Equal(test, []string{"ImportConfig {\"old\":true}", "DeleteDataSource 5 TEST", "ExportConfig 5", "CloseConfig 5"}, config.calls)
+	assert.Equal(test, []string{
+		"GetDefaultConfigID",
+		"GetConfig 100",
+		"AddConfig {\"new\":true} Removed data source TEST",
+		"ReplaceDefaultConfigID 100 200",
+	}, configManager.calls)
+
+	purger = &Purger{Engine: newEngine(), RemoveDataSource: true}
+	_, err = purger.PurgeDataSource(ctx, "TEST")
+	require.Error(test, err)
+}
+
+func TestPurger_entityRecordIDs(test *testing.T) {
+	recordIDs, err := entityRecordIDs(`{"RESOLVED_ENTITY":{"RECORDS":[{"DATA_SOURCE":"TEST","RECORD_ID":"A"},{"DATA_SOURCE":"OTHER","RECORD_ID":"B"}]}}`, "TEST")
+	require.NoError(test, err)
+	assert.Equal(test, []string{"A"}, recordIDs)
+	recordIDs, err = entityRecordIDs("  ", "TEST")
+	require.NoError(test, err)
+	assert.Empty(test, recordIDs)
+	_, err = entityRecordIDs("{", "TEST")
+	require.Error(test, err)
+}
@@ -0,0 +1,308 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Builder
+// ----------------------------------------------------------------------------
+
+/*
+The Build method returns the request, or the first error recorded by a With method.
+At least one attribute must have been added.
+*/
+func (builder *Builder) Build() (*Request, error) {
+	if builder.err != nil {
+		return nil, errors.Join(szerror.ErrSzBadInput, builder.err)
+	}
+	if len(builder.attributes) == 0 {
+		return nil, errors.Join(szerror.ErrSzBadInput, errors.New("no search attributes"))
+	}
+	attributes, err := json.Marshal(builder.attributes)
+	if err != nil {
+		return nil, err
+	}
+	return &Request{
+		Attributes: string(attributes),
+		Flags:      builder.flags,
+		Profile:    builder.profile,
+	}, nil
+}
+
+/*
+The Search method builds the request and runs it. See Request.Search.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine to search.
+*/
+func (builder *Builder) Search(ctx context.Context, szEngine senzing.SzEngine) (*Result, error) {
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return request.Search(ctx, szEngine)
+}
+
+/*
+The WithAddress method adds an address to ADDRESSES.
+
+Input
+  - address: The address. Full, or at least one other part, must be set.
+*/
+func (builder *Builder) WithAddress(address Address) *Builder {
+	return builder.add("ADDRESSES", "address", map[string]string{
+		"ADDR_CITY":        address.City,
+		"ADDR_COUNTRY":     address.Country,
+		"ADDR_FULL":        address.Full,
+		"ADDR_LINE1":       address.Line1,
+		"ADDR_LINE2":       address.Line2,
+		"ADDR_LINE3":       address.Line3,
+		"ADDR_POSTAL_CODE": address.PostalCode,
+		"ADDR_STATE":       address.State,
+		"ADDR_TYPE":        address.Type,
+	}, "ADDR_TYPE")
+}
+
+/*
+The WithAttribute method adds an attribute with no typed method, e.g. "WEBSITE_ADDRESS", to OTHERS.
+
+Input
+  - name: The Senzing attribute name.
+  - value: The value of the attribute.
+*/
+func (builder *Builder) WithAttribute(name string, value string) *Builder {
+	if len(strings.TrimSpace(name)) == 0 {
+		return builder.fail(errors.New("attribute name is empty"))
+	}
+	return builder.add("OTHERS", strings.ToLower(name), map[string]string{strings.ToUpper(name): value})
+}
+
+/*
+The WithDateOfBirth method adds a date of birth to DATES.
+
+Input
+  - dateOfBirth: The date, complete or partial, e.g. "1980-05-14", "14/05/1980" or "1980".
+*/
+func (builder *Builder) WithDateOfBirth(dateOfBirth string) *Builder {
+	return builder.add("DATES", "date of birth", map[string]string{"DATE_OF_BIRTH": dateOfBirth})
+}
+
+// The WithEmail method adds an email address to EMAILS.
+func (builder *Builder) WithEmail(emailAddress string) *Builder {
+	if value := strings.TrimSpace(emailAddress); len(value) > 0 && !strings.Contains(value, "@") {
+		return builder.fail(fmt.Errorf("email address %q has no @", emailAddress))
+	}
+	return builder.add("EMAILS", "email address", map[string]string{"EMAIL_ADDRESS": emailAddress})
+}
+
+/*
+The WithFlags method sets the flags, replacing a Preset.
+
+Input
+  - flags: SzSearchByAttributes flags, e.g. senzing.SzSearchByAttributesStrong | senzing.SzSearchIncludeStats.
+*/
+func (builder *Builder) WithFlags(flags int64) *Builder {
+	builder.flags = flags
+	return builder
+}
+
+// The WithFullName method adds a name to NAMES as NAME_FULL.
+func (builder *Builder) WithFullName(fullName string) *Builder {
+	return builder.WithName(Name{Full: fullName})
+}
+
+/*
+The WithIdentifier method adds an identifier to IDENTIFIERS.
+
+Input
+  - identifier: The identifier. Number and Type must be set.
+*/
+func (builder *Builder) WithIdentifier(identifier Identifier) *Builder {
+	if len(strings.TrimSpace(string(identifier.Type))) == 0 {
+		return builder.fail(errors.New("identifier type is empty"))
+	}
+	if len(strings.TrimSpace(identifier.Number)) == 0 {
+		return builder.fail(fmt.Errorf("%s identifier number is empty", identifier.Type))
+	}
+	attributes := map[string]string{}
+	countryKey, ok := identifierCountries[identifier.Type]
+	switch {
+	case identifier.Type == IdentifierSSN:
+		if len(identifier.Country) > 0 {
+			return builder.fail(errors.New("SSN identifier has no country"))
+		}
+		attributes["SSN_NUMBER"] = identifier.Number
+	case ok:
+		attributes[string(identifier.Type)+"_NUMBER"] = identifier.Number
+		attributes[countryKey] = identifier.Country
+	default:
+		attributes["OTHER_ID_COUNTRY"] = identifier.Country
+		attributes["OTHER_ID_NUMBER"] = identifier.Number
+		attributes["OTHER_ID_TYPE"] = string(identifier.Type)
+	}
+	return builder.add("IDENTIFIERS", "identifier", attributes)
+}
+
+/*
+The WithName method adds a name to NAMES.
+
+Input
+  - name: The name. Full, Organization, or at least one other part, must be set, but not more than one of these.
+*/
+func (builder *Builder) WithName(name Name) *Builder {
+	parts := 0
+	for _, value := range []string{name.Full, name.Organization, name.First + name.Last + name.Middle + name.Prefix + name.Suffix} {
+		if len(strings.TrimSpace(value)) > 0 {
+			parts++
+		}
+	}
+	if parts > 1 {
+		return builder.fail(errors.New("name sets more than one of full name, organization name and name parts"))
+	}
+	return builder.add("NAMES", "name", map[string]string{
+		"NAME_FIRST":  name.First,
+		"NAME_FULL":   name.Full,
+		"NAME_LAST":   name.Last,
+		"NAME_MIDDLE": name.Middle,
+		"NAME_ORG":    name.Organization,
+		"NAME_PREFIX": name.Prefix,
+		"NAME_SUFFIX": name.Suffix,
+		"NAME_TYPE":   name.Type,
+	}, "NAME_TYPE")
+}
+
+// The WithNameParts method adds a name to NAMES as NAME_FIRST, NAME_MIDDLE and NAME_LAST.
+func (builder *Builder) WithNameParts(first string, middle string, last string) *Builder {
+	return builder.WithName(Name{First: first, Last: last, Middle: middle})
+}
+
+// The WithOrganizationName method adds a name to NAMES as NAME_ORG.
+func (builder *Builder) WithOrganizationName(organizationName string) *Builder {
+	return builder.WithName(Name{Organization: organizationName})
+}
+
+// The WithPhone method adds a phone number to PHONES.
+func (builder *Builder) WithPhone(phoneNumber string) *Builder {
+	return builder.add("PHONES", "phone number", map[string]string{"PHONE_NUMBER": phoneNumber})
+}
+
+/*
+The WithPreset method sets the flags to those of a preset, replacing earlier flags.
+
+Input
+  - preset: One of the Preset constants.
+*/
+func (builder *Builder) WithPreset(preset Preset) *Builder {
+	flags, ok := PresetFlags[preset]
+	if !ok {
+		return builder.fail(fmt.Errorf("unknown preset %q", preset))
+	}
+	builder.flags = flags
+	return builder
+}
+
+/*
+The WithProfile method sets the search profile.
+
+Input
+  - profile: One of the Profile constants, or a profile defined in the Senzing configuration.
+*/
+func (builder *Builder) WithProfile(profile Profile) *Builder {
+	builder.profile = profile
+	return builder
+}
+
+// The WithStatistics method adds senzing.SzSearchIncludeStats to the flags.
+func (builder *Builder) WithStatistics() *Builder {
+	builder.flags |= senzing.SzSearchIncludeStats
+	return builder
+}
+
+// Add an entry, without its empty attributes, to a list of the search attributes.
+// The entry fails if only its optional attributes are set.
+func (builder *Builder) add(list string, what string, entry map[string]string, optional ...string) *Builder {
+	required := 0
+	for key, value := range entry {
+		value = strings.TrimSpace(value)
+		if len(value) == 0 {
+			delete(entry, key)
+			continue
+		}
+		entry[key] = value
+		if !slices.Contains(optional, key) {
+			required++
+		}
+	}
+	if required == 0 {
+		return builder.fail(fmt.Errorf("%s is empty", what))
+	}
+	if builder.attributes == nil {
+		builder.attributes = map[string][]map[string]string{}
+	}
+	builder.attributes[list] = append(builder.attributes[list], entry)
+	return builder
+}
+
+func (builder *Builder) fail(err error) *Builder {
+	if builder.err == nil {
+		builder.err = err
+	}
+	return builder
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Request
+// ----------------------------------------------------------------------------
+
+/*
+The Search method calls SearchByAttributes and parses its response.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szEngine: The engine to search.
+*/
+func (request *Request) Search(ctx context.Context, szEngine senzing.SzEngine) (*Result, error) {
+	result, err := szEngine.SearchByAttributes(ctx, request.Attributes, string(request.Profile), request.Flags)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResult(ctx, result)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The NewBuilder function returns a Builder with PresetDefault flags and ProfileDefault.
+*/
+func NewBuilder() *Builder {
+	return &Builder{
+		flags:   PresetFlags[PresetDefault],
+		profile: ProfileDefault,
+	}
+}
+
+/*
+The ParsePreset function returns the Preset with a name, ignoring case.
+
+Input
+  - name: The name, e.g. "strong" or "MINIMAL-ALL". Underscores may be used for hyphens.
+*/
+func ParsePreset(name string) (Preset, error) {
+	preset := Preset(strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "_", "-"))
+	if _, ok := PresetFlags[preset]; !ok {
+		return "", errors.Join(szerror.ErrSzBadInput, fmt.Errorf("unknown preset %q", name))
+	}
+	return preset, nil
+}
@@ -0,0 +1,10 @@
+/*
+The search package builds SearchByAttributes requests and interprets their results.
+
+A Builder assembles the attribute JSON from typed values: names, dates of birth, addresses, phones, emails and identifiers.
+Each With method may be called more than once; the values of each kind are sent as a list, e.g. NAMES or ADDRESSES.
+A Preset names one of the senzing.SzSearchByAttributes* flag combinations, and a Profile names a search profile.
+
+Request.Search calls SearchByAttributes and returns a Result, whose Matches give the entity, match level and feature scores of each match.
+*/
+package search
@@ -0,0 +1,149 @@
+package search
+
+import (
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-json-type-definition/go/typedef"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An Address is one address of the searched entity. Set Full, or the other parts.
+type Address struct {
+	City       string
+	Country    string
+	Full       string
+	Line1      string
+	Line2      string
+	Line3      string
+	PostalCode string
+	State      string
+	// Type is the usage of the address, e.g. "HOME" or "BUSINESS".
+	Type string
+}
+
+// A Builder assembles a Request. The first error is kept and returned by Build.
+type Builder struct {
+	attributes map[string][]map[string]string
+	err        error
+	flags      int64
+	profile    Profile
+}
+
+// An Identifier is an identifying number of the searched entity.
+type Identifier struct {
+	// Country that issued the identifier, or for IdentifierDriversLicense, the state.
+	Country string
+	Number  string
+	Type    IdentifierType
+}
+
+// An IdentifierType is the kind of an Identifier. Types without a constant are sent as OTHER_ID_TYPE.
+type IdentifierType string
+
+// A Match is one entity found by a search.
+type Match struct {
+	typedef.ResolvedEntityAndMatchInfo
+}
+
+// A MatchLevel is how strongly a Match matched, from MatchLevelResolved, the strongest, to MatchLevelNameOnly.
+type MatchLevel int64
+
+// A Name is one name of the searched entity. Set Full, Organization, or the other parts.
+type Name struct {
+	First        string
+	Full         string
+	Last         string
+	Middle       string
+	Organization string
+	Prefix       string
+	Suffix       string
+	// Type is the usage of the name, e.g. "PRIMARY" or "ALIAS".
+	Type string
+}
+
+// A Preset names a combination of SzSearchByAttributes flags.
+type Preset string
+
+// A Profile names a search profile in the Senzing configuration.
+type Profile string
+
+// A Request holds the arguments of SearchByAttributes.
+type Request struct {
+	Attributes string
+	Flags      int64
+	Profile    Profile
+}
+
+// A Result is the parsed response of SearchByAttributes.
+type Result struct {
+	// JSON is the response as returned by the engine.
+	JSON     string
+	Response *typedef.SzEngineSearchByAttributesResponse
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Identifier types.
+const (
+	IdentifierDriversLicense IdentifierType = "DRIVERS_LICENSE"
+	IdentifierNationalID     IdentifierType = "NATIONAL_ID"
+	IdentifierPassport       IdentifierType = "PASSPORT"
+	IdentifierSSN            IdentifierType = "SSN"
+	IdentifierTaxID          IdentifierType = "TAX_ID"
+)
+
+// Match levels, as in MATCH_LEVEL.
+const (
+	MatchLevelResolved        MatchLevel = 1
+	MatchLevelPossiblySame    MatchLevel = 2
+	MatchLevelPossiblyRelated MatchLevel = 3
+	MatchLevelNameOnly        MatchLevel = 4
+)
+
+// Flag presets.
+const (
+	PresetAll           Preset = "all"
+	PresetDefault       Preset = "default"
+	PresetMinimalAll    Preset = "minimal-all"
+	PresetMinimalStrong Preset = "minimal-strong"
+	PresetStrong        Preset = "strong"
+)
+
+// Search profiles. ProfileDefault lets the engine choose, which is "SEARCH".
+const (
+	ProfileDefault Profile = ""
+	ProfileIngest  Profile = "INGEST"
+	ProfileSearch  Profile = "SEARCH"
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// PresetFlags maps each Preset to its flags.
+var PresetFlags = map[Preset]int64{
+	PresetAll:           senzing.SzSearchByAttributesAll,
+	PresetDefault:       senzing.SzSearchByAttributesDefaultFlags,
+	PresetMinimalAll:    senzing.SzSearchByAttributesMinimalAll,
+	PresetMinimalStrong: senzing.SzSearchByAttributesMinimalStrong,
+	PresetStrong:        senzing.SzSearchByAttributesStrong,
+}
+
+// Attribute of the country, or state, of each identifier type with a constant.
+var identifierCountries = map[IdentifierType]string{
+	IdentifierDriversLicense: "DRIVERS_LICENSE_STATE",
+	IdentifierNationalID:     "NATIONAL_ID_COUNTRY",
+	IdentifierPassport:       "PASSPORT_COUNTRY",
+	IdentifierTaxID:          "TAX_ID_COUNTRY",
+}
+
+var matchLevelNames = map[MatchLevel]string{
+	MatchLevelNameOnly:        "NAME_ONLY",
+	MatchLevelPossiblyRelated: "POSSIBLY_RELATED",
+	MatchLevelPossiblySame:    "POSSIBLY_SAME",
+	MatchLevelResolved:        "RESOLVED",
+}
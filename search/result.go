@@ -0,0 +1,164 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Match
+// ----------------------------------------------------------------------------
+
+// The EntityID method returns the ENTITY_ID of the matched entity.
+func (match Match) EntityID() int64 {
+	return match.Entity.ResolvedEntity.EntityID
+}
+
+// The EntityName method returns the ENTITY_NAME of the matched entity.
+func (match Match) EntityName() string {
+	return match.Entity.ResolvedEntity.EntityName
+}
+
+// The MatchKey method returns the MATCH_KEY, e.g. "+NAME+DOB".
+func (match Match) MatchKey() string {
+	return match.MatchInfo.MatchKey
+}
+
+// The MatchLevel method returns the MATCH_LEVEL.
+func (match Match) MatchLevel() MatchLevel {
+	return MatchLevel(match.MatchInfo.MatchLevel)
+}
+
+/*
+The Score method returns the best FULL_SCORE of a feature type, or 0 if it was not scored.
+
+Input
+  - featureType: The feature type, e.g. "NAME" or "DOB".
+*/
+func (match Match) Score(featureType string) int64 {
+	var result int64
+	for _, score := range match.MatchInfo.FeatureScores[featureType] {
+		result = max(result, score.FullScore)
+	}
+	return result
+}
+
+// The Scores method returns the best FULL_SCORE of each scored feature type.
+func (match Match) Scores() map[string]int64 {
+	result := make(map[string]int64, len(match.MatchInfo.FeatureScores))
+	for featureType := range match.MatchInfo.FeatureScores {
+		result[featureType] = match.Score(featureType)
+	}
+	return result
+}
+
+// The TotalScore method returns the sum of Scores, used to rank matches of the same level.
+func (match Match) TotalScore() int64 {
+	var result int64
+	for featureType := range match.MatchInfo.FeatureScores {
+		result += match.Score(featureType)
+	}
+	return result
+}
+
+// Whether a match ranks above another: a stronger match level, then a higher total score.
+func (match Match) better(other Match) bool {
+	if match.MatchLevel().rank() != other.MatchLevel().rank() {
+		return match.MatchLevel().rank() < other.MatchLevel().rank()
+	}
+	return match.TotalScore() > other.TotalScore()
+}
+
+// ----------------------------------------------------------------------------
+// Methods - MatchLevel
+// ----------------------------------------------------------------------------
+
+// The String method returns the MATCH_LEVEL_CODE of the level, e.g. "RESOLVED".
+func (level MatchLevel) String() string {
+	if name, ok := matchLevelNames[level]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// Order levels from strongest; a missing or unknown level ranks last.
+func (level MatchLevel) rank() int64 {
+	if level < MatchLevelResolved {
+		return math.MaxInt64
+	}
+	return int64(level)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Result
+// ----------------------------------------------------------------------------
+
+/*
+The BestMatch method returns the match with the strongest match level.
+Among matches of that level, the one with the highest TotalScore is returned, the first if several tie.
+It returns false if nothing matched.
+*/
+func (result *Result) BestMatch() (Match, bool) {
+	var best Match
+	found := false
+	for _, match := range result.Matches() {
+		if !found || match.better(best) {
+			best = match
+			found = true
+		}
+	}
+	return best, found
+}
+
+/*
+The MatchesAtLeast method returns the matches at a match level or stronger, in the engine's order.
+
+Input
+  - level: The weakest level to return, e.g. MatchLevelPossiblySame.
+*/
+func (result *Result) MatchesAtLeast(level MatchLevel) []Match {
+	var matches []Match
+	for _, match := range result.Matches() {
+		if match.MatchLevel().rank() <= int64(level) {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
+
+// The Matches method returns every match, in the engine's order.
+func (result *Result) Matches() []Match {
+	if result.Response == nil {
+		return nil
+	}
+	matches := make([]Match, 0, len(result.Response.ResolvedEntities))
+	for _, entity := range result.Response.ResolvedEntities {
+		matches = append(matches, Match{entity})
+	}
+	return matches
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The ParseResult function parses a SearchByAttributes response.
+
+Input
+  - ctx: A context to control lifecycle.
+  - responseJSON: The JSON returned by SearchByAttributes.
+*/
+func ParseResult(ctx context.Context, responseJSON string) (*Result, error) {
+	parsed, err := response.SzEngineSearchByAttributes(ctx, responseJSON)
+	if err != nil {
+		return nil, fmt.Errorf("parse search response: %w", err)
+	}
+	return &Result{
+		JSON:     responseJSON,
+		Response: parsed,
+	}, nil
+}
@@ -0,0 +1,209 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	attributes string
+	flags      int64
+	profile    string
+	response   string
+}
+
+func (engine *mockSzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	_ = ctx
+	engine.attributes = attributes
+	engine.flags = flags
+	engine.profile = searchProfile
+	return engine.response, nil
+}
+
+const searchResponse = `{
+	"RESOLVED_ENTITIES": [
+		{
+			"MATCH_INFO": {
+				"MATCH_LEVEL": 3,
+				"MATCH_LEVEL_CODE": "POSSIBLY_RELATED",
+				"MATCH_KEY": "+NAME",
+				"FEATURE_SCORES": {"NAME": [{"FULL_SCORE": 90}]}
+			},
+			"ENTITY": {"RESOLVED_ENTITY": {"ENTITY_ID": 1, "ENTITY_NAME": "Robert Smith"}}
+		},
+		{
+			"MATCH_INFO": {
+				"MATCH_LEVEL": 2,
+				"MATCH_LEVEL_CODE": "POSSIBLY_SAME",
+				"MATCH_KEY": "+NAME+DOB",
+				"FEATURE_SCORES": {
+					"NAME": [{"FULL_SCORE": 80}, {"FULL_SCORE": 95}],
+					"DOB": [{"FULL_SCORE": 100}]
+				}
+			},
+			"ENTITY": {"RESOLVED_ENTITY": {"ENTITY_ID": 2, "ENTITY_NAME": "Bob Smith"}}
+		},
+		{
+			"MATCH_INFO": {
+				"MATCH_LEVEL": 2,
+				"MATCH_LEVEL_CODE": "POSSIBLY_SAME",
+				"MATCH_KEY": "+NAME+DOB",
+				"FEATURE_SCORES": {
+					"NAME": [{"FULL_SCORE": 70}],
+					"DOB": [{"FULL_SCORE": 100}]
+				}
+			},
+			"ENTITY": {"RESOLVED_ENTITY": {"ENTITY_ID": 3, "ENTITY_NAME": "R Smith"}}
+		}
+	]
+}`
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestBuilder_Build(test *testing.T) {
+	request, err := NewBuilder().
+		WithNameParts("Robert", "", "Smith").
+		WithFullName("Bob Smith").
+		WithDateOfBirth("1980-05-14").
+		WithAddress(Address{Line1: "123 Main St", City: "Las Vegas", State: "NV", PostalCode: "89132", Type: "HOME"}).
+		WithPhone("702-919-1300").
+		WithEmail("bsmith@example.com").
+		WithIdentifier(Identifier{Type: IdentifierPassport, Number: "PP12345", Country: "US"}).
+		WithIdentifier(Identifier{Type: IdentifierSSN, Number: "123-45-6789"}).
+		WithIdentifier(Identifier{Type: "MEMBER_ID", Number: "M-1"}).
+		WithAttribute("website_address", "example.com").
+		WithPreset(PresetStrong).
+		WithStatistics().
+		WithProfile(ProfileSearch).
+		Build()
+	require.NoError(test, err)
+	assert.JSONEq(test, `{
+		"ADDRESSES": [{"ADDR_CITY": "Las Vegas", "ADDR_LINE1": "123 Main St", "ADDR_POSTAL_CODE": "89132", "ADDR_STATE": "NV", "ADDR_TYPE": "HOME"}],
+		"DATES": [{"DATE_OF_BIRTH": "1980-05-14"}],
+		"EMAILS": [{"EMAIL_ADDRESS": "bsmith@example.com"}],
+		"IDENTIFIERS": [
+			{"PASSPORT_COUNTRY": "US", "PASSPORT_NUMBER": "PP12345"},
+			{"SSN_NUMBER": "123-45-6789"},
+			{"OTHER_ID_NUMBER": "M-1", "OTHER_ID_TYPE": "MEMBER_ID"}
+		],
+		"NAMES": [{"NAME_FIRST": "Robert", "NAME_LAST": "Smith"}, {"NAME_FULL": "Bob Smith"}],
+		"OTHERS": [{"WEBSITE_ADDRESS": "example.com"}],
+		"PHONES": [{"PHONE_NUMBER": "702-919-1300"}]
+	}`, request.Attributes)
+	assert.Equal(test, senzing.SzSearchByAttributesStrong|senzing.SzSearchIncludeStats, request.Flags)
+	assert.Equal(test, ProfileSearch, request.Profile)
+}
+
+func TestBuilder_Build_defaults(test *testing.T) {
+	request, err := NewBuilder().WithFullName("Bob Smith").Build()
+	require.NoError(test, err)
+	assert.Equal(test, senzing.SzSearchByAttributesDefaultFlags, request.Flags)
+	assert.Equal(test, ProfileDefault, request.Profile)
+}
+
+func TestBuilder_Build_errors(test *testing.T) {
+	testCases := []struct {
+		name    string
+		builder *Builder
+	}{
+		{name: "no attributes", builder: NewBuilder()},
+		{name: "empty name", builder: NewBuilder().WithName(Name{Type: "PRIMARY"})},
+		{name: "mixed name", builder: NewBuilder().WithName(Name{Full: "Bob Smith", Last: "Smith"})},
+		{name: "empty date of birth", builder: NewBuilder().WithDateOfBirth(" ")},
+		{name: "empty address", builder: NewBuilder().WithAddress(Address{Type: "HOME"})},
+		{name: "bad email", builder: NewBuilder().WithEmail("example.com")},
+		{name: "identifier without type", builder: NewBuilder().WithIdentifier(Identifier{Number: "1"})},
+		{name: "identifier without number", builder: NewBuilder().WithIdentifier(Identifier{Type: IdentifierTaxID})},
+		{name: "SSN with country", builder: NewBuilder().WithIdentifier(Identifier{Type: IdentifierSSN, Number: "1", Country: "US"})},
+		{name: "unknown preset", builder: NewBuilder().WithFullName("Bob Smith").WithPreset("fuzzy")},
+		{name: "first error kept", builder: NewBuilder().WithPhone("").WithFullName("Bob Smith")},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			_, err := testCase.builder.Build()
+			require.ErrorIs(test, err, szerror.ErrSzBadInput)
+		})
+	}
+}
+
+func TestBuilder_Search(test *testing.T) {
+	ctx := context.TODO()
+	engine := &mockSzEngine{response: searchResponse}
+	result, err := NewBuilder().WithFullName("Bob Smith").WithProfile(ProfileIngest).WithPreset(PresetMinimalAll).Search(ctx, engine)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"NAMES": [{"NAME_FULL": "Bob Smith"}]}`, engine.attributes)
+	assert.Equal(test, senzing.SzSearchByAttributesMinimalAll, engine.flags)
+	assert.Equal(test, "INGEST", engine.profile)
+	assert.Equal(test, searchResponse, result.JSON)
+	assert.Len(test, result.Matches(), 3)
+}
+
+func TestParsePreset(test *testing.T) {
+	testCases := []struct {
+		name     string
+		expected Preset
+	}{
+		{name: "all", expected: PresetAll},
+		{name: "DEFAULT", expected: PresetDefault},
+		{name: "minimal_all", expected: PresetMinimalAll},
+		{name: " Minimal-Strong ", expected: PresetMinimalStrong},
+		{name: "strong", expected: PresetStrong},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			preset, err := ParsePreset(testCase.name)
+			require.NoError(test, err)
+			assert.Equal(test, testCase.expected, preset)
+		})
+	}
+	_, err := ParsePreset("fuzzy")
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+}
+
+func TestResult_BestMatch(test *testing.T) {
+	result, err := ParseResult(context.TODO(), searchResponse)
+	require.NoError(test, err)
+	best, ok := result.BestMatch()
+	require.True(test, ok)
+	assert.Equal(test, int64(2), best.EntityID())
+	assert.Equal(test, "Bob Smith", best.EntityName())
+	assert.Equal(test, MatchLevelPossiblySame, best.MatchLevel())
+	assert.Equal(test, "POSSIBLY_SAME", best.MatchLevel().String())
+	assert.Equal(test, "+NAME+DOB", best.MatchKey())
+	assert.Equal(test, int64(95), best.Score("NAME"))
+	assert.Equal(test, int64(0), best.Score("ADDRESS"))
+	assert.Equal(test, map[string]int64{"DOB": 100, "NAME": 95}, best.Scores())
+	assert.Equal(test, int64(195), best.TotalScore())
+}
+
+func TestResult_BestMatch_none(test *testing.T) {
+	result, err := ParseResult(context.TODO(), `{"RESOLVED_ENTITIES": []}`)
+	require.NoError(test, err)
+	_, ok := result.BestMatch()
+	assert.False(test, ok)
+	assert.Empty(test, result.Matches())
+}
+
+func TestResult_MatchesAtLeast(test *testing.T) {
+	result, err := ParseResult(context.TODO(), searchResponse)
+	require.NoError(test, err)
+	entityIDs := []int64{}
+	for _, match := range result.MatchesAtLeast(MatchLevelPossiblySame) {
+		entityIDs = append(entityIDs, match.EntityID())
+	}
+	assert.Equal(test, []int64{2, 3}, entityIDs)
+	assert.Empty(test, result.MatchesAtLeast(MatchLevelResolved))
+}
+
+func TestParseResult_error(test *testing.T) {
+	_, err := ParseResult(context.TODO(), "not json")
+	require.Error(test, err)
+}
@@ -0,0 +1,373 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Cluster
+// ----------------------------------------------------------------------------
+
+// The String method lists the cluster's connection strings with passwords redacted.
+func (cluster Cluster) String() string {
+	redacted := []string{}
+	for _, connection := range cluster.Connections {
+		redacted = append(redacted, RedactConnection(connection))
+	}
+	return "[" + strings.Join(redacted, " ") + "]"
+}
+
+// ----------------------------------------------------------------------------
+// Methods - SQL
+// ----------------------------------------------------------------------------
+
+// The String method formats the SQL section with the connection password redacted.
+func (sql SQL) String() string {
+	return fmt.Sprintf("{Backend:%s Connection:%s}", sql.Backend, RedactConnection(sql.Connection))
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Settings
+// ----------------------------------------------------------------------------
+
+// The GoString method returns String(), so that %#v does not print passwords either.
+func (settings Settings) GoString() string {
+	return settings.String()
+}
+
+/*
+The JSON method returns the engine configuration JSON, including passwords, to pass to Initialize.
+*/
+func (settings Settings) JSON() (string, error) {
+	result, err := json.Marshal(settings)
+	return string(result), err
+}
+
+// The LogValue method logs the settings with passwords redacted.
+func (settings Settings) LogValue() slog.Value {
+	return slog.StringValue(settings.String())
+}
+
+// The MarshalJSON method writes clusters as top-level sections, as the engine expects.
+func (settings Settings) MarshalJSON() ([]byte, error) {
+	result := map[string]any{}
+	for key, value := range settings.Extra {
+		result[key] = value
+	}
+	result["PIPELINE"] = settings.Pipeline
+	result["SQL"] = settings.SQL
+	if len(settings.Hybrid) > 0 {
+		result[BackendHybrid] = settings.Hybrid
+	}
+	for name, cluster := range settings.Clusters {
+		section := map[string]string{"CLUSTER_SIZE": strconv.Itoa(len(cluster.Connections))}
+		for index, connection := range cluster.Connections {
+			section[fmt.Sprintf("DB_%d", index+1)] = connection
+		}
+		result[name] = section
+	}
+	return json.Marshal(result)
+}
+
+/*
+The Redacted method returns a copy of the settings with every password, and the license string, replaced by RedactedPassword.
+Strings in Extra sections are redacted too if they are connection strings or hold "://" and "@".
+*/
+func (settings Settings) Redacted() Settings {
+	result := settings
+	if len(settings.Pipeline.LicenseStringBase64) > 0 {
+		result.Pipeline.LicenseStringBase64 = RedactedPassword
+	}
+	result.SQL.Connection = RedactConnection(settings.SQL.Connection)
+	if settings.Extra != nil {
+		result.Extra = map[string]json.RawMessage{}
+		for key, value := range settings.Extra {
+			result.Extra[key] = redactSection(value)
+		}
+	}
+	if settings.Clusters != nil {
+		result.Clusters = map[string]Cluster{}
+		for name, cluster := range settings.Clusters {
+			redacted := Cluster{}
+			for _, connection := range cluster.Connections {
+				redacted.Connections = append(redacted.Connections, RedactConnection(connection))
+			}
+			result.Clusters[name] = redacted
+		}
+	}
+	return result
+}
+
+// The String method returns the engine configuration JSON with passwords redacted.
+func (settings Settings) String() string {
+	result, err := settings.Redacted().JSON()
+	if err != nil {
+		return fmt.Sprintf("invalid settings: %v", err)
+	}
+	return result
+}
+
+// The UnmarshalJSON method reads clusters from top-level sections holding CLUSTER_SIZE or DB_n keys.
+func (settings *Settings) UnmarshalJSON(data []byte) error {
+	sections := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+	*settings = Settings{}
+	for key, value := range sections {
+		var err error
+		switch key {
+		case "PIPELINE":
+			err = json.Unmarshal(value, &settings.Pipeline)
+		case "SQL":
+			err = json.Unmarshal(value, &settings.SQL)
+		case BackendHybrid:
+			err = json.Unmarshal(value, &settings.Hybrid)
+		default:
+			if cluster, ok := parseCluster(value); ok {
+				if settings.Clusters == nil {
+					settings.Clusters = map[string]Cluster{}
+				}
+				settings.Clusters[key] = cluster
+				continue
+			}
+			if settings.Extra == nil {
+				settings.Extra = map[string]json.RawMessage{}
+			}
+			settings.Extra[key] = value
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+/*
+The Validate method checks the settings and returns every problem found, joined with szerror.ErrSzConfiguration.
+PIPELINE paths must be directories that exist on this machine, and connection strings must be well formed.
+*/
+func (settings Settings) Validate() error {
+	errs := settings.validate()
+	for _, path := range []struct {
+		name  string
+		value string
+	}{
+		{"CONFIGPATH", settings.Pipeline.ConfigPath},
+		{"RESOURCEPATH", settings.Pipeline.ResourcePath},
+		{"SUPPORTPATH", settings.Pipeline.SupportPath},
+	} {
+		if len(path.value) == 0 {
+			continue
+		}
+		info, err := os.Stat(path.value)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("PIPELINE.%s: %w", path.name, err))
+		case !info.IsDir():
+			errs = append(errs, fmt.Errorf("PIPELINE.%s: %s is not a directory", path.name, path.value))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(append([]error{szerror.ErrSzConfiguration}, errs...)...)
+	}
+	return nil
+}
+
+// Checks that do not depend on the local filesystem.
+func (settings Settings) validate() []error {
+	result := []error{}
+	for _, path := range []struct {
+		name  string
+		value string
+	}{
+		{"CONFIGPATH", settings.Pipeline.ConfigPath},
+		{"RESOURCEPATH", settings.Pipeline.ResourcePath},
+		{"SUPPORTPATH", settings.Pipeline.SupportPath},
+	} {
+		if len(path.value) == 0 {
+			result = append(result, fmt.Errorf("PIPELINE.%s is required", path.name))
+		}
+	}
+	if len(settings.SQL.Connection) == 0 {
+		result = append(result, errors.New("SQL.CONNECTION is required"))
+	} else if _, err := ParseConnection(settings.SQL.Connection); err != nil {
+		result = append(result, fmt.Errorf("SQL.CONNECTION %s: %w", RedactConnection(settings.SQL.Connection), err))
+	}
+	switch settings.SQL.Backend {
+	case "", BackendSQL:
+		if len(settings.Clusters) > 0 || len(settings.Hybrid) > 0 {
+			result = append(result, fmt.Errorf("clusters require SQL.BACKEND %s", BackendHybrid))
+		}
+	case BackendHybrid:
+		if len(settings.Hybrid) == 0 {
+			result = append(result, fmt.Errorf("SQL.BACKEND %s requires a %s section", BackendHybrid, BackendHybrid))
+		}
+	default:
+		result = append(result, fmt.Errorf("unknown SQL.BACKEND %q", settings.SQL.Backend))
+	}
+	for _, table := range sortedKeys(settings.Hybrid) {
+		if _, ok := settings.Clusters[settings.Hybrid[table]]; !ok {
+			result = append(result, fmt.Errorf("%s.%s refers to unknown cluster %q", BackendHybrid, table, settings.Hybrid[table]))
+		}
+	}
+	for _, name := range sortedKeys(settings.Clusters) {
+		cluster := settings.Clusters[name]
+		if len(cluster.Connections) == 0 {
+			result = append(result, fmt.Errorf("cluster %s has no databases", name))
+		}
+		for index, connection := range cluster.Connections {
+			if _, err := ParseConnection(connection); err != nil {
+				result = append(result, fmt.Errorf("%s.DB_%d %s: %w", name, index+1, RedactConnection(connection), err))
+			}
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The FromEnvironment function parses the engine configuration JSON in the SENZING_ENGINE_CONFIGURATION_JSON environment variable.
+*/
+func FromEnvironment() (*Settings, error) {
+	value, ok := os.LookupEnv(EnvironmentVariable)
+	if !ok || len(strings.TrimSpace(value)) == 0 {
+		return nil, errors.Join(szerror.ErrSzConfiguration, fmt.Errorf("%s is not set", EnvironmentVariable))
+	}
+	result, err := Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", EnvironmentVariable, err)
+	}
+	return result, nil
+}
+
+/*
+The Parse function parses engine configuration JSON.
+The result is not validated; call Validate.
+
+Input
+  - jsonString: The engine configuration JSON.
+*/
+func Parse(jsonString string) (*Settings, error) {
+	result := &Settings{}
+	if err := json.Unmarshal([]byte(jsonString), result); err != nil {
+		return nil, errors.Join(szerror.ErrSzConfiguration, err)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// Parse a top-level section as a Cluster if it holds CLUSTER_SIZE or DB_n keys.
+// Values may be strings or numbers, e.g. "CLUSTER_SIZE": 1.
+func parseCluster(value json.RawMessage) (Cluster, bool) {
+	section := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&section); err != nil {
+		return Cluster{}, false
+	}
+	result := Cluster{}
+	_, hasSize := section["CLUSTER_SIZE"]
+	for index := 1; ; index++ {
+		connection, ok := section[fmt.Sprintf("DB_%d", index)]
+		if !ok {
+			break
+		}
+		switch connection := connection.(type) {
+		case string:
+			result.Connections = append(result.Connections, connection)
+		case json.Number:
+			result.Connections = append(result.Connections, connection.String())
+		default:
+			return Cluster{}, false
+		}
+	}
+	return result, hasSize || len(result.Connections) > 0
+}
+
+// Redact a string that is a connection string with a password, or holds "://" and "@".
+func redactString(value string) (string, bool) {
+	if scheme, rest, found := strings.Cut(value, "://"); found && len(scheme) > 0 && strings.Contains(rest, "@") {
+		return RedactConnection(value), true
+	}
+	connection, format, err := Decode(value)
+	if err != nil || len(connection.Password) == 0 {
+		return value, false
+	}
+	switch format {
+	case FormatADO, FormatLibpq, FormatMySQLDSN:
+		connection.Password = RedactedPassword
+		if redacted, err := connection.Encode(format); err == nil {
+			return redacted, true
+		}
+		return RedactedPassword, true
+	default:
+		return value, false
+	}
+}
+
+// Redact the strings in a JSON section, keeping the section unchanged if none are redacted.
+// Sections that cannot be decoded are replaced by a redacted string.
+func redactSection(value json.RawMessage) json.RawMessage {
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return json.RawMessage(strconv.Quote(RedactedPassword))
+	}
+	redacted, changed := redactValue(document)
+	if !changed {
+		return value
+	}
+	result, err := json.Marshal(redacted)
+	if err != nil {
+		return json.RawMessage(strconv.Quote(RedactedPassword))
+	}
+	return result
+}
+
+func redactValue(document any) (any, bool) {
+	changed := false
+	switch value := document.(type) {
+	case string:
+		return redactString(value)
+	case []any:
+		for index, element := range value {
+			redacted, ok := redactValue(element)
+			value[index] = redacted
+			changed = changed || ok
+		}
+	case map[string]any:
+		for key, element := range value {
+			redacted, ok := redactValue(element)
+			value[key] = redacted
+			changed = changed || ok
+		}
+	}
+	return document, changed
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	result := make([]string, 0, len(values))
+	for key := range values {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
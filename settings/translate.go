@@ -0,0 +1,533 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Format
+// ----------------------------------------------------------------------------
+
+// The String method returns the name of the format.
+func (format Format) String() string {
+	result, ok := formatNames[format]
+	if !ok {
+		result = fmt.Sprintf("Format(%d)", int(format))
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Connection
+// ----------------------------------------------------------------------------
+
+/*
+The Encode method returns the connection in the given format, including the password.
+FormatSenzing and FormatURL accept every scheme. The other formats accept one scheme each:
+FormatADO mssql, FormatLibpq postgresql, FormatMySQLDSN mysql and FormatSQLitePath sqlite3.
+
+Input
+  - format: The format of the result.
+*/
+func (connection *Connection) Encode(format Format) (string, error) {
+	if err := connection.Validate(); err != nil {
+		return "", err
+	}
+	requireScheme := func(scheme string) error {
+		if connection.Scheme != scheme {
+			return fmt.Errorf("%s format cannot express a %s connection", format, connection.Scheme)
+		}
+		return nil
+	}
+	switch format {
+	case FormatSenzing:
+		return connection.ConnectionString(), nil
+	case FormatURL:
+		return connection.encodeURL(), nil
+	case FormatADO:
+		if err := requireScheme(SchemeMsSQL); err != nil {
+			return "", err
+		}
+		return connection.encodeADO(), nil
+	case FormatLibpq:
+		if err := requireScheme(SchemePostgreSQL); err != nil {
+			return "", err
+		}
+		return connection.encodeLibpq(), nil
+	case FormatMySQLDSN:
+		if err := requireScheme(SchemeMySQL); err != nil {
+			return "", err
+		}
+		return connection.encodeMySQLDSN(), nil
+	case FormatSQLitePath:
+		if err := requireScheme(SchemeSQLite); err != nil {
+			return "", err
+		}
+		return connection.Path, nil
+	}
+	return "", fmt.Errorf("unsupported format %s", format)
+}
+
+func (connection *Connection) encodeADO() string {
+	parts := []string{}
+	add := func(key string, value string) {
+		if len(value) > 0 {
+			parts = append(parts, key+"="+quoteADO(value))
+		}
+	}
+	server := connection.Host
+	if len(server) > 0 && connection.Port != 0 {
+		server += "," + strconv.Itoa(connection.Port)
+	}
+	add("Server", server)
+	add("Database", connection.Database)
+	add("User Id", connection.Username)
+	add("Password", connection.Password)
+	for _, key := range sortedKeys(connection.Parameters) {
+		add(key, connection.Parameters.Get(key))
+	}
+	return strings.Join(parts, ";") + ";"
+}
+
+func (connection *Connection) encodeLibpq() string {
+	parts := []string{}
+	add := func(key string, value string) {
+		if len(value) > 0 {
+			parts = append(parts, key+"="+quoteLibpq(value))
+		}
+	}
+	add("host", connection.Host)
+	if connection.Port != 0 {
+		add("port", strconv.Itoa(connection.Port))
+	}
+	add("dbname", connection.Database)
+	add("user", connection.Username)
+	add("password", connection.Password)
+	for _, key := range sortedKeys(connection.Parameters) {
+		add(key, connection.Parameters.Get(key))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (connection *Connection) encodeMySQLDSN() string {
+	var builder strings.Builder
+	if len(connection.Username) > 0 || len(connection.Password) > 0 {
+		builder.WriteString(connection.Username)
+		if len(connection.Password) > 0 {
+			builder.WriteString(":")
+			builder.WriteString(connection.Password)
+		}
+		builder.WriteString("@")
+	}
+	builder.WriteString("tcp(")
+	builder.WriteString(connection.hostPort())
+	builder.WriteString(")/")
+	builder.WriteString(connection.Database)
+	if len(connection.Parameters) > 0 {
+		builder.WriteString("?")
+		builder.WriteString(connection.Parameters.Encode())
+	}
+	return builder.String()
+}
+
+func (connection *Connection) encodeURL() string {
+	result := url.URL{
+		Scheme:   connection.Scheme,
+		RawQuery: connection.Parameters.Encode(),
+	}
+	if len(connection.Username) > 0 || len(connection.Password) > 0 {
+		result.User = url.UserPassword(connection.Username, connection.Password)
+	}
+	switch connection.Scheme {
+	case SchemeSQLite:
+		result.User = nil // Always "na:na".
+		if strings.HasPrefix(connection.Path, "/") {
+			result.Path = connection.Path
+		} else {
+			result.Opaque = connection.Path
+		}
+	default:
+		result.Host = connection.hostPort()
+		result.Path = "/" + connection.Database
+	}
+	return result.String()
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Settings
+// ----------------------------------------------------------------------------
+
+/*
+The Connections method parses SQL.CONNECTION and the databases of every cluster.
+The result is keyed as the settings JSON names them: "SQL.CONNECTION", "C1.DB_1", "C1.DB_2" and so on.
+*/
+func (settings Settings) Connections() (map[string]*Connection, error) {
+	result := map[string]*Connection{}
+	errs := []error{}
+	add := func(key string, connectionString string) {
+		connection, err := ParseConnection(connectionString)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", key, RedactConnection(connectionString), err))
+			return
+		}
+		result[key] = connection
+	}
+	if len(settings.SQL.Connection) > 0 {
+		add("SQL.CONNECTION", settings.SQL.Connection)
+	}
+	for _, name := range sortedKeys(settings.Clusters) {
+		for index, connectionString := range settings.Clusters[name].Connections {
+			add(fmt.Sprintf("%s.DB_%d", name, index+1), connectionString)
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Decode function parses a connection string in any supported format, and returns the format it was in.
+URLs are read as conventional database URLs when possible, otherwise as Senzing connection strings.
+
+Input
+  - connectionString: A connection string, e.g. "host=localhost dbname=G2 user=postgres password=secret".
+*/
+func Decode(connectionString string) (*Connection, Format, error) {
+	connectionString = strings.TrimSpace(connectionString)
+	format := DetectFormat(connectionString)
+	var (
+		result *Connection
+		err    error
+	)
+	switch format {
+	case FormatURL:
+		if result, err = ConnectionFromDatabaseURL(connectionString); err == nil {
+			return result, FormatURL, nil
+		}
+		if senzing, senzingErr := ParseConnection(connectionString); senzingErr == nil {
+			return senzing, FormatSenzing, nil
+		}
+		return nil, FormatUnknown, err
+	case FormatADO:
+		result, err = decodeADO(connectionString)
+	case FormatLibpq:
+		result, err = decodeLibpq(connectionString)
+	case FormatMySQLDSN:
+		result, err = decodeMySQLDSN(connectionString)
+	case FormatSQLitePath:
+		result = &Connection{
+			Path:     strings.TrimPrefix(connectionString, "file:"),
+			Password: "na",
+			Scheme:   SchemeSQLite,
+			Username: "na",
+		}
+	default:
+		return nil, FormatUnknown, errors.New("unrecognized connection string format")
+	}
+	if err == nil {
+		err = result.Validate()
+	}
+	if err != nil {
+		return nil, FormatUnknown, fmt.Errorf("%s connection string: %w", format, err)
+	}
+	return result, format, nil
+}
+
+/*
+The DetectFormat function guesses the format of a connection string from its syntax.
+FormatURL is returned for both conventional and Senzing URLs; Decode distinguishes them.
+
+Input
+  - connectionString: A connection string.
+*/
+func DetectFormat(connectionString string) Format {
+	switch {
+	case strings.Contains(connectionString, "://"):
+		return FormatURL
+	case strings.HasPrefix(connectionString, "file:"):
+		return FormatSQLitePath
+	case keywordPattern.MatchString(connectionString):
+		if strings.Contains(connectionString, ";") {
+			return FormatADO
+		}
+		return FormatLibpq
+	case isMySQLDSN(connectionString):
+		return FormatMySQLDSN
+	case len(strings.TrimSpace(connectionString)) > 0 && !strings.ContainsAny(connectionString, "=@;"):
+		return FormatSQLitePath
+	}
+	return FormatUnknown
+}
+
+/*
+The NewCluster function returns a Cluster of the given databases, translated into Senzing connection strings.
+
+Input
+  - connectionStrings: Connection strings in any format accepted by Decode, one per database.
+*/
+func NewCluster(connectionStrings ...string) (Cluster, error) {
+	result := Cluster{}
+	for index, connectionString := range connectionStrings {
+		connection, _, err := Decode(connectionString)
+		if err != nil {
+			return Cluster{}, fmt.Errorf("DB_%d: %w", index+1, err)
+		}
+		result.Connections = append(result.Connections, connection.ConnectionString())
+	}
+	return result, nil
+}
+
+/*
+The Translate function converts a connection string from any supported format into another.
+
+Input
+  - connectionString: A connection string in any format accepted by Decode.
+  - format: The format of the result, e.g. FormatSenzing.
+*/
+func Translate(connectionString string, format Format) (string, error) {
+	connection, _, err := Decode(connectionString)
+	if err != nil {
+		return "", err
+	}
+	return connection.Encode(format)
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func decodeADO(connectionString string) (*Connection, error) {
+	pairs, err := splitADO(connectionString)
+	if err != nil {
+		return nil, err
+	}
+	result := &Connection{Scheme: SchemeMsSQL}
+	for _, pair := range pairs {
+		key := strings.ToLower(pair[0])
+		value := pair[1]
+		switch {
+		case contains(adoServerKeys, key):
+			server := strings.TrimPrefix(value, "tcp:")
+			host, port, found := strings.Cut(server, ",")
+			result.Host = host
+			if found {
+				if result.Port, err = strconv.Atoi(strings.TrimSpace(port)); err != nil {
+					return nil, fmt.Errorf("invalid port %q", port)
+				}
+			}
+		case contains(adoDatabaseKeys, key):
+			result.Database = value
+		case contains(adoUsernameKeys, key):
+			result.Username = value
+		case contains(adoPasswordKeys, key):
+			result.Password = value
+		default:
+			if result.Parameters == nil {
+				result.Parameters = url.Values{}
+			}
+			result.Parameters.Set(pair[0], value)
+		}
+	}
+	return result, nil
+}
+
+func decodeLibpq(connectionString string) (*Connection, error) {
+	result := &Connection{Scheme: SchemePostgreSQL}
+	input := []rune(connectionString)
+	for index := 0; index < len(input); {
+		for index < len(input) && input[index] == ' ' {
+			index++
+		}
+		if index >= len(input) {
+			break
+		}
+		start := index
+		for index < len(input) && input[index] != '=' && input[index] != ' ' {
+			index++
+		}
+		key := string(input[start:index])
+		for index < len(input) && input[index] == ' ' {
+			index++
+		}
+		if index >= len(input) || input[index] != '=' {
+			return nil, fmt.Errorf("missing \"=\" after %q", key)
+		}
+		index++
+		for index < len(input) && input[index] == ' ' {
+			index++
+		}
+		var value strings.Builder
+		if index < len(input) && input[index] == '\'' {
+			index++
+			closed := false
+			for index < len(input) {
+				character := input[index]
+				index++
+				if character == '\\' && index < len(input) {
+					value.WriteRune(input[index])
+					index++
+					continue
+				}
+				if character == '\'' {
+					closed = true
+					break
+				}
+				value.WriteRune(character)
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted value for %q", key)
+			}
+		} else {
+			for index < len(input) && input[index] != ' ' {
+				if input[index] == '\\' && index+1 < len(input) {
+					index++
+				}
+				value.WriteRune(input[index])
+				index++
+			}
+		}
+		var err error
+		switch key {
+		case "host", "hostaddr":
+			result.Host = value.String()
+		case "port":
+			if result.Port, err = strconv.Atoi(value.String()); err != nil {
+				return nil, fmt.Errorf("invalid port %q", value.String())
+			}
+		case "dbname":
+			result.Database = value.String()
+		case "user":
+			result.Username = value.String()
+		case "password":
+			result.Password = value.String()
+		default:
+			if result.Parameters == nil {
+				result.Parameters = url.Values{}
+			}
+			result.Parameters.Set(key, value.String())
+		}
+	}
+	if result.Port == 0 {
+		result.Port = defaultPortPostgreSQL
+	}
+	return result, nil
+}
+
+func decodeMySQLDSN(connectionString string) (*Connection, error) {
+	match := mySQLDSNPattern.FindStringSubmatch(connectionString)
+	if match == nil {
+		return nil, errors.New("not a MySQL DSN")
+	}
+	result := &Connection{
+		Database: match[4],
+		Port:     defaultPortMySQL,
+		Scheme:   SchemeMySQL,
+	}
+	result.Username, result.Password, _ = strings.Cut(match[1], ":")
+	switch match[2] {
+	case "", "tcp":
+		address := match[3]
+		if len(address) == 0 {
+			address = "127.0.0.1"
+		}
+		if err := result.setHostPort(address); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("MySQL network %q is not supported", match[2])
+	}
+	if len(match[5]) > 0 {
+		parameters, err := url.ParseQuery(match[5])
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameters: %w", err)
+		}
+		result.Parameters = parameters
+	}
+	return result, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// A MySQL DSN needs credentials or a network; "/G2" alone is a file path.
+func isMySQLDSN(connectionString string) bool {
+	match := mySQLDSNPattern.FindStringSubmatch(connectionString)
+	return match != nil && (len(match[1]) > 0 || len(match[2]) > 0)
+}
+
+func quoteADO(value string) string {
+	if !strings.ContainsAny(value, ";{}'\"") && strings.TrimSpace(value) == value {
+		return value
+	}
+	return "{" + strings.ReplaceAll(value, "}", "}}") + "}"
+}
+
+func quoteLibpq(value string) string {
+	if !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + replacer.Replace(value) + "'"
+}
+
+// Split an ADO.NET string into key/value pairs. Values may be wrapped in braces, double or single quotes.
+func splitADO(connectionString string) ([][2]string, error) {
+	result := [][2]string{}
+	input := connectionString
+	for len(strings.TrimSpace(input)) > 0 {
+		key, rest, found := strings.Cut(input, "=")
+		if !found {
+			return nil, fmt.Errorf("missing \"=\" in %q", strings.TrimSpace(input))
+		}
+		key = strings.TrimSpace(strings.TrimLeft(key, "; "))
+		rest = strings.TrimLeft(rest, " ")
+		var value string
+		if len(rest) > 0 && strings.ContainsRune(`{"'`, rune(rest[0])) {
+			closing := map[byte]string{'{': "}", '"': `"`, '\'': "'"}[rest[0]]
+			var builder strings.Builder
+			index := 1
+			closed := false
+			for index < len(rest) {
+				if strings.HasPrefix(rest[index:], closing+closing) {
+					builder.WriteString(closing)
+					index += 2
+					continue
+				}
+				if strings.HasPrefix(rest[index:], closing) {
+					index++
+					closed = true
+					break
+				}
+				builder.WriteByte(rest[index])
+				index++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted value for %q", key)
+			}
+			value = builder.String()
+			rest = rest[index:]
+			rest = strings.TrimLeft(rest, " ")
+			if len(rest) > 0 && rest[0] != ';' {
+				return nil, fmt.Errorf("unexpected text after value for %q", key)
+			}
+		} else {
+			value, rest, _ = strings.Cut(rest, ";")
+			value = strings.TrimSpace(value)
+		}
+		result = append(result, [2]string{key, value})
+		input = strings.TrimPrefix(rest, ";")
+	}
+	return result, nil
+}
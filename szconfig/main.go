@@ -15,8 +15,6 @@ const Prefix = "szconfig."
 var IDMessages = map[int]string{
 	1:    "Enter " + Prefix + "AddDataSource(%v, %s).",
 	2:    "Exit  " + Prefix + "AddDataSource(%v, %s) returned (%s, %v).",
-	3:    "Enter " + Prefix + "ClearLastException().",
-	4:    "Exit  " + Prefix + "ClearLastException() returned (%v).",
 	5:    "Enter " + Prefix + "CloseConfig(%v).",
 	6:    "Exit  " + Prefix + "CloseConfig(%v) returned (%v).",
 	7:    "Enter " + Prefix + "CreateConfig().",
@@ -29,10 +27,6 @@ var IDMessages = map[int]string{
 	14:   "Exit  " + Prefix + "ExportConfig(%v) returned (%s, %v).",
 	15:   "Enter " + Prefix + "GetDataSources(%v).",
 	16:   "Exit  " + Prefix + "GetDataSources(%v) returned (%s, %v).",
-	17:   "Enter " + Prefix + "GetLastException().",
-	18:   "Exit  " + Prefix + "GetLastException() returned (%s, %v).",
-	19:   "Enter " + Prefix + "GetLastExceptionCode().",
-	20:   "Exit  " + Prefix + "GetLastExceptionCode() returned (%d, %v).",
 	21:   "Enter " + Prefix + "ImportConfig(%s).",
 	22:   "Exit  " + Prefix + "ImportConfig(%s) returned (%v, %v).",
 	23:   "Enter " + Prefix + "Initialize(%s, %s, %d).",
@@ -15,8 +15,6 @@ const Prefix = "szconfigmanager."
 var IDMessages = map[int]string{
 	1:    "Enter " + Prefix + "AddConfig(%s, %s).",
 	2:    "Exit  " + Prefix + "AddConfig(%s, %s) returned (%d, %v).",
-	3:    "Enter " + Prefix + "ClearLastException().",
-	4:    "Exit  " + Prefix + "ClearLastException() returned (%v).",
 	5:    "Enter " + Prefix + "Destroy().",
 	6:    "Exit  " + Prefix + "Destroy() returned (%v).",
 	7:    "Enter " + Prefix + "GetConfig(%d).",
@@ -25,10 +23,6 @@ var IDMessages = map[int]string{
 	10:   "Exit  " + Prefix + "GetConfigs() returned (%s, %v).",
 	11:   "Enter " + Prefix + "GetDefaultConfigID().",
 	12:   "Exit  " + Prefix + "GetDefaultConfigID() returned (%d, %v).",
-	13:   "Enter " + Prefix + "GetLastException().",
-	14:   "Exit  " + Prefix + "GetLastException() returned (%s, %v).",
-	15:   "Enter " + Prefix + "GetLastExceptionCode().",
-	16:   "Exit  " + Prefix + "GetLastExceptionCode() returned (%d, %v).",
 	17:   "Enter " + Prefix + "Initialize(%s, %s, %d).",
 	18:   "Exit  " + Prefix + "Initialize(%s, %s, %d) returned (%v).",
 	19:   "Enter " + Prefix + "ReplaceDefaultConfigID(%d, %d).",
@@ -15,18 +15,12 @@ const Prefix = "szdiagnostic."
 var IDMessages = map[int]string{
 	1:    "Enter " + Prefix + "CheckDatastorePerformance(%d).",
 	2:    "Exit  " + Prefix + "CheckDatastorePerformance(%d) returned (%s, %v).",
-	3:    "Enter " + Prefix + "ClearLastException().",
-	4:    "Exit  " + Prefix + "ClearLastException() returned (%v).",
 	5:    "Enter " + Prefix + "Destroy().",
 	6:    "Exit  " + Prefix + "Destroy() returned (%v).",
 	7:    "Enter " + Prefix + "GetDatastoreInfo().",
 	8:    "Exit  " + Prefix + "GetDatastoreInfo() returned (%s, %v).",
 	9:    "Enter " + Prefix + "GetFeature(%d).",
 	10:   "Exit  " + Prefix + "GetFeature(%d) returned (%s, %v).",
-	11:   "Enter " + Prefix + "GetLastException().",
-	12:   "Exit  " + Prefix + "GetLastException() returned (%s, %v).",
-	13:   "Enter " + Prefix + "GetLastExceptionCode().",
-	14:   "Exit  " + Prefix + "GetLastExceptionCode() returned (%d, %v).",
 	15:   "Enter " + Prefix + "Initialize(%s, %s, %d).",
 	16:   "Exit  " + Prefix + "Initialize(%s, %s, %d) returned (%v).",
 	17:   "Enter " + Prefix + "PurgeRepository().",
@@ -15,8 +15,6 @@ const Prefix = "szengine."
 var IDMessages = map[int]string{
 	1:    "Enter " + Prefix + "AddRecord(%s, %s, %s, %d).",
 	2:    "Exit  " + Prefix + "AddRecord(%s, %s, %s, %d) returned (%s, %v).",
-	3:    "Enter " + Prefix + "ClearLastException().",
-	4:    "Exit  " + Prefix + "ClearLastException() returned (%v).",
 	5:    "Enter " + Prefix + "CloseExport(%v).",
 	6:    "Exit  " + Prefix + "CloseExport(%v) returned (%v).",
 	7:    "Enter " + Prefix + "CountRedoRecords().",
@@ -35,8 +33,8 @@ var IDMessages = map[int]string{
 	20:   "Exit  " + Prefix + "ExportJSONEntityReportIterator(%d) returned (%v).",
 	21:   "Enter " + Prefix + "FetchNext(%v).",
 	22:   "Exit  " + Prefix + "FetchNext(%v) returned (%s, %v).",
-	23:   "Enter " + Prefix + "FindInterestingEntitiesByEntityID(%s, %d).",
-	24:   "Exit  " + Prefix + "FindInterestingEntitiesByEntityID(%s, %d) returned (%s, %v).",
+	23:   "Enter " + Prefix + "FindInterestingEntitiesByEntityID(%d, %d).",
+	24:   "Exit  " + Prefix + "FindInterestingEntitiesByEntityID(%d, %d) returned (%s, %v).",
 	25:   "Enter " + Prefix + "FindInterestingEntitiesByRecordID(%s, %s, %d).",
 	26:   "Exit  " + Prefix + "FindInterestingEntitiesByRecordID(%s, %s, %d) returned (%s, %v).",
 	27:   "Enter " + Prefix + "FindNetworkByEntityID(%s, %d, %d, %d, %d).",
@@ -53,10 +51,6 @@ var IDMessages = map[int]string{
 	38:   "Exit  " + Prefix + "GetEntityByEntityID(%d, %d) returned (%s, %v).",
 	39:   "Enter " + Prefix + "GetEntityByRecordID(%s, %s, %d).",
 	40:   "Exit  " + Prefix + "GetEntityByRecordID(%s, %s, %d) returned (%s, %v).",
-	41:   "Enter " + Prefix + "GetLastException().",
-	42:   "Exit  " + Prefix + "GetLastException() returned (%s, %v).",
-	43:   "Enter " + Prefix + "GetLastExceptionCode().",
-	44:   "Exit  " + Prefix + "GetLastExceptionCode() returned (%d, %v).",
 	45:   "Enter " + Prefix + "GetRecord(%s, %s, %d).",
 	46:   "Exit  " + Prefix + "GetRecord(%s, %s, %d) returned (%s, %v).",
 	47:   "Enter " + Prefix + "GetRedoRecord().",
@@ -153,13 +147,14 @@ var IDMessages = map[int]string{
 	4058: Prefix + "G2_whyRecordInEntity_V2(%s, %s, %d) failed. Return code: %d",
 	4059: Prefix + "G2_whyRecords(%s, %s, %s, %s) failed. Return code: %d",
 	4060: Prefix + "G2_whyRecords_V2(%s, %s, %s, %s, %d) failed. Return code: %d",
+	4061: Prefix + "G2_countRedoRecords() failed. Return code: %d",
 	8001: Prefix + "AddRecord",
 	8002: Prefix + "CloseExport",
 	8003: Prefix + "CountRedoRecords",
 	8004: Prefix + "DeleteRecord",
 	8005: Prefix + "Destroy",
-	8006: Prefix + "ExportCSVEntityReport",
-	8007: Prefix + "ExportCSVEntityReportIterator",
+	8006: Prefix + "ExportCsvEntityReport",
+	8007: Prefix + "ExportCsvEntityReportIterator",
 	8008: Prefix + "ExportJSONEntityReport",
 	8009: Prefix + "ExportJSONEntityReportIterator",
 	8010: Prefix + "FetchNext",
@@ -0,0 +1,37 @@
+package szerror
+
+import "sort"
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Catalog function returns the ErrorInfo of every known Senzing error code, in order of code.
+*/
+func Catalog() []ErrorInfo {
+	result := make([]ErrorInfo, 0, len(szErrorCatalog))
+	for code := range szErrorCatalog {
+		if errorInfo, ok := Lookup(code); ok {
+			result = append(result, errorInfo)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Code < result[j].Code })
+	return result
+}
+
+/*
+The Lookup function returns the symbolic name, message template, component and classification of a Senzing error code.
+
+Input
+  - senzingErrorCode: The error code, e.g. 33 from "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]".
+*/
+func Lookup(senzingErrorCode int) (ErrorInfo, bool) {
+	result, ok := szErrorCatalog[senzingErrorCode]
+	if !ok {
+		return ErrorInfo{}, false
+	}
+	result.Types = Types(New(senzingErrorCode, result.Template))
+	result.Class = Classify(New(senzingErrorCode, result.Template))
+	return result, true
+}
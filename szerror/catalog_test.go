@@ -0,0 +1,77 @@
+package szerror
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzerror_Lookup(test *testing.T) {
+	actual, ok := Lookup(33)
+	require.True(test, ok)
+	assert.Equal(test, ErrorInfo{
+		Class:     ClassCallerFault,
+		Code:      33,
+		Component: "Engine",
+		Name:      "EAS_ERR_UNKNOWN_DSRC_RECORD_ID",
+		Template:  "Unknown record: dsrc[{0}], record[{1}]",
+		Types:     []TypeIDs{SzNotFound, SzBadInput},
+	}, actual)
+
+	actual, ok = Lookup(1006)
+	require.True(test, ok)
+	assert.Equal(test, ClassRetryable, actual.Class)
+	assert.Equal(test, "Database", actual.Component)
+
+	actual, ok = Lookup(9253)
+	require.True(test, ok)
+	assert.Equal(test, "SecureStore", actual.Component)
+
+	_, ok = Lookup(-1)
+	assert.False(test, ok)
+}
+
+func TestSzerror_Catalog(test *testing.T) {
+	catalog := Catalog()
+	require.Len(test, catalog, len(SzErrorTypes), "szerrorcatalog.go is out of date; run make generate-catalogs")
+	for index, errorInfo := range catalog {
+		if index > 0 {
+			assert.Less(test, catalog[index-1].Code, errorInfo.Code)
+		}
+		_, ok := SzErrorTypes[errorInfo.Code]
+		assert.True(test, ok, errorInfo.Code)
+		assert.NotEmpty(test, errorInfo.Name, errorInfo.Code)
+		assert.NotEmpty(test, errorInfo.Template, errorInfo.Code)
+		assert.NotEmpty(test, errorInfo.Component, errorInfo.Code)
+		assert.Len(test, errorInfo.Types, len(SzErrorTypes[errorInfo.Code]), errorInfo.Code)
+	}
+	catalog[0].Types[0] = SzLicense
+	assert.NotEqual(test, SzLicense, Catalog()[0].Types[0], "callers cannot change the catalog")
+}
+
+func TestSzerror_ErrorInfo_JSON(test *testing.T) {
+	errorInfo, ok := Lookup(33)
+	require.True(test, ok)
+	jsonBytes, err := json.Marshal(errorInfo)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{
+		"class": "CallerFault",
+		"code": 33,
+		"component": "Engine",
+		"name": "EAS_ERR_UNKNOWN_DSRC_RECORD_ID",
+		"template": "Unknown record: dsrc[{0}], record[{1}]",
+		"types": ["SzNotFound", "SzBadInput"]
+	}`, string(jsonBytes))
+
+	parsed := ErrorInfo{}
+	require.NoError(test, json.Unmarshal(jsonBytes, &parsed))
+	assert.Equal(test, errorInfo, parsed)
+	require.Error(test, json.Unmarshal([]byte(`{"types": ["SzUnknown"]}`), &parsed))
+	require.Error(test, json.Unmarshal([]byte(`{"class": "Unknown"}`), &parsed))
+}
@@ -0,0 +1,148 @@
+package szerror
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Class
+// ----------------------------------------------------------------------------
+
+// The MarshalText method encodes the class by name, so it appears in JSON as e.g. "Retryable".
+func (class Class) MarshalText() ([]byte, error) {
+	return []byte(class.String()), nil
+}
+
+// The String method returns the name of the class, e.g. "Retryable".
+func (class Class) String() string {
+	if name, ok := classNames[class]; ok {
+		return name
+	}
+	return fmt.Sprintf("Class(%d)", int(class))
+}
+
+// The UnmarshalText method decodes a class encoded by MarshalText.
+func (class *Class) UnmarshalText(text []byte) error {
+	for candidate, name := range classNames {
+		if name == string(text) {
+			*class = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown szerror class %q", text)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - TypeIDs
+// ----------------------------------------------------------------------------
+
+// The MarshalText method encodes the type by name, so it appears in JSON as e.g. "SzNotFound".
+func (typeID TypeIDs) MarshalText() ([]byte, error) {
+	return []byte(typeID.String()), nil
+}
+
+// The String method returns the name of the type, e.g. "SzNotFound".
+func (typeID TypeIDs) String() string {
+	if name, ok := typeIDNames[typeID]; ok {
+		return name
+	}
+	return fmt.Sprintf("TypeIDs(%d)", int(typeID))
+}
+
+// The UnmarshalText method decodes a type encoded by MarshalText.
+func (typeID *TypeIDs) UnmarshalText(text []byte) error {
+	for candidate, name := range typeIDNames {
+		if name == string(text) {
+			*typeID = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown szerror type %q", text)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Classify function returns the class of an error: ClassRetryable, ClassPermanent or ClassCallerFault,
+in that order of precedence, ClassUnclassified for other Senzing errors, and ClassNone for other errors and nil.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func Classify(err error) Class {
+	for _, class := range []Class{ClassRetryable, ClassPermanent, ClassCallerFault} {
+		if isAny(err, classTypes[class]) {
+			return class
+		}
+	}
+	if errors.Is(err, ErrSzBase) {
+		return ClassUnclassified
+	}
+	return ClassNone
+}
+
+/*
+The IsCallerFault function returns true if the arguments of the call caused the error:
+SzBadInput, SzNotFound or SzUnknownDataSource.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func IsCallerFault(err error) bool {
+	return isAny(err, classTypes[ClassCallerFault])
+}
+
+/*
+The IsPermanent function returns true if repeating the call cannot succeed until an operator intervenes:
+SzConfiguration, SzDatabase, SzLicense, SzNotInitialized, SzUnhandled or SzUnrecoverable.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func IsPermanent(err error) bool {
+	return isAny(err, classTypes[ClassPermanent])
+}
+
+/*
+The IsRetryable function returns true if repeating the call later may succeed:
+SzDatabaseConnectionLost, SzRetryable or SzRetryTimeoutExceeded.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func IsRetryable(err error) bool {
+	return isAny(err, classTypes[ClassRetryable])
+}
+
+/*
+The Types function returns the szerror types of an error, in the order of StatusPrecedence, most specific first.
+It returns nil for errors of no szerror type.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func Types(err error) []TypeIDs {
+	var result []TypeIDs
+	for _, mapping := range StatusPrecedence {
+		if errors.Is(err, SzErrorMap[mapping.TypeID]) {
+			result = append(result, mapping.TypeID)
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func isAny(err error, typeIDs []TypeIDs) bool {
+	for _, typeID := range typeIDs {
+		if errors.Is(err, SzErrorMap[typeID]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package szerror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzerror_Classify(test *testing.T) {
+	testCases := []struct {
+		name          string
+		err           error
+		expected      Class
+		expectedTypes []TypeIDs
+	}{
+		{name: "nil", err: nil, expected: ClassNone},
+		{name: "plain", err: errors.New("plain"), expected: ClassNone},
+		{name: "not-found", err: New(33, "0033E|Unknown record"), expected: ClassCallerFault, expectedTypes: []TypeIDs{SzNotFound, SzBadInput}},
+		{name: "bad-input", err: New(2, "0002E|Invalid message"), expected: ClassCallerFault, expectedTypes: []TypeIDs{SzBadInput}},
+		{name: "connection-lost", err: New(1006, "1006E|Database Connection Failure"), expected: ClassRetryable, expectedTypes: []TypeIDs{SzDatabaseConnectionLost, SzRetryable}},
+		{name: "retry-timeout", err: New(10, "0010E|Retry timeout exceeded"), expected: ClassRetryable, expectedTypes: []TypeIDs{SzRetryTimeoutExceeded, SzRetryable}},
+		{name: "license", err: New(9000, "9000E|Maximum number of records ingested"), expected: ClassPermanent, expectedTypes: []TypeIDs{SzLicense, SzUnrecoverable}},
+		{name: "configuration", err: New(14, "0014E|Invalid datastore configuration"), expected: ClassPermanent, expectedTypes: []TypeIDs{SzConfiguration}},
+		{name: "base", err: New(5, "0005E|Exceeded the maximum number of retries"), expected: ClassUnclassified, expectedTypes: []TypeIDs{SzBase}},
+		{name: "wrapped", err: fmt.Errorf("get record: %w", New(33, "0033E|Unknown record")), expected: ClassCallerFault, expectedTypes: []TypeIDs{SzNotFound, SzBadInput}},
+		{
+			name:          "nested-join",
+			err:           errors.Join(errors.New("batch"), fmt.Errorf("record 2: %w", errors.Join(errors.New("first"), New(1006, "1006E|Database Connection Failure")))),
+			expected:      ClassRetryable,
+			expectedTypes: []TypeIDs{SzDatabaseConnectionLost, SzRetryable},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			assert.Equal(test, testCase.expected, Classify(testCase.err))
+			assert.Equal(test, testCase.expectedTypes, Types(testCase.err))
+			assert.Equal(test, testCase.expected == ClassRetryable, IsRetryable(testCase.err))
+			assert.Equal(test, testCase.expected == ClassPermanent, IsPermanent(testCase.err))
+			assert.Equal(test, testCase.expected == ClassCallerFault, IsCallerFault(testCase.err))
+		})
+	}
+}
+
+func TestSzerror_Classify_allCodes(test *testing.T) {
+	for senzingErrorCode := range SzErrorTypes {
+		err := New(senzingErrorCode, "message")
+		assert.NotEqual(test, ClassNone, Classify(err), senzingErrorCode)
+		assert.LessOrEqual(test, countTrue(IsRetryable(err), IsPermanent(err), IsCallerFault(err)), 1, "code %d is in one class", senzingErrorCode)
+	}
+}
+
+func TestSzerror_String(test *testing.T) {
+	assert.Equal(test, "SzNotFound", SzNotFound.String())
+	assert.Equal(test, "TypeIDs(99)", TypeIDs(99).String())
+	assert.Equal(test, "[SzNotFound SzBadInput]", fmt.Sprint(Types(New(33, "0033E|Unknown record"))))
+	assert.Equal(test, "Retryable", ClassRetryable.String())
+	assert.Equal(test, "Class(99)", Class(99).String())
+	for _, typeID := range SzErrorTypesList {
+		assert.NotContains(test, typeID.String(), "TypeIDs(")
+	}
+}
+
+func countTrue(values ...bool) int {
+	result := 0
+	for _, value := range values {
+		if value {
+			result++
+		}
+	}
+	return result
+}
@@ -0,0 +1,168 @@
+package szerror
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Severity
+// ----------------------------------------------------------------------------
+
+// The MarshalText method encodes the severity by name, so it appears in JSON as e.g. "error".
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+// The String method returns the name of the severity: "error", "warning", "info" or "unknown".
+func (severity Severity) String() string {
+	if name, ok := severityNames[severity]; ok {
+		return name
+	}
+	return fmt.Sprintf("Severity(%d)", int(severity))
+}
+
+// The UnmarshalText method decodes a severity encoded by MarshalText.
+func (severity *Severity) UnmarshalText(text []byte) error {
+	for candidate, name := range severityNames {
+		if name == string(text) {
+			*severity = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown szerror severity %q", text)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The ParseException function parses a Senzing exception string such as "0037E|Unknown resolved entity value '-4'".
+The header, an optional prefix such as "SENZ", the code and an optional severity letter, may follow other text,
+as in the text returned by err.Error() for errors from New. Everything after the header is the message.
+Headers nested in the message are parsed as the chain of Causes, at most 15 deep.
+If the text is a JSON document, the first exception in its strings is parsed,
+e.g. from {"errors": [{"text": "0033E|Unknown record: dsrc[CUSTOMERS], record[1001]"}]}.
+It returns false if the text holds no exception header.
+
+Input
+  - text: The exception string, e.g. from Senzing's getLastException.
+*/
+func ParseException(text string) (*Exception, bool) {
+	var document any
+	if trimmed := strings.TrimSpace(text); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if json.Unmarshal([]byte(trimmed), &document) == nil {
+			if result, ok := parseJSONException(document); ok {
+				return result, true
+			}
+		}
+	}
+	return parseException(text)
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// The first JSON object, or array of objects, in text, and its offset, trying at most maxJSONCandidates starting positions.
+func firstJSON(text string) (json.RawMessage, int) {
+	candidates := 0
+	for index := 0; index < len(text) && candidates < maxJSONCandidates; index++ {
+		if text[index] != '{' && text[index] != '[' {
+			continue
+		}
+		candidates++
+		if text[index] == '[' && !strings.HasPrefix(strings.TrimLeft(text[index+1:], " \t\r\n"), "{") {
+			continue
+		}
+		var result json.RawMessage
+		if json.NewDecoder(strings.NewReader(text[index:])).Decode(&result) == nil {
+			return result, index
+		}
+	}
+	return nil, -1
+}
+
+// Parse the first header in text and, as its Causes, up to maxExceptionDepth-1 headers following it.
+// The headers are found in one pass and JSON is looked for once, in the outermost message;
+// a Cause has the JSON only if the JSON is within its message.
+func parseException(text string) (*Exception, bool) {
+	var (
+		outermost *Exception
+		previous  *Exception
+		jsonData  json.RawMessage
+		jsonAt    int
+	)
+	depth := 0
+	for _, location := range exceptionHeaderPattern.FindAllStringSubmatchIndex(text, -1) {
+		if depth == maxExceptionDepth {
+			break
+		}
+		if start := location[0]; start > 0 && isAlphanumeric(text[start-1]) {
+			continue
+		}
+		code, err := strconv.Atoi(text[location[2]:location[3]])
+		if err != nil {
+			continue
+		}
+		message := strings.TrimLeft(text[location[1]:], " \t\r\n\v\f")
+		messageAt := len(text) - len(message)
+		exception := &Exception{
+			Code:     code,
+			Message:  strings.TrimSpace(message),
+			Severity: severityLetters[strings.ToUpper(text[location[4]:location[5]])],
+		}
+		if outermost == nil {
+			outermost = exception
+			jsonData, jsonAt = firstJSON(message)
+			jsonAt += messageAt
+		}
+		if jsonData != nil && jsonAt >= messageAt {
+			exception.JSON = jsonData
+		}
+		if previous != nil {
+			previous.Cause = exception
+		}
+		previous = exception
+		depth++
+	}
+	return outermost, outermost != nil
+}
+
+// The exception in the first string of a JSON document, depth first, holding one.
+func parseJSONException(document any) (*Exception, bool) {
+	switch value := document.(type) {
+	case string:
+		return parseException(value)
+	case []any:
+		for _, element := range value {
+			if result, ok := parseJSONException(element); ok {
+				return result, true
+			}
+		}
+	case map[string]any:
+		for _, key := range sortedKeys(value) {
+			if result, ok := parseJSONException(value[key]); ok {
+				return result, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func sortedKeys(values map[string]any) []string {
+	result := make([]string, 0, len(values))
+	for key := range values {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isAlphanumeric(character byte) bool {
+	return ('0' <= character && character <= '9') || ('A' <= character && character <= 'Z') || ('a' <= character && character <= 'z')
+}
@@ -0,0 +1,192 @@
+package szerror
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzerror_ParseException(test *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected Exception
+	}{
+		{
+			name:     "error",
+			text:     "0037E|Unknown resolved entity value '-4'",
+			expected: Exception{Code: 37, Severity: SeverityError, Message: "Unknown resolved entity value '-4'"},
+		},
+		{
+			name:     "warning",
+			text:     "7W|Test message",
+			expected: Exception{Code: 7, Severity: SeverityWarning, Message: "Test message"},
+		},
+		{
+			name:     "info-senz-prefix",
+			text:     "SENZ0005I|Test message",
+			expected: Exception{Code: 5, Severity: SeverityInfo, Message: "Test message"},
+		},
+		{
+			name:     "no-severity",
+			text:     "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]",
+			expected: Exception{Code: 33, Severity: SeverityUnknown, Message: "Unknown record: dsrc[CUSTOMERS], record[1001]"},
+		},
+		{
+			name:     "second-pipe",
+			text:     "0002E|Invalid message: field|value",
+			expected: Exception{Code: 2, Severity: SeverityError, Message: "Invalid message: field|value"},
+		},
+		{
+			name:     "joined",
+			text:     "\n\n0033E|Unknown record\nsecond line",
+			expected: Exception{Code: 33, Severity: SeverityError, Message: "Unknown record\nsecond line"},
+		},
+		{
+			name: "embedded-json",
+			text: `0002E|Invalid message {"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": 1} rejected`,
+			expected: Exception{
+				Code:     2,
+				Severity: SeverityError,
+				Message:  `Invalid message {"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": 1} rejected`,
+				JSON:     json.RawMessage(`{"DATA_SOURCE": "CUSTOMERS", "RECORD_ID": 1}`),
+			},
+		},
+		{
+			name: "nested",
+			text: "2001E|Initialization failed: 7220E|No engine configuration registered in datastore",
+			expected: Exception{
+				Code:     2001,
+				Severity: SeverityError,
+				Message:  "Initialization failed: 7220E|No engine configuration registered in datastore",
+				Cause:    &Exception{Code: 7220, Severity: SeverityError, Message: "No engine configuration registered in datastore"},
+			},
+		},
+		{
+			name:     "json-wrapped",
+			text:     `{"errors": [{"id": "senzing-60044001", "text": "Not a Senzing message"}, {"id": "senzing-60044001", "text": "5I|Test message"}]}`,
+			expected: Exception{Code: 5, Severity: SeverityInfo, Message: "Test message"},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, ok := ParseException(testCase.text)
+			require.True(test, ok)
+			assert.Equal(test, testCase.expected, *actual)
+			assert.Equal(test, testCase.expected.Code, Code(testCase.text))
+			assert.Equal(test, testCase.expected.Message, Message(testCase.text))
+		})
+	}
+}
+
+func TestSzerror_ParseException_noHeader(test *testing.T) {
+	for _, text := range []string{"", "plain", "abc|def", "|", "12345678901|too long", `{"text": "plain"}`, "[1, 2]"} {
+		_, ok := ParseException(text)
+		assert.False(test, ok, text)
+		assert.Equal(test, 0, Code(text), text)
+	}
+	assert.Equal(test, "def", Message("abc|def"), "text after the first | without a header")
+	assert.Empty(test, Message("plain"))
+}
+
+func TestSzerror_ParseException_repeatedHeaders(test *testing.T) {
+	text := strings.Repeat("SENZ1|", 40000)
+	exception, ok := ParseException(text)
+	require.True(test, ok)
+	depth := 0
+	for cause := exception; cause != nil; cause = cause.Cause {
+		assert.Equal(test, 1, cause.Code)
+		depth++
+	}
+	assert.Equal(test, maxExceptionDepth, depth)
+	assert.Equal(test, 1, Code(text))
+}
+
+func TestSzerror_ParseException_causeJSON(test *testing.T) {
+	exception, ok := ParseException(`2001E|Failed: 0002E|Invalid {"RECORD_ID": 1}`)
+	require.True(test, ok)
+	require.NotNil(test, exception.Cause)
+	assert.JSONEq(test, `{"RECORD_ID": 1}`, string(exception.JSON))
+	assert.JSONEq(test, `{"RECORD_ID": 1}`, string(exception.Cause.JSON))
+	exception, ok = ParseException(`2001E|Failed {"A": 1}: 0002E|Invalid`)
+	require.True(test, ok)
+	assert.Nil(test, exception.Cause.JSON, "JSON before the cause is not the cause's")
+}
+
+func TestSzerror_Severity(test *testing.T) {
+	assert.Equal(test, "error", SeverityError.String())
+	assert.Equal(test, "Severity(9)", Severity(9).String())
+	exception, ok := ParseException("0037E|Unknown resolved entity value '-4'")
+	require.True(test, ok)
+	jsonBytes, err := json.Marshal(exception)
+	require.NoError(test, err)
+	assert.JSONEq(test, `{"code": 37, "severity": "error", "message": "Unknown resolved entity value '-4'"}`, string(jsonBytes))
+	parsed := &Exception{}
+	require.NoError(test, json.Unmarshal(jsonBytes, parsed))
+	assert.Equal(test, exception, parsed)
+	require.Error(test, json.Unmarshal([]byte(`{"severity": "fatal"}`), parsed))
+}
+
+// ----------------------------------------------------------------------------
+// Fuzz tests
+// ----------------------------------------------------------------------------
+
+func FuzzSzerror_ParseException(fuzz *testing.F) {
+	for _, seed := range []string{
+		"0037E|Unknown resolved entity value '-4'",
+		"SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]",
+		"2001E|Initialization failed: 7220E|No engine configuration",
+		`{"errors": [{"text": "5I|Test message"}]}`,
+		`0002E|Invalid {"A": [1, {"B": 2}]} message`,
+		"\n\n0033E|a|b\nc",
+		"99999999999|overflow",
+		"[[[[{{{{",
+		"|||",
+		"x1|y1|-1|",
+		strings.Repeat("SENZ1|", 40000),
+	} {
+		fuzz.Add(seed)
+	}
+	fuzz.Fuzz(func(test *testing.T, text string) {
+		exception, ok := ParseException(text)
+		code := Code(text)
+		message := Message(text)
+		if !ok {
+			assert.Equal(test, 0, code)
+			return
+		}
+		assert.Equal(test, exception.Code, code)
+		assert.Equal(test, exception.Message, message)
+		assert.GreaterOrEqual(test, exception.Code, 0)
+		if exception.JSON != nil {
+			assert.True(test, json.Valid(exception.JSON))
+		}
+		if utf8.ValidString(text) && !strings.HasPrefix(strings.TrimSpace(text), "{") && !strings.HasPrefix(strings.TrimSpace(text), "[") {
+			assert.Contains(test, text, exception.Message)
+		}
+		for cause := exception.Cause; cause != nil; cause = cause.Cause {
+			assert.Contains(test, exception.Message, cause.Message)
+		}
+	})
+}
+
+func FuzzSzerror_ParseParameters(fuzz *testing.F) {
+	fuzz.Add(33, "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]")
+	fuzz.Add(10, "SENZ0010|Retry timeout exceeded RES_ENT_ID locklist [1, 2]")
+	fuzz.Add(-1, "")
+	fuzz.Fuzz(func(test *testing.T, code int, message string) {
+		parameters, ok := ParseParameters(code, message)
+		if ok {
+			assert.Equal(test, code, parameters.Code)
+		}
+		_, _ = Parameters(New(code, message))
+	})
+}
@@ -1,6 +1,12 @@
 package szerror
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"sync"
+)
 
 // ----------------------------------------------------------------------------
 // Types
@@ -8,12 +14,148 @@ import "errors"
 
 type TypeIDs int
 
+// A Class is the broad kind of an error, as returned by Classify: what a caller should do about it.
+type Class int
+
+// An ErrorInfo describes a Senzing error code, as returned by Lookup and Catalog.
+type ErrorInfo struct {
+	// Class is the Class of errors of the code.
+	Class Class `json:"class"`
+	Code  int   `json:"code"`
+	// Component is the part of Senzing issuing the code, e.g. "Engine", "Database", "Configuration", "SecureStore".
+	Component string `json:"component"`
+	// Name is the symbolic name, e.g. "EAS_ERR_UNKNOWN_DSRC_RECORD_ID".
+	Name string `json:"name"`
+	// Template is the message template, with numbered placeholders, e.g. "Unknown record: dsrc[{0}], record[{1}]".
+	Template string `json:"template"`
+	// Types are the TypeIDs of errors of the code, as in SzErrorTypes.
+	Types []TypeIDs `json:"types"`
+}
+
+// An Exception is a parsed Senzing exception string, as returned by ParseException.
+type Exception struct {
+	// Cause is the exception nested in Message, if any, e.g. "7220E|..." in "2001E|Initialization failed: 7220E|...".
+	Cause *Exception `json:"cause,omitempty"`
+	Code  int        `json:"code"`
+	// JSON is the first JSON object, or array of objects, in Message, if any.
+	JSON json.RawMessage `json:"json,omitempty"`
+	// Message is all the text after the header, including any further "|" and lines.
+	Message  string   `json:"message"`
+	Severity Severity `json:"severity"`
+}
+
+// MessageParameters are the values a Senzing error message fills into the template of its code, as returned by Parameters.
+type MessageParameters struct {
+	Code int `json:"code"`
+	// Named holds the values by name, for codes whose placeholders have names, e.g. ParameterDataSource for code 33.
+	Named map[string]string `json:"named,omitempty"`
+	// Values holds the values by placeholder number: Values[0] fills {0}.
+	Values []string `json:"values"`
+}
+
+// A Problem is an RFC 7807 problem details body describing an error, as returned by NewProblem.
+type Problem struct {
+	// Detail is the error message, e.g. "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]".
+	Detail string `json:"detail,omitempty"`
+	// SenzingErrorCode is the Senzing error code in Detail, if any.
+	SenzingErrorCode int    `json:"senzingErrorCode,omitempty"`
+	Status           int    `json:"status"`
+	Title            string `json:"title"`
+	Type             string `json:"type"`
+	// Types are the names of the TypeIDs of the error, e.g. "SzNotFound".
+	Types []string `json:"types,omitempty"`
+}
+
+// A Severity is the level of a Senzing exception, from the letter after its code, e.g. the "E" of "0037E|".
+type Severity int
+
+// An SzError is the error returned by New. It matches the szerror sentinels of its code with errors.Is.
+type SzError struct {
+	// Code is the Senzing error code, e.g. 33.
+	Code int
+	// Message is the message given to New.
+	Message string
+
+	joined error
+}
+
+// A StatusMapping gives the HTTP status and gRPC code of errors of a type.
+type StatusMapping struct {
+	GRPCCode   uint32
+	HTTPStatus int
+	TypeID     TypeIDs
+}
+
 // ----------------------------------------------------------------------------
 // Constants
 // ----------------------------------------------------------------------------
 
 const emptyErrorMessage = ""
 
+// gRPC status codes, with the values of google.golang.org/grpc/codes, which szerror does not import.
+const (
+	GRPCCodeOK                 uint32 = 0
+	GRPCCodeCanceled           uint32 = 1
+	GRPCCodeUnknown            uint32 = 2
+	GRPCCodeInvalidArgument    uint32 = 3
+	GRPCCodeDeadlineExceeded   uint32 = 4
+	GRPCCodeNotFound           uint32 = 5
+	GRPCCodePermissionDenied   uint32 = 7
+	GRPCCodeResourceExhausted  uint32 = 8
+	GRPCCodeFailedPrecondition uint32 = 9
+	GRPCCodeInternal           uint32 = 13
+	GRPCCodeUnavailable        uint32 = 14
+)
+
+// Severities, from the letter after the code of an exception.
+const (
+	SeverityUnknown Severity = iota
+	SeverityError
+	SeverityWarning
+	SeverityInfo
+)
+
+// The most candidate positions ParseException tries when looking for JSON in a message.
+const maxJSONCandidates = 16
+
+// The most exceptions ParseException chains through Cause, the outermost included.
+const maxExceptionDepth = 16
+
+// Names of message parameters, as keys of MessageParameters.Named.
+const (
+	ParameterAttribute        = "Attribute"
+	ParameterConfigID         = "ConfigID"
+	ParameterDataSource       = "DataSource"
+	ParameterDataSource2      = "DataSource2"
+	ParameterDataSourceID     = "DataSourceID"
+	ParameterEntityID         = "EntityID"
+	ParameterEntityIDs        = "EntityIDs"
+	ParameterEntityKey        = "EntityKey"
+	ParameterFeatureType      = "FeatureType"
+	ParameterKeyword          = "Keyword"
+	ParameterMappingDirective = "MappingDirective"
+	ParameterRecordID         = "RecordID"
+	ParameterRecordID2        = "RecordID2"
+	ParameterRecordLimit      = "RecordLimit"
+)
+
+// Classes, from Classify.
+const (
+	// ClassNone is an error of no szerror type, or nil.
+	ClassNone Class = iota
+	// ClassRetryable errors may succeed if the call is repeated later.
+	ClassRetryable
+	// ClassPermanent errors need an operator: the license, database, configuration or engine state must change.
+	ClassPermanent
+	// ClassCallerFault errors are caused by the arguments of the call, e.g. an unknown record.
+	ClassCallerFault
+	// ClassUnclassified errors are Senzing errors of no more specific type than SzBase.
+	ClassUnclassified
+)
+
+// ProblemTypeBase prefixes the Type of a Problem; the name of the error's primary type follows, e.g. "urn:senzing:error:SzNotFound".
+const ProblemTypeBase = "urn:senzing:error:"
+
 const (
 	SzBase TypeIDs = iota
 	SzBadInput
@@ -84,3 +226,121 @@ var SzErrorMap = map[TypeIDs]error{
 	SzUnknownDataSource:      ErrSzUnknownDataSource,
 	SzUnrecoverable:          ErrSzUnrecoverable,
 }
+
+// The HTTP status and gRPC code of each TypeIDs, in order of precedence:
+// an error of several types, e.g. SzNotFound and SzBadInput, maps by the first one listed.
+// SzRetryTimeoutExceeded is Unavailable, not DeadlineExceeded, so that clients can tell it from an expired context.
+var StatusPrecedence = []StatusMapping{
+	{TypeID: SzRetryTimeoutExceeded, HTTPStatus: http.StatusServiceUnavailable, GRPCCode: GRPCCodeUnavailable},
+	{TypeID: SzNotFound, HTTPStatus: http.StatusNotFound, GRPCCode: GRPCCodeNotFound},
+	{TypeID: SzUnknownDataSource, HTTPStatus: http.StatusBadRequest, GRPCCode: GRPCCodeInvalidArgument},
+	{TypeID: SzBadInput, HTTPStatus: http.StatusBadRequest, GRPCCode: GRPCCodeInvalidArgument},
+	{TypeID: SzLicense, HTTPStatus: http.StatusForbidden, GRPCCode: GRPCCodePermissionDenied},
+	{TypeID: SzNotInitialized, HTTPStatus: http.StatusServiceUnavailable, GRPCCode: GRPCCodeFailedPrecondition},
+	{TypeID: SzConfiguration, HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCCodeFailedPrecondition},
+	{TypeID: SzDatabaseConnectionLost, HTTPStatus: http.StatusServiceUnavailable, GRPCCode: GRPCCodeUnavailable},
+	{TypeID: SzRetryable, HTTPStatus: http.StatusServiceUnavailable, GRPCCode: GRPCCodeUnavailable},
+	{TypeID: SzDatabase, HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCCodeInternal},
+	{TypeID: SzUnrecoverable, HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCCodeInternal},
+	{TypeID: SzUnhandled, HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCCodeUnknown},
+	{TypeID: SzBase, HTTPStatus: http.StatusInternalServerError, GRPCCode: GRPCCodeUnknown},
+}
+
+// The types a client assumes for a gRPC code with no further detail. Codes not listed have no type.
+var grpcCodeTypes = map[uint32][]TypeIDs{
+	GRPCCodeFailedPrecondition: {SzConfiguration},
+	GRPCCodeInternal:           {SzUnrecoverable},
+	GRPCCodeInvalidArgument:    {SzBadInput},
+	GRPCCodeNotFound:           {SzNotFound, SzBadInput},
+	GRPCCodePermissionDenied:   {SzLicense, SzUnrecoverable},
+	GRPCCodeResourceExhausted:  {SzRetryable},
+	GRPCCodeUnavailable:        {SzRetryable},
+}
+
+// The types a client assumes for an HTTP status with no further detail. Statuses not listed have no type.
+var httpStatusTypes = map[int][]TypeIDs{
+	http.StatusBadRequest:          {SzBadInput},
+	http.StatusForbidden:           {SzLicense, SzUnrecoverable},
+	http.StatusInternalServerError: {SzUnrecoverable},
+	http.StatusNotFound:            {SzNotFound, SzBadInput},
+	http.StatusServiceUnavailable:  {SzRetryable},
+	http.StatusTooManyRequests:     {SzRetryable},
+}
+
+// Names of Classes, from Class.String.
+var classNames = map[Class]string{
+	ClassCallerFault:  "CallerFault",
+	ClassNone:         "None",
+	ClassPermanent:    "Permanent",
+	ClassRetryable:    "Retryable",
+	ClassUnclassified: "Unclassified",
+}
+
+// Types of each Class, other than ClassNone and ClassUnclassified.
+var classTypes = map[Class][]TypeIDs{
+	ClassCallerFault: {SzBadInput, SzNotFound, SzUnknownDataSource},
+	ClassPermanent:   {SzConfiguration, SzDatabase, SzLicense, SzNotInitialized, SzUnhandled, SzUnrecoverable},
+	ClassRetryable:   {SzDatabaseConnectionLost, SzRetryable, SzRetryTimeoutExceeded},
+}
+
+// Headers of exceptions, e.g. "0037E|" or "SENZ0033|": optional letters, the code and an optional severity letter.
+// A header must not follow a letter or digit; parseException checks this, as matches may not overlap the preceding "|".
+var exceptionHeaderPattern = regexp.MustCompile(`[A-Za-z]*([0-9]{1,9})([A-Za-z]?)\|`)
+
+// A "SENZ" exception header, which ends the segment of a message matched by a template.
+var segmentHeaderPattern = regexp.MustCompile(`SENZ[0-9]{1,9}[A-Za-z]?\|`)
+
+// Names of the placeholders of message templates, by code: the first name is for {0}.
+var parameterNames = map[int][]string{
+	10:   {ParameterEntityIDs},
+	23:   {ParameterDataSource, ParameterDataSource2},
+	24:   {ParameterRecordID, ParameterRecordID2},
+	26:   {ParameterKeyword},
+	33:   {ParameterDataSource, ParameterRecordID},
+	37:   {ParameterEntityID},
+	38:   {ParameterDataSource, ParameterRecordID},
+	39:   {ParameterDataSource, ParameterRecordID, ParameterEntityKey},
+	55:   {ParameterDataSource, ParameterRecordID, ParameterEntityKey},
+	56:   {ParameterEntityID},
+	2015: {ParameterFeatureType},
+	2207: {ParameterDataSource},
+	2294: {ParameterDataSourceID},
+	7221: {ParameterConfigID},
+	7344: {ParameterMappingDirective, ParameterAttribute},
+	7511: {ParameterEntityID},
+	9000: {ParameterRecordLimit},
+}
+
+// Placeholders of message templates, e.g. "{0}".
+var placeholderPattern = regexp.MustCompile(`\{(\d+)\}`)
+
+// Compiled message templates, by code, built as needed.
+var templatePatterns sync.Map
+
+// Severities by the letter after the code, and their names, from Severity.String.
+var (
+	severityLetters = map[string]Severity{"E": SeverityError, "I": SeverityInfo, "W": SeverityWarning}
+	severityNames   = map[Severity]string{
+		SeverityError:   "error",
+		SeverityInfo:    "info",
+		SeverityUnknown: "unknown",
+		SeverityWarning: "warning",
+	}
+)
+
+// Names of TypeIDs, from TypeIDs.String and as listed in Problem.Types.
+var typeIDNames = map[TypeIDs]string{
+	SzBadInput:               "SzBadInput",
+	SzBase:                   "SzBase",
+	SzConfiguration:          "SzConfiguration",
+	SzDatabase:               "SzDatabase",
+	SzDatabaseConnectionLost: "SzDatabaseConnectionLost",
+	SzLicense:                "SzLicense",
+	SzNotFound:               "SzNotFound",
+	SzNotInitialized:         "SzNotInitialized",
+	SzRetryable:              "SzRetryable",
+	SzRetryTimeoutExceeded:   "SzRetryTimeoutExceeded",
+	SzUnhandled:              "SzUnhandled",
+	SzUnknownDataSource:      "SzUnknownDataSource",
+	SzUnrecoverable:          "SzUnrecoverable",
+}
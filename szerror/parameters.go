@@ -0,0 +1,196 @@
+package szerror
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - MessageParameters
+// ----------------------------------------------------------------------------
+
+// The Get method returns the value of a named parameter, or "" if it has none.
+func (parameters MessageParameters) Get(name string) string {
+	return parameters.Named[name]
+}
+
+/*
+The Int64 method returns the value of a named parameter as an integer, e.g. ParameterEntityID.
+
+Input
+  - name: The name of the parameter.
+*/
+func (parameters MessageParameters) Int64(name string) (int64, error) {
+	value, ok := parameters.Named[name]
+	if !ok {
+		return 0, fmt.Errorf("code %d has no parameter %s", parameters.Code, name)
+	}
+	return strconv.ParseInt(strings.Trim(value, "'\" "), 10, 64)
+}
+
+/*
+The Int64s method returns the integers in the value of a named parameter, e.g. ParameterEntityIDs for code 10.
+Integers may be separated by any non-digit characters, such as commas or spaces.
+
+Input
+  - name: The name of the parameter.
+*/
+func (parameters MessageParameters) Int64s(name string) ([]int64, error) {
+	value, ok := parameters.Named[name]
+	if !ok {
+		return nil, fmt.Errorf("code %d has no parameter %s", parameters.Code, name)
+	}
+	result := []int64{}
+	fields := strings.FieldsFunc(value, func(r rune) bool { return !unicode.IsDigit(r) && r != '-' })
+	for _, field := range fields {
+		integer, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, integer)
+	}
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Parameters function returns the values a Senzing error fills into the template of its code.
+For an *SzError anywhere in the chain of err, its Code and Message are used; otherwise the code is read from err.Error().
+It returns false if the message does not match the template, or the code is unknown.
+
+Input
+  - err: The error returned by a Senzing method. It may be wrapped with fmt.Errorf("%w") or errors.Join.
+*/
+func Parameters(err error) (MessageParameters, bool) {
+	var szError *SzError
+	if errors.As(err, &szError) {
+		return szError.Parameters()
+	}
+	if err == nil {
+		return MessageParameters{}, false
+	}
+	message := err.Error()
+	return ParseParameters(Code(message), message)
+}
+
+/*
+The ParseParameters function matches a message against the template of a Senzing error code
+and returns the values filling its placeholders, by number and, for common codes, by name.
+The message may hold other text around the templated part, such as the "SENZ0033|" prefix or a JSON wrapper.
+
+Input
+  - senzingErrorCode: The error code, e.g. 33.
+  - message: The message, e.g. "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]".
+*/
+func ParseParameters(senzingErrorCode int, message string) (MessageParameters, bool) {
+	pattern, indexes := templatePattern(senzingErrorCode)
+	if pattern == nil {
+		return MessageParameters{}, false
+	}
+	location := pattern.FindStringSubmatchIndex(message)
+	// The last placeholder is greedy; keep it within its message segment, before any following "SENZ" header.
+	for location != nil && len(location) > 2 {
+		last := location[len(location)-2:]
+		cut := segmentHeaderPattern.FindStringIndex(message[last[0]:last[1]])
+		if cut == nil {
+			break
+		}
+		message = message[:last[0]+cut[0]]
+		location = pattern.FindStringSubmatchIndex(message)
+	}
+	if location == nil {
+		return MessageParameters{}, false
+	}
+	match := make([]string, len(location)/2)
+	for group := range match {
+		if location[2*group] >= 0 {
+			match[group] = message[location[2*group]:location[2*group+1]]
+		}
+	}
+	result := MessageParameters{
+		Code:   senzingErrorCode,
+		Values: []string{},
+	}
+	for group, index := range indexes {
+		for len(result.Values) <= index {
+			result.Values = append(result.Values, "")
+		}
+		if len(result.Values[index]) == 0 {
+			result.Values[index] = match[group+1]
+		}
+	}
+	if names, ok := parameterNames[senzingErrorCode]; ok {
+		result.Named = map[string]string{}
+		for index, name := range names {
+			if index < len(result.Values) {
+				result.Named[name] = result.Values[index]
+			}
+		}
+	}
+	return result, true
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// A compiled message template: literal text must match with any whitespace, placeholders match lazily,
+// except the last, which runs greedily to the end of the line or of an enclosing JSON string.
+type compiledTemplate struct {
+	indexes []int // The placeholder number of each group.
+	pattern *regexp.Regexp
+}
+
+// The compiled template of a code and the placeholder number of each of its groups, or nil for unknown codes.
+func templatePattern(senzingErrorCode int) (*regexp.Regexp, []int) {
+	if cached, ok := templatePatterns.Load(senzingErrorCode); ok {
+		compiled := cached.(compiledTemplate)
+		return compiled.pattern, compiled.indexes
+	}
+	errorInfo, ok := szErrorCatalog[senzingErrorCode]
+	if !ok {
+		return nil, nil
+	}
+	var builder strings.Builder
+	compiled := compiledTemplate{}
+	template := strings.TrimSpace(errorInfo.Template)
+	last := 0
+	locations := placeholderPattern.FindAllStringSubmatchIndex(template, -1)
+	for number, location := range locations {
+		builder.WriteString(literalPattern(template[last:location[0]]))
+		index, _ := strconv.Atoi(template[location[2]:location[3]])
+		compiled.indexes = append(compiled.indexes, index)
+		if number == len(locations)-1 {
+			builder.WriteString(`([^"\n]*)`)
+		} else {
+			builder.WriteString(`(.*?)`)
+		}
+		last = location[1]
+	}
+	builder.WriteString(literalPattern(template[last:]))
+	compiled.pattern = regexp.MustCompile(builder.String())
+	templatePatterns.Store(senzingErrorCode, compiled)
+	return compiled.pattern, compiled.indexes
+}
+
+func literalPattern(literal string) string {
+	fields := strings.Fields(literal)
+	for index, field := range fields {
+		fields[index] = regexp.QuoteMeta(field)
+	}
+	result := strings.Join(fields, `\s+`)
+	if len(literal) > 0 && unicode.IsSpace(rune(literal[0])) {
+		result = `\s*` + result
+	}
+	if len(literal) > 0 && unicode.IsSpace(rune(literal[len(literal)-1])) && len(fields) > 0 {
+		result += `\s*`
+	}
+	return result
+}
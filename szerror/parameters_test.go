@@ -0,0 +1,201 @@
+package szerror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzerror_ParseParameters(test *testing.T) {
+	testCases := []struct {
+		name     string
+		code     int
+		message  string
+		expected MessageParameters
+	}{
+		{
+			name:    "unknown-record",
+			code:    33,
+			message: "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]",
+			expected: MessageParameters{
+				Code:   33,
+				Named:  map[string]string{ParameterDataSource: "CUSTOMERS", ParameterRecordID: "1001"},
+				Values: []string{"CUSTOMERS", "1001"},
+			},
+		},
+		{
+			name:    "unknown-entity",
+			code:    37,
+			message: "0037E|Unknown resolved entity value '-4'",
+			expected: MessageParameters{
+				Code:   37,
+				Named:  map[string]string{ParameterEntityID: "-4"},
+				Values: []string{"-4"},
+			},
+		},
+		{
+			name:    "retry-timeout",
+			code:    10,
+			message: "SENZ0010|Retry timeout exceeded RES_ENT_ID locklist [200001, 200002]",
+			expected: MessageParameters{
+				Code:   10,
+				Named:  map[string]string{ParameterEntityIDs: "200001, 200002"},
+				Values: []string{"200001, 200002"},
+			},
+		},
+		{
+			name:    "json-wrapped",
+			code:    2207,
+			message: `{"errors": [{"id": "senzing-60044001", "text": "SENZ2207|Data source code [BOB] does not exist."}]}`,
+			expected: MessageParameters{
+				Code:   2207,
+				Named:  map[string]string{ParameterDataSource: "BOB"},
+				Values: []string{"BOB"},
+			},
+		},
+		{
+			name:    "trailing-placeholder",
+			code:    9000,
+			message: `{"text": "9000E|LIMIT: Maximum number of records ingested: 50000"}`,
+			expected: MessageParameters{
+				Code:   9000,
+				Named:  map[string]string{ParameterRecordLimit: "50000"},
+				Values: []string{"50000"},
+			},
+		},
+		{
+			name:     "unnamed",
+			code:     7217,
+			message:  "7217E|Cannot specify both default backend database and default backend cluster",
+			expected: MessageParameters{Code: 7217, Values: []string{}},
+		},
+		{
+			name:    "delimiter-in-last-value",
+			code:    33,
+			message: "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[x]y]",
+			expected: MessageParameters{
+				Code:   33,
+				Named:  map[string]string{ParameterDataSource: "CUSTOMERS", ParameterRecordID: "x]y"},
+				Values: []string{"CUSTOMERS", "x]y"},
+			},
+		},
+		{
+			name:    "delimiter-in-json-wrapped-value",
+			code:    33,
+			message: `{"text": "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[x]y]", "other": "[z]"}`,
+			expected: MessageParameters{
+				Code:   33,
+				Named:  map[string]string{ParameterDataSource: "CUSTOMERS", ParameterRecordID: "x]y"},
+				Values: []string{"CUSTOMERS", "x]y"},
+			},
+		},
+		{
+			name:    "next-header",
+			code:    33,
+			message: "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001] SENZ0002|Invalid [z]",
+			expected: MessageParameters{
+				Code:   33,
+				Named:  map[string]string{ParameterDataSource: "CUSTOMERS", ParameterRecordID: "1001"},
+				Values: []string{"CUSTOMERS", "1001"},
+			},
+		},
+		{
+			name:    "whitespace",
+			code:    7511,
+			message: "7511E|Detected change in candidate entity[12].   Restarting ER evaluation.",
+			expected: MessageParameters{
+				Code:   7511,
+				Named:  map[string]string{ParameterEntityID: "12"},
+				Values: []string{"12"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			actual, ok := ParseParameters(testCase.code, testCase.message)
+			require.True(test, ok)
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestSzerror_ParseParameters_noMatch(test *testing.T) {
+	_, ok := ParseParameters(33, "SENZ0033|Something else entirely")
+	assert.False(test, ok)
+	_, ok = ParseParameters(-1, "-1E|Unknown code")
+	assert.False(test, ok)
+}
+
+func TestSzerror_ParseParameters_allTemplates(test *testing.T) {
+	for _, errorInfo := range Catalog() {
+		pattern, indexes := templatePattern(errorInfo.Code)
+		require.NotNil(test, pattern, errorInfo.Code)
+		message := errorInfo.Template
+		for index := range indexes {
+			message = strings.ReplaceAll(message, fmt.Sprintf("{%d}", index), fmt.Sprintf("value%d", index))
+		}
+		actual, ok := ParseParameters(errorInfo.Code, fmt.Sprintf("%04dE|%s", errorInfo.Code, message))
+		require.True(test, ok, "%d: %s", errorInfo.Code, errorInfo.Template)
+		for _, name := range parameterNames[errorInfo.Code] {
+			assert.Contains(test, actual.Named, name, errorInfo.Code)
+		}
+	}
+}
+
+func TestSzerror_Parameters(test *testing.T) {
+	err := fmt.Errorf("get record: %w", New(33, "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]"))
+	actual, ok := Parameters(err)
+	require.True(test, ok)
+	assert.Equal(test, "CUSTOMERS", actual.Get(ParameterDataSource))
+	assert.Equal(test, "1001", actual.Get(ParameterRecordID))
+	assert.Empty(test, actual.Get(ParameterEntityID))
+
+	var szError *SzError
+	require.ErrorAs(test, err, &szError)
+	assert.Equal(test, 33, szError.Code)
+	fromError, ok := szError.Parameters()
+	require.True(test, ok)
+	assert.Equal(test, actual, fromError)
+
+	plain := errors.New("SENZ0037|Unknown resolved entity value '12'")
+	actual, ok = Parameters(plain)
+	require.True(test, ok)
+	entityID, err := actual.Int64(ParameterEntityID)
+	require.NoError(test, err)
+	assert.Equal(test, int64(12), entityID)
+
+	_, ok = Parameters(nil)
+	assert.False(test, ok)
+	_, ok = Parameters(errors.New("plain"))
+	assert.False(test, ok)
+}
+
+func TestSzerror_MessageParameters_Int64s(test *testing.T) {
+	actual, ok := Parameters(New(10, "SENZ0010|Retry timeout exceeded RES_ENT_ID locklist [200001, 200002 200003]"))
+	require.True(test, ok)
+	entityIDs, err := actual.Int64s(ParameterEntityIDs)
+	require.NoError(test, err)
+	assert.Equal(test, []int64{200001, 200002, 200003}, entityIDs)
+
+	_, err = actual.Int64s(ParameterDataSource)
+	require.Error(test, err)
+	_, err = actual.Int64(ParameterDataSource)
+	require.Error(test, err)
+}
+
+func TestSzerror_SzError_Unwrap(test *testing.T) {
+	err := New(33, "SENZ0033|Unknown record")
+	assert.Equal(test, "\n\nSENZ0033|Unknown record", err.Error())
+	require.ErrorIs(test, err, ErrSzNotFound)
+	require.ErrorIs(test, err, ErrSzBadInput)
+	assert.NotErrorIs(test, err, ErrSzRetryable)
+	assert.Equal(test, "SENZ0033|Unknown record", New(-1, "SENZ0033|Unknown record").Error())
+}
@@ -0,0 +1,167 @@
+package szerror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - Problem
+// ----------------------------------------------------------------------------
+
+/*
+The Err method rebuilds the error a Problem describes, for clients of an HTTP API.
+The result matches the szerror sentinels of Types with errors.Is or, without Types, those assumed for Status.
+Its message is Detail.
+*/
+func (problem *Problem) Err() error {
+	if len(problem.Types) == 0 {
+		return FromHTTPStatus(problem.Status, problem.Detail)
+	}
+	typeIDs := []TypeIDs{}
+	for _, name := range problem.Types {
+		var typeID TypeIDs
+		if typeID.UnmarshalText([]byte(name)) == nil {
+			typeIDs = append(typeIDs, typeID)
+		}
+	}
+	return newFromTypeIDs(typeIDs, problem.Detail)
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The FromGRPCCode function returns an error with the szerror types a client assumes for a gRPC code,
+e.g. SzNotFound and SzBadInput for GRPCCodeNotFound. It returns nil for GRPCCodeOK.
+Codes with no corresponding type, e.g. GRPCCodeUnknown, give an error of no szerror type.
+
+Input
+  - code: The gRPC status code, as a uint32 of google.golang.org/grpc/codes.Code.
+  - message: The message to be returned by err.Error().
+*/
+func FromGRPCCode(code uint32, message string) error {
+	if code == GRPCCodeOK {
+		return nil
+	}
+	return newFromTypeIDs(grpcCodeTypes[code], message)
+}
+
+/*
+The FromHTTPStatus function returns an error with the szerror types a client assumes for an HTTP status,
+e.g. SzNotFound and SzBadInput for 404. It returns nil for statuses below 400.
+Statuses with no corresponding type give an error of no szerror type.
+
+Input
+  - status: The HTTP status code.
+  - message: The message to be returned by err.Error().
+*/
+func FromHTTPStatus(status int, message string) error {
+	if status < http.StatusBadRequest {
+		return nil
+	}
+	return newFromTypeIDs(httpStatusTypes[status], message)
+}
+
+/*
+The GRPCCode function returns the gRPC status code for an error, as a uint32 of google.golang.org/grpc/codes.Code.
+Context errors map to GRPCCodeCanceled and GRPCCodeDeadlineExceeded; other errors map by the first of their types
+in StatusPrecedence. Errors of no szerror type are GRPCCodeUnknown.
+
+Input
+  - err: The error returned by a Senzing method.
+*/
+func GRPCCode(err error) uint32 {
+	switch {
+	case err == nil:
+		return GRPCCodeOK
+	case errors.Is(err, context.Canceled):
+		return GRPCCodeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return GRPCCodeDeadlineExceeded
+	}
+	if mapping, ok := primary(err); ok {
+		return mapping.GRPCCode
+	}
+	return GRPCCodeUnknown
+}
+
+/*
+The HTTPStatus function returns the HTTP status for an error.
+Context errors map to 503 Service Unavailable and 504 Gateway Timeout; other errors map by the first of their types
+in StatusPrecedence. Errors of no szerror type are 500 Internal Server Error.
+
+Input
+  - err: The error returned by a Senzing method.
+*/
+func HTTPStatus(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+	if mapping, ok := primary(err); ok {
+		return mapping.HTTPStatus
+	}
+	return http.StatusInternalServerError
+}
+
+/*
+The NewProblem function returns the RFC 7807 problem details for an error, with Status HTTPStatus(err)
+Types naming the types of the error, most specific first, and Type naming the first of them. It returns nil for a nil error.
+
+Input
+  - err: The error returned by a Senzing method.
+*/
+func NewProblem(err error) *Problem {
+	if err == nil {
+		return nil
+	}
+	status := HTTPStatus(err)
+
+	// szerror sentinels have empty messages, so errors.Join leads the text with a newline for each.
+
+	result := &Problem{
+		Detail: strings.TrimLeft(err.Error(), "\n"),
+		Status: status,
+		Title:  http.StatusText(status),
+		Type:   "about:blank",
+	}
+	result.SenzingErrorCode = Code(result.Detail)
+	for _, typeID := range Types(err) {
+		result.Types = append(result.Types, typeID.String())
+	}
+	if len(result.Types) > 0 {
+		result.Type = ProblemTypeBase + result.Types[0]
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+func newFromTypeIDs(typeIDs []TypeIDs, message string) error {
+	result := []error{}
+	for _, typeID := range typeIDs {
+		result = append(result, mapErrorIDtoError(typeID))
+	}
+	result = append(result, errors.New(message))
+	return errors.Join(result...)
+}
+
+// The StatusMapping of the first type of err in StatusPrecedence.
+func primary(err error) (StatusMapping, bool) {
+	for _, mapping := range StatusPrecedence {
+		if errors.Is(err, SzErrorMap[mapping.TypeID]) {
+			return mapping, true
+		}
+	}
+	return StatusMapping{}, false
+}
@@ -0,0 +1,136 @@
+package szerror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzerror_HTTPStatus_GRPCCode(test *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedHTTP int
+		expectedCode uint32
+	}{
+		{name: "nil", err: nil, expectedHTTP: http.StatusOK, expectedCode: GRPCCodeOK},
+		{name: "not-found", err: New(33, "0033E|Unknown record"), expectedHTTP: http.StatusNotFound, expectedCode: GRPCCodeNotFound},
+		{name: "bad-input", err: New(2, "0002E|Invalid message"), expectedHTTP: http.StatusBadRequest, expectedCode: GRPCCodeInvalidArgument},
+		{name: "unknown-data-source", err: errors.Join(ErrSzUnknownDataSource, ErrSzBadInput, errors.New("2207E|Unknown data source")), expectedHTTP: http.StatusBadRequest, expectedCode: GRPCCodeInvalidArgument},
+		{name: "license", err: New(9000, "9000E|Maximum number of records ingested"), expectedHTTP: http.StatusForbidden, expectedCode: GRPCCodePermissionDenied},
+		{name: "configuration", err: New(14, "0014E|Invalid datastore configuration"), expectedHTTP: http.StatusInternalServerError, expectedCode: GRPCCodeFailedPrecondition},
+		{name: "not-initialized", err: New(48, "0048E|G2 is not initialized"), expectedHTTP: http.StatusServiceUnavailable, expectedCode: GRPCCodeFailedPrecondition},
+		{name: "connection-lost", err: New(1006, "1006E|Database Connection Failure"), expectedHTTP: http.StatusServiceUnavailable, expectedCode: GRPCCodeUnavailable},
+		{name: "retry-timeout", err: New(10, "0010E|Retry timeout exceeded"), expectedHTTP: http.StatusServiceUnavailable, expectedCode: GRPCCodeUnavailable},
+		{name: "database", err: New(1000, "1000E|Unhandled Database Error"), expectedHTTP: http.StatusInternalServerError, expectedCode: GRPCCodeInternal},
+		{name: "base", err: New(5, "0005E|Exceeded the maximum number of retries"), expectedHTTP: http.StatusInternalServerError, expectedCode: GRPCCodeUnknown},
+		{name: "unclassified", err: errors.New("plain"), expectedHTTP: http.StatusInternalServerError, expectedCode: GRPCCodeUnknown},
+		{name: "canceled", err: fmt.Errorf("call: %w", context.Canceled), expectedHTTP: http.StatusServiceUnavailable, expectedCode: GRPCCodeCanceled},
+		{name: "deadline", err: context.DeadlineExceeded, expectedHTTP: http.StatusGatewayTimeout, expectedCode: GRPCCodeDeadlineExceeded},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			assert.Equal(test, testCase.expectedHTTP, HTTPStatus(testCase.err))
+			assert.Equal(test, testCase.expectedCode, GRPCCode(testCase.err))
+		})
+	}
+}
+
+func TestSzerror_StatusPrecedence(test *testing.T) {
+	listed := map[TypeIDs]bool{}
+	for _, mapping := range StatusPrecedence {
+		assert.False(test, listed[mapping.TypeID], "%s listed twice", typeIDNames[mapping.TypeID])
+		listed[mapping.TypeID] = true
+	}
+	for _, typeID := range SzErrorTypesList {
+		assert.True(test, listed[typeID], "%s not listed", typeIDNames[typeID])
+		assert.NotEmpty(test, typeIDNames[typeID])
+	}
+}
+
+func TestSzerror_NewProblem(test *testing.T) {
+	assert.Nil(test, NewProblem(nil))
+
+	problem := NewProblem(New(33, "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]"))
+	assert.Equal(test, &Problem{
+		Detail:           "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]",
+		SenzingErrorCode: 33,
+		Status:           http.StatusNotFound,
+		Title:            "Not Found",
+		Type:             ProblemTypeBase + "SzNotFound",
+		Types:            []string{"SzNotFound", "SzBadInput"},
+	}, problem)
+
+	jsonBytes, err := json.Marshal(problem)
+	require.NoError(test, err)
+	parsed := &Problem{}
+	require.NoError(test, json.Unmarshal(jsonBytes, parsed))
+	assert.Equal(test, problem, parsed)
+
+	plain := NewProblem(errors.New("plain"))
+	assert.Equal(test, "about:blank", plain.Type)
+	assert.Empty(test, plain.Types)
+	assert.Equal(test, http.StatusInternalServerError, plain.Status)
+}
+
+func TestSzerror_Problem_Err(test *testing.T) {
+	for senzingErrorCode := range SzErrorTypes {
+		message := fmt.Sprintf("%04dE|Test message", senzingErrorCode)
+		original := New(senzingErrorCode, message)
+		rebuilt := NewProblem(original).Err()
+		for _, typeID := range SzErrorTypesList {
+			sentinel := SzErrorMap[typeID]
+			assert.Equal(test, errors.Is(original, sentinel), errors.Is(rebuilt, sentinel), "code %d type %d", senzingErrorCode, typeID)
+		}
+		assert.Equal(test, HTTPStatus(original), HTTPStatus(rebuilt))
+		assert.Equal(test, GRPCCode(original), GRPCCode(rebuilt))
+		assert.Equal(test, original.Error(), rebuilt.Error())
+	}
+
+	withoutTypes := &Problem{Status: http.StatusNotFound, Detail: "not found"}
+	require.ErrorIs(test, withoutTypes.Err(), ErrSzNotFound)
+	require.ErrorIs(test, withoutTypes.Err(), ErrSzBadInput)
+}
+
+func TestSzerror_FromHTTPStatus(test *testing.T) {
+	assert.NoError(test, FromHTTPStatus(http.StatusOK, "ok"))
+	assert.NoError(test, FromHTTPStatus(http.StatusNotModified, "not modified"))
+	for status, typeIDs := range httpStatusTypes {
+		err := FromHTTPStatus(status, "message")
+		assert.Equal(test, "message", err.Error()[len(typeIDs):])
+		for _, typeID := range typeIDs {
+			require.ErrorIs(test, err, SzErrorMap[typeID], status)
+		}
+		if status != http.StatusTooManyRequests {
+			assert.Equal(test, status, HTTPStatus(err), "inverse of %d", status)
+		}
+	}
+	teapot := FromHTTPStatus(http.StatusTeapot, "teapot")
+	assert.Equal(test, "teapot", teapot.Error())
+	assert.Equal(test, "about:blank", NewProblem(teapot).Type)
+}
+
+func TestSzerror_FromGRPCCode(test *testing.T) {
+	assert.NoError(test, FromGRPCCode(GRPCCodeOK, "ok"))
+	for code, typeIDs := range grpcCodeTypes {
+		err := FromGRPCCode(code, "message")
+		for _, typeID := range typeIDs {
+			require.ErrorIs(test, err, SzErrorMap[typeID], code)
+		}
+		if code != GRPCCodeResourceExhausted {
+			assert.Equal(test, code, GRPCCode(err), "inverse of %d", code)
+		}
+	}
+	require.ErrorIs(test, FromGRPCCode(GRPCCodeUnavailable, "unavailable"), ErrSzRetryable)
+	assert.Equal(test, GRPCCodeUnknown, GRPCCode(FromGRPCCode(GRPCCodeUnknown, "unknown")))
+}
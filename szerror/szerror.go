@@ -1,12 +1,34 @@
 package szerror
 
 import (
-	"errors"
-	"regexp"
-	"strconv"
 	"strings"
 )
 
+// ----------------------------------------------------------------------------
+// Methods - SzError
+// ----------------------------------------------------------------------------
+
+// The Error method returns the message, led by a newline for each szerror type of the code, as errors.Join does.
+func (err *SzError) Error() string {
+	return err.joined.Error()
+}
+
+/*
+The Parameters method returns the values the message fills into the template of the code.
+See ParseParameters.
+*/
+func (err *SzError) Parameters() (MessageParameters, bool) {
+	return ParseParameters(err.Code, err.Message)
+}
+
+// The Unwrap method returns the szerror sentinels of the code and an error with the message.
+func (err *SzError) Unwrap() []error {
+	if joined, ok := err.joined.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err.joined}
+}
+
 // ----------------------------------------------------------------------------
 // Private Functions
 // ----------------------------------------------------------------------------
@@ -30,58 +52,48 @@ func mapErrorIDtoError(errorTypeID TypeIDs) error {
 // ----------------------------------------------------------------------------
 
 /*
-The Message function returns the string value from the Senzing error message.
+The Message function returns the message of a Senzing exception string: all the text after its header.
+It is ParseException(senzingErrorMessage).Message; for text with no header, it is the text after the first "|", if any.
 
 Input
   - senzingErrorMessage: The message returned from Senzing's G2xxx_getLastException message.
 */
 func Message(senzingErrorMessage string) string {
-	result := ""
-	splits := strings.Split(senzingErrorMessage, "|")
-	if len(splits) > 1 {
-		result = strings.TrimSpace(splits[1])
+	if exception, ok := ParseException(senzingErrorMessage); ok {
+		return exception.Message
 	}
-	return result
+	if _, after, found := strings.Cut(senzingErrorMessage, "|"); found {
+		return strings.TrimSpace(after)
+	}
+	return ""
 }
 
 /*
 The Code function returns the integer error code value from the Senzing error message.
+It is ParseException(senzingErrorMessage).Code, or 0 if the text holds no exception header.
 Example Senzing error message: "0037E|Unknown resolved entity value '-4'"
 
 Input
   - senzingErrorMessage: The message returned from Senzing's G2xxx_getLastException message.
 */
 func Code(senzingErrorMessage string) int {
-	result := 0
-	if strings.Contains(senzingErrorMessage, "|") {
-		splits := strings.Split(senzingErrorMessage, "|")
-
-		// Make a Regex to say we only want numbers.
-
-		regularExpression := regexp.MustCompile("[^0-9]+")
-		numericOnlyString := regularExpression.ReplaceAllString(splits[0], "")
-		result, err := strconv.Atoi(numericOnlyString)
-		if err == nil {
-			return result
-		}
+	if exception, ok := ParseException(senzingErrorMessage); ok {
+		return exception.Code
 	}
-	return result
+	return 0
 }
 
 /*
-The New function returns the integer error code value from the Senzing error message.
+The New function returns an *SzError for a Senzing error code, matching the szerror sentinels of the code.
 
 Input
   - senzingErrorCode: The error integer extracted from Senzing's G2xxx_getLastException message.
   - message: The message to be returned by err.Error().
 */
 func New(senzingErrorCode int, message string) error {
-	result := []error{}
-	if errorTypeIDs, ok := SzErrorTypes[senzingErrorCode]; ok {
-		for _, errorTypeID := range errorTypeIDs {
-			result = append(result, mapErrorIDtoError(errorTypeID))
-		}
+	return &SzError{
+		Code:    senzingErrorCode,
+		Message: message,
+		joined:  newFromTypeIDs(SzErrorTypes[senzingErrorCode], message),
 	}
-	result = append(result, errors.New(message))
-	return errors.Join(result...)
 }
@@ -28,3 +28,45 @@ func ExampleNew() {
 	fmt.Println(err)
 	// Output: {"messageId": 1}
 }
+
+func ExampleHTTPStatus() {
+	senzingErrorMessage := "33E|Test message" // Example message from Senzing Szengine.
+	err := New(Code(senzingErrorMessage), senzingErrorMessage)
+	fmt.Println(HTTPStatus(err), GRPCCode(err))
+	// Output: 404 5
+}
+
+func ExampleNewProblem() {
+	senzingErrorMessage := "33E|Test message" // Example message from Senzing Szengine.
+	problem := NewProblem(New(Code(senzingErrorMessage), senzingErrorMessage))
+	fmt.Println(problem.Type, problem.Types)
+	// Output: urn:senzing:error:SzNotFound [SzNotFound SzBadInput]
+}
+
+func ExampleClassify() {
+	senzingErrorMessage := "1006E|Database Connection Failure" // Example message from Senzing Szengine.
+	err := fmt.Errorf("add record: %w", New(Code(senzingErrorMessage), senzingErrorMessage))
+	fmt.Println(Classify(err), Types(err), IsRetryable(err))
+	// Output: Retryable [SzDatabaseConnectionLost SzRetryable] true
+}
+
+func ExampleLookup() {
+	errorInfo, ok := Lookup(33)
+	fmt.Println(ok, errorInfo.Name, errorInfo.Template)
+	// Output: true EAS_ERR_UNKNOWN_DSRC_RECORD_ID Unknown record: dsrc[{0}], record[{1}]
+}
+
+func ExampleParameters() {
+	senzingErrorMessage := "SENZ0033|Unknown record: dsrc[CUSTOMERS], record[1001]" // Example message from Senzing Szengine.
+	err := New(Code(senzingErrorMessage), senzingErrorMessage)
+	parameters, ok := Parameters(err)
+	fmt.Println(ok, parameters.Get(ParameterDataSource), parameters.Get(ParameterRecordID))
+	// Output: true CUSTOMERS 1001
+}
+
+func ExampleParseException() {
+	senzingErrorMessage := "0002E|Invalid message: field|value" // Example message from Senzing Szengine.
+	exception, ok := ParseException(senzingErrorMessage)
+	fmt.Println(ok, exception.Code, exception.Severity, exception.Message)
+	// Output: true 2 error Invalid message: field|value
+}
@@ -0,0 +1,435 @@
+// Code generated by cmd/szerrorcatalog from szerrortypes.go. DO NOT EDIT.
+
+package szerror
+
+// The symbolic name, message template and component of each Senzing error code.
+var szErrorCatalog = map[int]ErrorInfo{
+	2:    {Code: 2, Component: "Engine", Name: "EAS_ERR_INVALID_MESSAGE", Template: "Invalid Message"},
+	5:    {Code: 5, Component: "Engine", Name: "EAS_ERR_EXCEEDED_MAX_RETRIES", Template: "Exceeded the Maximum Number of Retries Allowed"},
+	7:    {Code: 7, Component: "Engine", Name: "EAS_ERR_EMPTY_MESSAGE", Template: "Empty Message"},
+	10:   {Code: 10, Component: "Engine", Name: "EAS_ERR_RETRY_TIMEOUT", Template: "Retry timeout exceeded RES_ENT_ID locklist [{0}]"},
+	14:   {Code: 14, Component: "Database", Name: "EAS_ERR_INVALID_DATASTORE_CONFIGURATION_TYPE", Template: "Invalid Datastore Configuration Type"},
+	19:   {Code: 19, Component: "Configuration", Name: "EAS_ERR_NO_CONFIGURATION_FOUND", Template: "Configuration not found"},
+	20:   {Code: 20, Component: "Database", Name: "EAS_ERR_CONFIG_CANNOT_BE_NULL_DATABASE", Template: "Configuration cannot be loaded from database connection"},
+	21:   {Code: 21, Component: "Configuration", Name: "EAS_ERR_CONFIG_CANNOT_BE_NULL_CONFIG_FILE", Template: "Configuration cannot be loaded from config file"},
+	22:   {Code: 22, Component: "Engine", Name: "EAS_ERR_INVALID_DOCTYPE", Template: "Invalid DocType {0}"},
+	23:   {Code: 23, Component: "Engine", Name: "EAS_ERR_CONFLICTING_DATA_SOURCE_VALUES", Template: "Conflicting DATA_SOURCE values '{0}' and '{1}'"},
+	24:   {Code: 24, Component: "Engine", Name: "EAS_ERR_CONFLICTING_RECORD_ID_VALUES", Template: "Conflicting RECORD_ID values '{0}' and '{1}'"},
+	26:   {Code: 26, Component: "Engine", Name: "EAS_ERR_RESERVED_WORD_USED_IN_DOCUMENT", Template: "Inbound data contains a reserved keyword '{0}'"},
+	28:   {Code: 28, Component: "Configuration", Name: "EAS_ERR_INVALID_JSON_CONFIG_DOCUMENT", Template: "Invalid JSON config document"},
+	29:   {Code: 29, Component: "Engine", Name: "EAS_ERR_INVALID_HANDLE", Template: "Invalid Handle"},
+	30:   {Code: 30, Component: "Configuration", Name: "EAS_ERR_INVALID_MATCH_LEVEL", Template: "Invalid match level '{0}'"},
+	33:   {Code: 33, Component: "Engine", Name: "EAS_ERR_UNKNOWN_DSRC_RECORD_ID", Template: "Unknown record: dsrc[{0}], record[{1}]"},
+	34:   {Code: 34, Component: "Configuration", Name: "EAS_ERR_AMBIGUOUS_ENTITY_FTYPE_MISSING", Template: "AMBIGUOUS_ENTITY Feature Type is not configured"},
+	35:   {Code: 35, Component: "Configuration", Name: "EAS_ERR_AMBIGUOUS_TIER_FELEM_MISSING", Template: "AMBIGUOUS_TIER Feature Element is not configured"},
+	36:   {Code: 36, Component: "Configuration", Name: "EAS_ERR_AMBIGUOUS_FTYPE_ID_FELEM_MISSING", Template: "AMBIGUOUS_FTYPE_ID Feature Element is not configured"},
+	37:   {Code: 37, Component: "Engine", Name: "EAS_ERR_UNKNOWN_RESOLVED_ENTITY_VALUE", Template: "Unknown resolved entity value '{0}'"},
+	38:   {Code: 38, Component: "Engine", Name: "EAS_ERR_RECORD_HAS_NO_RESOLVED_ENTITY", Template: "Data source record has no resolved entity: dsrc[{0}], recordID[{1}]"},
+	39:   {Code: 39, Component: "Engine", Name: "EAS_ERR_NO_OBSERVED_ENTITY_FOR_DSRC_ENTITY_KEY", Template: "No observed entity for entity key: dsrc[{0}], record_id[{1}], key[{2}]"},
+	40:   {Code: 40, Component: "Configuration", Name: "EAS_ERR_CONFIG_COMPATIBILITY_MISMATCH", Template: "The engine configuration compatibility version [{0}] does not match the version of the provided config[{1}]."},
+	41:   {Code: 41, Component: "Engine", Name: "EAS_ERR_DOCUMENT_PREPROCESSING_FAILED", Template: "Document preprocessing failed"},
+	42:   {Code: 42, Component: "Engine", Name: "EAS_ERR_DOCUMENT_LOAD_PROCESSING_FAILED", Template: "Document load processing failed"},
+	43:   {Code: 43, Component: "Engine", Name: "EAS_ERR_DOCUMENT_ER_PROCESSING_FAILED", Template: "Document ER processing failed"},
+	44:   {Code: 44, Component: "Engine", Name: "EAS_ERR_CHECK_ENTITY_PROCESSING_FAILED", Template: "Check entity processing failed"},
+	45:   {Code: 45, Component: "Engine", Name: "EAS_ERR_INPUT_PROCEDURE_PROCESSING_FAILED", Template: "Input procedure processing failed"},
+	46:   {Code: 46, Component: "Engine", Name: "EAS_ERR_DOCUMENT_HASHING_PROCESSING_FAILED", Template: "Document hashing-processing failed"},
+	47:   {Code: 47, Component: "Engine", Name: "EAS_ERR_SESSION_IS_INVALID", Template: "Session is invalid"},
+	48:   {Code: 48, Component: "Engine", Name: "EAS_ERR_G2_NOT_INITIALIZED", Template: "G2 is not initialized"},
+	49:   {Code: 49, Component: "Engine", Name: "EAS_ERR_G2AUDIT_NOT_INITIALIZED", Template: "G2Audit is not initialized"},
+	50:   {Code: 50, Component: "Hashing", Name: "EAS_ERR_G2HASHER_NOT_INITIALIZED", Template: "G2Hasher is not initialized"},
+	51:   {Code: 51, Component: "Engine", Name: "EAS_ERR_BOTH_RECORD_ID_AND_ENT_SRC_KEY_SPECIFIED", Template: "Cannot use both Record ID and Entity Source Key in record"},
+	52:   {Code: 52, Component: "Engine", Name: "EAS_ERR_UNKNOWN_RELATIONSHIP_ID_VALUE", Template: "Unknown relationship ID value '{0}'"},
+	53:   {Code: 53, Component: "Engine", Name: "EAS_ERR_G2DIAGNOSTIC_NOT_INITIALIZED", Template: "G2Diagnostic is not initialized"},
+	54:   {Code: 54, Component: "Engine", Name: "EAS_ERR_G2_DATA_REPOSITORY_WAS_PURGED", Template: "Data repository was purged"},
+	55:   {Code: 55, Component: "Engine", Name: "EAS_ERR_NO_RESOLVED_ENTITY_FOR_DSRC_ENTITY_KEY", Template: "No resolved entity for entity key: dsrc[{0}], record_id[{1}], key[{2}]"},
+	56:   {Code: 56, Component: "Engine", Name: "EAS_ERR_NO_RECORDS_EXIST_FOR_RESOLVED_ENTITY", Template: "No data source records exist for entity ID: entityID[{0}]"},
+	57:   {Code: 57, Component: "Engine", Name: "EAS_ERR_UNKNOWN_FEATURE_ID_VALUE", Template: "Unknown feature ID value '{0}'"},
+	58:   {Code: 58, Component: "Engine", Name: "EAS_ERR_G2_INITIALIZATION_FAILURE", Template: "G2 initialization process has failed"},
+	60:   {Code: 60, Component: "Database", Name: "EAS_ERR_CONFIG_DATABASE_MISMATCH", Template: "The engine configuration does not match the records loaded into the repository:  errors[{0}]."},
+	61:   {Code: 61, Component: "Configuration", Name: "EAS_ERR_AMBIGUOUS_SUPPRESSED_LIBFEAT_FELEM_MISSING", Template: "AMBIGUOUS_SUPRESSED_LIBFEAT Feature Element is not configured"},
+	62:   {Code: 62, Component: "Configuration", Name: "EAS_ERR_AMBIGUOUS_TYPE_FELEM_MISSING", Template: "AMBIGUOUS_TYPE Feature Element is not configured"},
+	63:   {Code: 63, Component: "Engine", Name: "EAS_ERR_G2CONFIGMGR_NOT_INITIALIZED", Template: "G2ConfigMgr is not initialized"},
+	64:   {Code: 64, Component: "Configuration", Name: "EAS_ERR_CONFUSED_ENTITY_FTYPE_MISSING", Template: "CONFUSED_ENTITY Feature Type is not configured"},
+	66:   {Code: 66, Component: "Engine", Name: "EAS_ERR_UNKNOWN_GENERIC_PLAN_VALUE", Template: "Unknown generic plan value '{0}'"},
+	67:   {Code: 67, Component: "Configuration", Name: "EAS_ERR_INVALID_GENERIC_PLAN_VALUE", Template: "Invalid Generic Plan ID [{0}] configured for the '{1}' retention level.'"},
+	68:   {Code: 68, Component: "Engine", Name: "EAS_ERR_UNKNOWN_ER_RESULT", Template: "Unknown ER-result."},
+	69:   {Code: 69, Component: "Engine", Name: "EAS_ERR_NO_CANDIDATES", Template: "No candidates."},
+	76:   {Code: 76, Component: "Engine", Name: "EAS_ERR_INBOUND_FEATURE_VERSION_NEWER_THAN_CONFIG", Template: "Inbound Feature Version [{0}] is newer than configured version [{1}] for feature type[{2}]."},
+	77:   {Code: 77, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_PRIMING_GNR", Template: "Error when priming GNR resources '{0}'"},
+	78:   {Code: 78, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_ENCRYPTING", Template: "Error when encrypting '{0}'"},
+	79:   {Code: 79, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_DECRYPTING", Template: "Error when decrypting '{0}'"},
+	80:   {Code: 80, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_VALIDATING_ENCRYPTION_SIGNATURE_COMPATIBILITY", Template: "Error when validating encryption signature compatibility '{0}'"},
+	81:   {Code: 81, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_CHECKING_DISTINCT_FEATURE_GENERALIZATION", Template: "Error when checking distinct feature generalization '{0}'"},
+	82:   {Code: 82, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_RUNNING_DQM", Template: "Error when running DQM '{0}'"},
+	83:   {Code: 83, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_CREATING_EFEATS", Template: "Error when creating EFEATS '{0}'"},
+	84:   {Code: 84, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_SIMPLE_SCORING", Template: "Error when simple scoring '{0}'"},
+	85:   {Code: 85, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_SCORING_PAIR", Template: "Error when scoring a pair '{0}'"},
+	86:   {Code: 86, Component: "Engine", Name: "EAS_ERR_ERROR_WHEN_SCORING_SET", Template: "Error when scoring a set '{0}'"},
+	87:   {Code: 87, Component: "Engine", Name: "EAS_ERR_SRD_EXCEPTION", Template: "SRD Exception '{0}'"},
+	88:   {Code: 88, Component: "Engine", Name: "EAS_ERR_UNKNOWN_SEARCH_PROFILE_VALUE", Template: "Unknown search profile value '{0}'"},
+	89:   {Code: 89, Component: "Configuration", Name: "EAS_ERR_MISCONFIGURED_SEARCH_PROFILE_VALUE", Template: "Misconfigured search profile value '{0}'"},
+	90:   {Code: 90, Component: "Database", Name: "EAS_ERR_CANNOT_ADD_LIBRARY_FEATURES_TO_DATASTORE", Template: "Cannot add library features to datastore:  '{0}'"},
+	91:   {Code: 91, Component: "Engine", Name: "EAS_ERR_TRUSTED_ID_FTYPE_MISSING", Template: "TRUSTED_ID Feature Type is not configured"},
+	92:   {Code: 92, Component: "Engine", Name: "EAS_ERR_RECORD_TYPE_FTYPE_MISSING", Template: "RECORD_TYPE Feature Type is not configured"},
+	999:  {Code: 999, Component: "License", Name: "EAS_ERR_LICENSE_HAS_EXPIRED", Template: "License has expired"},
+	1000: {Code: 1000, Component: "Database", Name: "EAS_ERR_UNHANDLED_DATABASE_ERROR", Template: "Unhandled Database Error '{0}'"},
+	1001: {Code: 1001, Component: "Database", Name: "EAS_ERR_CRITICAL_DATABASE_ERROR", Template: "Critical Database Error '{0}'"},
+	1002: {Code: 1002, Component: "Database", Name: "EAS_ERR_DATABASE_MEMORY_ERROR", Template: "Database Memory Error '{0}'"},
+	1003: {Code: 1003, Component: "Database", Name: "EAS_ERR_TABLE_SPACE_OR_LOG_VIOLATION", Template: "Table Space or Log Violation '{0}'"},
+	1004: {Code: 1004, Component: "Database", Name: "EAS_ERR_RESOURCE_CONTENTION", Template: "Resource Contention '{0}'"},
+	1005: {Code: 1005, Component: "Database", Name: "EAS_ERR_USER_DEFINED_PROC_ERROR", Template: "User Defined Procedure or Function Error '{0}'"},
+	1006: {Code: 1006, Component: "Database", Name: "EAS_ERR_DATABASE_CONNECTION_FAILURE", Template: "Database Connection Failure '{0}'"},
+	1007: {Code: 1007, Component: "Database", Name: "EAS_ERR_DATABASE_CONNECTION_LOST", Template: "Database Connection Lost '{0}'"},
+	1008: {Code: 1008, Component: "Database", Name: "EAS_ERR_DEADLOCK_ERROR", Template: "Deadlock Error '{0}'"},
+	1009: {Code: 1009, Component: "Database", Name: "EAS_ERR_INSUFFICIENT_PERMISSIONS", Template: "Insufficient Permissions '{0}'"},
+	1010: {Code: 1010, Component: "Database", Name: "EAS_ERR_TRANSACTION_ERROR", Template: "Transaction Error '{0}'"},
+	1011: {Code: 1011, Component: "Database", Name: "EAS_ERR_UNIQUE_CONSTRAINT_VIOLATION", Template: "Unique Constraint Violation '{0}'"},
+	1012: {Code: 1012, Component: "Database", Name: "EAS_ERR_CONSTRAINT_VIOLATION", Template: "Constraint Violation '{0}'"},
+	1013: {Code: 1013, Component: "Database", Name: "EAS_ERR_SYNTAX_ERROR", Template: "Syntax Error '{0}'"},
+	1014: {Code: 1014, Component: "Database", Name: "EAS_ERR_CURSOR_ERROR", Template: "Cursor Error '{0}'"},
+	1015: {Code: 1015, Component: "Database", Name: "EAS_ERR_DATATYPE_ERROR", Template: "Data Type Error '{0}'"},
+	1016: {Code: 1016, Component: "Database", Name: "EAS_ERR_TRANSACTION_ABORTED_ERROR", Template: "Transaction Aborted '{0}'"},
+	1017: {Code: 1017, Component: "Database", Name: "EAS_ERR_DATABASE_OPERATOR_NOT_SET", Template: "Database operator not set '{0}'"},
+	1018: {Code: 1018, Component: "Database", Name: "EAS_ERR_DATABASE_EXCEPTION_GENERATOR_NOT_SET", Template: "Database exception generator not set '{0}'"},
+	1019: {Code: 1019, Component: "Database", Name: "EAS_ERR_DATABASE_SCHEMA_TABLES_NOT_FOUND", Template: "Datastore schema tables not found. [{0}]"},
+	2001: {Code: 2001, Component: "Configuration", Name: "EAS_ERR_FEATURE_HAS_NO_FTYPE_CODE", Template: "Cannot process feature with no FTYPE_CODE[{0}]"},
+	2002: {Code: 2002, Component: "Engine", Name: "EAS_ERR_REQUESTED_CONFIG_FOR_INVALID_FTYPE_CODE", Template: "Requested config for invalid FTYPE_CODE[{0}]"},
+	2003: {Code: 2003, Component: "Engine", Name: "EAS_ERR_NO_FELEM_CODE", Template: "Cannot process OBS_FELEM with no FELEM_CODE[{0}]"},
+	2005: {Code: 2005, Component: "Engine", Name: "EAS_ERR_INVALID_FELEM_CODE", Template: "FELEM_CODE[{0}] is not configured for FTYPE_CODE[{1}]"},
+	2006: {Code: 2006, Component: "Engine", Name: "EAS_ERR_MISSING_ENT_SRC_KEY", Template: "OBS_ENT is missing ENT_SRC_KEY"},
+	2012: {Code: 2012, Component: "Configuration", Name: "EAS_ERR_ERRULE_CONFIGURED_FOR_RESOLVE_AND_RELATE", Template: "ER Rule [{0}] is configured for both resolve and relate."},
+	2015: {Code: 2015, Component: "Configuration", Name: "EAS_ERR_INVALID_FTYPE_CODE", Template: "Invalid FTYPE_CODE[{0}]"},
+	2027: {Code: 2027, Component: "Engine", Name: "EAS_ERR_PLUGIN_INIT", Template: "Plugin initialization error {0}"},
+	2029: {Code: 2029, Component: "Configuration", Name: "EAS_ERR_REQUESTED_CONFIG_FOR_INVALID_PLUGIN", Template: "Configuration not found for plugin type: {0}"},
+	2034: {Code: 2034, Component: "Configuration", Name: "EAS_ERR_INVALID_CFRTN_VAL", Template: "CFRTN_ID[{0}]/FTYPE[{1}] is expecting CFRTN_VAL[{2}] which is not offered by CFUNC_ID[{3}][{4}]. Available scores are [{5}]"},
+	2036: {Code: 2036, Component: "Configuration", Name: "EAS_ERR_FTYPE_HAS_NO_BOM", Template: "FType configured with no Feature Elements (Bill of Materials)  FTYPE_ID[{0}] FTYPE_CODE[{1}]"},
+	2037: {Code: 2037, Component: "Configuration", Name: "EAS_ERR_FUNC_CALL_HAS_NO_BOM", Template: "Function call ({3}) configured with no Bill of Materials  {4}[{0}] FTYPE_ID[{1}] FTYPE_CODE[{2}]"},
+	2038: {Code: 2038, Component: "Configuration", Name: "EAS_ERR_DISTINCT_FEATURE_HAS_NO_BOM", Template: "Distinct feature call configured with no Bill of Materials  DFCALL_ID[{0}]"},
+	2041: {Code: 2041, Component: "Configuration", Name: "EAS_ERR_EFCALL_HAS_NO_BOM", Template: "EFeature creation call configured with no Bill of Materials  EFCALL_ID[{0}]"},
+	2045: {Code: 2045, Component: "Configuration", Name: "EAS_ERR_CFRTN_REFERS_BAD_CFUNC_ID", Template: "CFG_CFRTN references CFUNC_ID[{0}] which is not configured"},
+	2047: {Code: 2047, Component: "Configuration", Name: "EAS_ERR_MISSING_DSRC_CODE", Template: "Observation is missing DSRC_CODE tag which is required"},
+	2048: {Code: 2048, Component: "Configuration", Name: "EAS_ERR_FEAT_FREQ_INVALID", Template: "FEATURE CODE[{0}] FEATURE FREQUENCY[{1}] is an invalid frequency"},
+	2049: {Code: 2049, Component: "Configuration", Name: "EAS_ERR_FUNC_INVALID", Template: "{2} [{0}] is invalid for {3}[{1}]"},
+	2050: {Code: 2050, Component: "Configuration", Name: "EAS_ERR_QUAL_FRAG_NOT_FOUND", Template: "Rule[{0}] Qualifier Fragment[{1}]: Fragment not found"},
+	2051: {Code: 2051, Component: "Configuration", Name: "EAS_ERR_DISQUAL_FRAG_NOT_FOUND", Template: "Rule[{0}] Disqualifier Fragment[{1}]: Fragment not found"},
+	2057: {Code: 2057, Component: "Engine", Name: "EAS_ERR_BAD_DSRC_ACTION", Template: "Observation has DSRC_ACTION[{0}] which is invalid.  Valid values are [A]dd, [C]hange, [D]elete or E[X]tensive Evaluation"},
+	2061: {Code: 2061, Component: "Configuration", Name: "EAS_ERR_DUPLICATE_LOOKUP_IDENTIFIER", Template: "Duplicate [{0}] with identifier value [{1}].  Only unique values are allowed."},
+	2062: {Code: 2062, Component: "Configuration", Name: "EAS_ERR_INVALID_LOOKUP_IDENTIFIER", Template: "Requested lookup of [{0}] using unknown value [{1}].  Value not found."},
+	2065: {Code: 2065, Component: "Configuration", Name: "EAS_ERR_FTYPE_HAS_MULTIPLE_DEFINITIONS", Template: "FType configured with multiple definitions. FTYPE_CODE[{0}] used in FTYPE_ID[{1}] and FTYPE_ID[{2}]"},
+	2066: {Code: 2066, Component: "Configuration", Name: "EAS_ERR_FELEM_HAS_MULTIPLE_DEFINITIONS", Template: "FElem configured with multiple definitions. FELEM_CODE[{0}] used in FELEM_ID[{1}] and FELEM_ID[{2}]"},
+	2067: {Code: 2067, Component: "Configuration", Name: "EAS_ERR_ERFRAG_HAS_MULTIPLE_DEFINITIONS", Template: "ER Fragment code configured with multiple definitions. ERFRAG_CODE[{0}] used in ERFRAG_ID[{1}] and ERFRAG_ID[{2}]"},
+	2069: {Code: 2069, Component: "Configuration", Name: "EAS_ERR_BOM_CONFIG_INVALID_FOR_SIMPLE_PLUGIN", Template: "Configured plugin for CFCALL_ID[{0}] requires exactly one value in BOM"},
+	2070: {Code: 2070, Component: "Configuration", Name: "EAS_ERR_EFCALL_HAS_INVALID_FUNCTION", Template: "EFeature creation call configured with invalid function ID EFCALL_ID[{0}] EFUNC_ID[{1}]"},
+	2071: {Code: 2071, Component: "Configuration", Name: "EAS_ERR_EFBOM_HAS_INVALID_EFCALL", Template: "EFeature BOM configured with invalid EFCALL_ID[{0}]"},
+	2073: {Code: 2073, Component: "Engine", Name: "EAS_ERR_LOADING_LIBRARY", Template: "Library loading error {0}"},
+	2074: {Code: 2074, Component: "Engine", Name: "EAS_ERR_SCORING_MANAGER_PLUGIN", Template: "Scoring manager: id {0} and {1} do not match"},
+	2075: {Code: 2075, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_FTYPE_CODE", Template: "Table {0} configured with an invalid type FTYPE_CODE[{1}]"},
+	2076: {Code: 2076, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_FELEM_CODE", Template: "Table {0} configured with an invalid type FELEM_CODE[{1}]"},
+	2079: {Code: 2079, Component: "Configuration", Name: "EAS_ERR_EFBOM_CONFIGURED_WITH_INVALID_FTYPE_ID", Template: "CFG_EFBOM configured with an invalid type FTYPE_ID[{0}]"},
+	2080: {Code: 2080, Component: "Configuration", Name: "EAS_ERR_EFBOM_CONFIGURED_WITH_INVALID_FELEM_ID", Template: "CFG_EFBOM configured with an invalid type FELEM_ID[{0}]"},
+	2081: {Code: 2081, Component: "Configuration", Name: "EAS_ERR_FUNC_CALL_CONFIGURED_WITH_INVALID_FTYPE_ID", Template: "{1} configured with an invalid type FTYPE_ID[{0}]"},
+	2082: {Code: 2082, Component: "Configuration", Name: "EAS_ERR_FUNC_CALL_CONFIGURED_WITH_INVALID_FUNC_ID", Template: "{1} configured with an invalid type {2}[{0}]"},
+	2083: {Code: 2083, Component: "Configuration", Name: "EAS_ERR_FUNC_BOM_CONFIGURED_WITH_INVALID_FTYPE_ID", Template: "{1} configured with an invalid type FTYPE_ID[{0}]"},
+	2084: {Code: 2084, Component: "Configuration", Name: "EAS_ERR_FUNC_BOM_CONFIGURED_WITH_INVALID_FELEM_ID", Template: "{1} configured with an invalid type FELEM_ID[{0}]"},
+	2088: {Code: 2088, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_RCLASS_ID", Template: "Table {0} configured with an invalid RCLASS_ID[{1}]"},
+	2089: {Code: 2089, Component: "Configuration", Name: "EAS_ERR_UNKNOWN_FCLASS_ID", Template: "UNKNOWN FCLASS ID[{0}]"},
+	2090: {Code: 2090, Component: "Configuration", Name: "EAS_ERR_SFCALL_HAS_INVALID_FUNCTION", Template: "Feature standardization call configured with invalid function ID SFCALL_ID[{0}] SFUNC_ID[{1}]"},
+	2091: {Code: 2091, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_BOTH_FTYPE_ID_AND_FELEM_ID", Template: "{0} configured with both an FTYPE_ID[{1}] and FELEM_ID[{2}]"},
+	2092: {Code: 2092, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_NEITHER_FTYPE_ID_NOR_FELEM_ID", Template: "{0} configured with neither an FTYPE_ID nor an FELEM_ID"},
+	2093: {Code: 2093, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_DUPLICATE_EXEC_ORDER_FOR_IDENTIFIER_LIST", Template: "Table [{0}] configured with duplicate execution order value [{3}] for identifiers[{1}] with values [{2}]"},
+	2094: {Code: 2094, Component: "Configuration", Name: "EAS_ERR_DUPLICATE_VALUE_FOR_FIELD_IN_TABLE", Template: "Duplicate value [{2}] of field [{1}] in config [{0}]"},
+	2095: {Code: 2095, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_FTYPE_CODE_FELEM_CODE_PAIR", Template: "Table {0} configured with an invalid FTYPE_CODE[{1}]/FELEM_CODE[{2}] pair"},
+	2099: {Code: 2099, Component: "Configuration", Name: "EAS_ERR_COUNTER_CONFIG_INVALID_THRESHOLD", Template: "Next Threshold for a counter should be no less than 10, but has NEXT_THRESH{0}"},
+	2101: {Code: 2101, Component: "Configuration", Name: "EAS_ERR_XPATH_OP_UNSUPPORTED", Template: "XPath operation unsupported [{0}]"},
+	2102: {Code: 2102, Component: "Configuration", Name: "EAS_ERR_XPATH_AXIS_UNSUPPORTED", Template: "XPath axis unsupported [{0}]"},
+	2103: {Code: 2103, Component: "Configuration", Name: "EAS_ERR_XPATH_TEST_UNSUPPORTED", Template: "XPath test unsupported [{0}]"},
+	2104: {Code: 2104, Component: "Configuration", Name: "EAS_ERR_XPATH_TYPE_UNSUPPORTED", Template: "XPath type unsupported [{0}]"},
+	2105: {Code: 2105, Component: "Configuration", Name: "EAS_ERR_XPATH_NODE_PREFIX_UNSUPPORTED", Template: "XPath node prefix unsupported [{0}]"},
+	2106: {Code: 2106, Component: "Configuration", Name: "EAS_ERR_XPATH_NODE_NAME_UNSUPPORTED", Template: "XPath node name unsupported position[{0}], name[{1}]"},
+	2107: {Code: 2107, Component: "Configuration", Name: "EAS_ERR_XPATH_BEHAVIOR_TYPE_UNSUPPORTED", Template: "XPath behavior type unsupported [{0}]"},
+	2108: {Code: 2108, Component: "Configuration", Name: "EAS_ERR_XPATH_BUCKET_UNSUPPORTED", Template: "XPath bucket type unsupported [{0}]"},
+	2109: {Code: 2109, Component: "Configuration", Name: "EAS_ERR_XPATH_VALUE_TYPE_UNSUPPORTED", Template: "XPath value type unsupported [{0}]"},
+	2110: {Code: 2110, Component: "Configuration", Name: "EAS_ERR_XPATH_PLUS_TYPE_UNSUPPORTED", Template: "XPath plus operand type unsupported [{0}]"},
+	2111: {Code: 2111, Component: "Configuration", Name: "EAS_ERR_XPATH_FRAGMENT_NOT_EVALUATED", Template: "XPath fragment not evaluated[{0}]"},
+	2112: {Code: 2112, Component: "Configuration", Name: "EAS_ERR_XPATH_FRAGMENT_NOT_CONFIGURED", Template: "XPath fragment not configured[{0}]"},
+	2113: {Code: 2113, Component: "Configuration", Name: "EAS_ERR_XPATH_FUNCTION_UNSUPPORTED", Template: "XPath function unsupported [{0}]"},
+	2114: {Code: 2114, Component: "Configuration", Name: "EAS_ERR_INVALID_FTYPE_SCORESET", Template: "Cannot set score for invalid FTYPE_ID [{0}]"},
+	2116: {Code: 2116, Component: "Engine", Name: "EAS_ERR_UNITIALIZED_AMBIGUOUS_CACHE", Template: "Uninitialized Ambiguous Test Cache"},
+	2117: {Code: 2117, Component: "Configuration", Name: "EAS_ERR_SCORING_CALL_HAS_NO_BOM", Template: "Scoring call configured with no Bill of Materials  CFCALL_ID[{0}]."},
+	2118: {Code: 2118, Component: "Configuration", Name: "EAS_ERR_BOM_CONFIG_INVALID_FOR_SCORING_PLUGIN", Template: "Configured plugin for CFCALL_ID[{0}] has invalid BOM."},
+	2120: {Code: 2120, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_FTYPE_ID", Template: "Table {0} configured with an invalid type FTYPE_ID[{1}]"},
+	2121: {Code: 2121, Component: "Configuration", Name: "EAS_ERR_TABLE_CONFIGURED_WITH_INVALID_FELEM_ID", Template: "Table {0} configured with an invalid type FELEM_ID[{1}]"},
+	2123: {Code: 2123, Component: "Configuration", Name: "EAS_ERR_CFUNC_CONFIGURED_WITH_NO_CFRTN", Template: "CFG_CFUNC [{0}] feature type [{1}] configured without any corresponding return values in CFG_CFRTN"},
+	2131: {Code: 2131, Component: "Configuration", Name: "EAS_ERR_OBS_ENT_NOT_FOUND", Template: "Requested resolution of OBS_ENT_ID that is not loaded OBS_ENT_ID[{0}]"},
+	2135: {Code: 2135, Component: "Configuration", Name: "EAS_ERR_INPUT_MAPPING_CONFIG_ERROR", Template: "Error in input mapping config[{0}]"},
+	2136: {Code: 2136, Component: "Configuration", Name: "EAS_ERR_INPUT_MAPPING_MISSING_REQUIRED_FIELD", Template: "Error in input mapping, missing required field[{0}]"},
+	2137: {Code: 2137, Component: "Configuration", Name: "EAS_ERR_INPUT_MAPPING_MALFORMED_INPUT", Template: "Error in input mapping, input message is malformed[{0}]"},
+	2138: {Code: 2138, Component: "Configuration", Name: "EAS_ERR_INVALID_CFRTN_INDEX", Template: "CFRTN_ID[{0}] is out of range. Valid range is 0-7"},
+	2139: {Code: 2139, Component: "Configuration", Name: "EAS_ERR_DSRC_INTEREST_CONFIGURED_WITH_INVALID_DSRCID", Template: "Data Source Interest configured with invalid Data Source ID DSRC_ID[{0}]"},
+	2207: {Code: 2207, Component: "Configuration", Name: "EAS_ERR_DATA_SOURCE_CODE_DOES_NOT_EXIST", Template: "Data source code [{0}] does not exist."},
+	2209: {Code: 2209, Component: "Configuration", Name: "EAS_ERR_DATA_SOURCE_ID_ALREADY_EXISTS", Template: "Data source ID [{0}] already exists."},
+	2210: {Code: 2210, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_DOES_NOT_EXIST", Template: "Feature element code [{0}] does not exist."},
+	2211: {Code: 2211, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_ALREADY_EXISTS", Template: "Feature element code [{0}] already exists."},
+	2212: {Code: 2212, Component: "Configuration", Name: "EAS_ERR_FELEM_ID_ALREADY_EXISTS", Template: "Feature element ID [{0}] already exists."},
+	2213: {Code: 2213, Component: "Configuration", Name: "EAS_ERR_INVALID_FELEM_DATA_TYPE", Template: "Invalid feature element datatype [{0}] found.  Datatype must be in [{1}]."},
+	2214: {Code: 2214, Component: "Configuration", Name: "EAS_ERR_FELEM_IS_CONFIGURED_FOR_USE_IN_FEATURES", Template: "Feature element [{0}] is configured for use in feature(s) [{1}]."},
+	2215: {Code: 2215, Component: "Configuration", Name: "EAS_ERR_FTYPE_CODE_DOES_NOT_EXIST", Template: "Feature type code [{0}] does not exist."},
+	2216: {Code: 2216, Component: "Configuration", Name: "EAS_ERR_FTYPE_CODE_ALREADY_EXISTS", Template: "Feature type code [{0}] already exists."},
+	2217: {Code: 2217, Component: "Configuration", Name: "EAS_ERR_FTYPE_ID_ALREADY_EXISTS", Template: "Feature type ID [{0}] already exists."},
+	2218: {Code: 2218, Component: "Configuration", Name: "EAS_ERR_FEATURE_FREQUENCY_IS_INVALID", Template: "Feature type frequency [{0}] is invalid."},
+	2219: {Code: 2219, Component: "Configuration", Name: "EAS_ERR_FEATURE_ELEMENT_LIST_IS_EMPTY", Template: "Feature element list is empty."},
+	2220: {Code: 2220, Component: "Configuration", Name: "EAS_ERR_STANDARDIZATION_FUNCTION_DOES_NOT_EXIST", Template: "Standardization function [{0}] does not exist."},
+	2221: {Code: 2221, Component: "Configuration", Name: "EAS_ERR_FUNCTION_USES_BOTH_FTYPE_AND_FELEM_TRIGGER", Template: "Function call requested uses both triggering feature type [{0}] and triggering feature element code [{1}].  Cannot use both triggering feature type and triggering feature element code."},
+	2222: {Code: 2222, Component: "Configuration", Name: "EAS_ERR_EXPRESSION_FUNCTION_DOES_NOT_EXIST", Template: "Expression function [{0}] does not exist."},
+	2223: {Code: 2223, Component: "Configuration", Name: "EAS_ERR_EXPRESSION_FUNCTION_FEATURE_ELEMENT_LIST_IS_EMPTY", Template: "Expression function feature element list is empty."},
+	2224: {Code: 2224, Component: "Configuration", Name: "EAS_ERR_COMPARISON_FUNCTION_DOES_NOT_EXIST", Template: "Comparison function [{0}] does not exist."},
+	2225: {Code: 2225, Component: "Configuration", Name: "EAS_ERR_COMPARISON_FUNCTION_FEATURE_ELEMENT_LIST_IS_EMPTY", Template: "Comparison function feature element list is empty."},
+	2226: {Code: 2226, Component: "Configuration", Name: "EAS_ERR_DISTINCT_FUNCTION_DOES_NOT_EXIST", Template: "Distinct feature function [{0}] does not exist."},
+	2227: {Code: 2227, Component: "Configuration", Name: "EAS_ERR_DISTINCT_FUNCTION_FEATURE_ELEMENT_LIST_IS_EMPTY", Template: "Distinct feature function feature element list is empty."},
+	2228: {Code: 2228, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_MUST_BE_UNIQUE_IN_FELEM_LIST", Template: "Feature element code [{0}] must be unique in felem list."},
+	2230: {Code: 2230, Component: "Configuration", Name: "EAS_ERR_FTYPE_CODE_AND_FELEM_CODE_MUST_BE_UNIQUE_IN_EXPRESSED_FUNCTION_CALL", Template: "Feature type [{0}] and feature element [{1}] must be unique in expressed feature function call."},
+	2231: {Code: 2231, Component: "Configuration", Name: "EAS_ERR_FTYPE_CODE_AND_FELEM_CODE_IN_EXPRESSED_FUNCTION_CALL_DO_NOT_EXIST_IN_FEATURE", Template: "Feature type [{0}] and feature element [{1}] requested for expressed feature function call, but don't exist in feature [{0}]."},
+	2232: {Code: 2232, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_MUST_BE_UNIQUE_IN_COMPARISON_FUNCTION_CALL", Template: "Feature element [{0}] must be unique in comparison feature function call."},
+	2233: {Code: 2233, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_IN_COMPARISON_FUNCTION_CALL_DOES_NOT_EXIST_IN_FEATURE", Template: "Feature element [{0}] requested for comparison feature function call, but doesn't exist in feature [{1}]."},
+	2234: {Code: 2234, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_MUST_BE_UNIQUE_IN_DISTINCT_FUNCTION_CALL", Template: "Feature element [{0}] must be unique in distinct feature function call."},
+	2235: {Code: 2235, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_IN_DISTINCT_FUNCTION_CALL_DOES_NOT_EXIST_IN_FEATURE", Template: "Feature element [{0}] requested for distinct feature function call, but doesn't exist in feature [{1}]."},
+	2236: {Code: 2236, Component: "Configuration", Name: "EAS_ERR_EXEC_ORDER_IS_NOT_SPECIFIED_FOR_FUNCTION", Template: "Exec order not specified for function."},
+	2237: {Code: 2237, Component: "Configuration", Name: "EAS_ERR_SFCALL_ID_ALREADY_EXISTS", Template: "Standardization function call ID [{0}] already exists."},
+	2238: {Code: 2238, Component: "Configuration", Name: "EAS_ERR_EFCALL_ID_ALREADY_EXISTS", Template: "Expression function call ID [{0}] already exists."},
+	2239: {Code: 2239, Component: "Configuration", Name: "EAS_ERR_CFCALL_ID_ALREADY_EXISTS", Template: "Comparison function call ID [{0}] already exists."},
+	2240: {Code: 2240, Component: "Configuration", Name: "EAS_ERR_DFCALL_ID_ALREADY_EXISTS", Template: "Distinct feature function call ID [{0}] already exists."},
+	2241: {Code: 2241, Component: "Configuration", Name: "EAS_ERR_FTYPE_CODE_REQUIRED_BY_SEPARATE_EXPRESSED_FUNCTION_CALL", Template: "Feature type [{0}] required for separate expressed feature function call [{1}]."},
+	2242: {Code: 2242, Component: "Configuration", Name: "EAS_ERR_SFCALL_ID_DOES_NOT_EXIST", Template: "Standardization function call ID [{0}] does not exist."},
+	2243: {Code: 2243, Component: "Configuration", Name: "EAS_ERR_EFCALL_ID_DOES_NOT_EXIST", Template: "Expression function call ID [{0}] does not exist."},
+	2244: {Code: 2244, Component: "Configuration", Name: "EAS_ERR_CFCALL_ID_DOES_NOT_EXIST", Template: "Comparison function call ID [{0}] does not exist."},
+	2245: {Code: 2245, Component: "Configuration", Name: "EAS_ERR_DFCALL_ID_DOES_NOT_EXIST", Template: "Distinct feature function call ID [{0}] does not exist."},
+	2246: {Code: 2246, Component: "Configuration", Name: "EAS_ERR_BOM_EXEC_ORDER_ALREADY_EXISTS", Template: "BOM exec order value [{0}] already exists."},
+	2247: {Code: 2247, Component: "Configuration", Name: "EAS_ERR_COMPARISON_FUNCTION_CALL_DOES_NOT_EXIST_FOR_FEATURE", Template: "Comparison function call does not exist for feature [{0}]."},
+	2248: {Code: 2248, Component: "Configuration", Name: "EAS_ERR_DISTINCT_FUNCTION_CALL_DOES_NOT_EXIST_FOR_FEATURE", Template: "Distinct feature function call does not exist for feature [{0}]."},
+	2249: {Code: 2249, Component: "Configuration", Name: "EAS_ERR_CONFLICTING_SPECIFIERS_FOR_FUNCTION_CALL", Template: "Conflicting specifiers: Function call ID [{0}] does not match function call ID [{1}] from feature type."},
+	2250: {Code: 2250, Component: "Configuration", Name: "EAS_ERR_ATTR_CODE_DOES_NOT_EXIST", Template: "Attribute code [{0}] does not exist."},
+	2251: {Code: 2251, Component: "Configuration", Name: "EAS_ERR_ATTR_CODE_ALREADY_EXISTS", Template: "Attribute code [{0}] already exists."},
+	2252: {Code: 2252, Component: "Configuration", Name: "EAS_ERR_ATTR_ID_ALREADY_EXISTS", Template: "Attribute ID [{0}] already exists."},
+	2253: {Code: 2253, Component: "Configuration", Name: "EAS_ERR_ATTR_CLASS_CODE_DOES_NOT_EXIST", Template: "Attribute class code [{0}] does not exist."},
+	2254: {Code: 2254, Component: "Configuration", Name: "EAS_ERR_FUNCTION_USES_NEITHER_FTYPE_NOR_FELEM_TRIGGER", Template: "Function call requested uses neither triggering feature type [{0}] nor triggering feature element code [{1}].  At least one trigger must be specified."},
+	2255: {Code: 2255, Component: "Configuration", Name: "EAS_ERR_FEATURE_CLASS_CODE_DOES_NOT_EXIST", Template: "Feature class code [{0}] does not exist."},
+	2256: {Code: 2256, Component: "Configuration", Name: "EAS_ERR_RELATIONSHIP_TYPE_CODE_DOES_NOT_EXIST", Template: "Relationship type code [{0}] does not exist."},
+	2257: {Code: 2257, Component: "Configuration", Name: "EAS_ERR_FELEM_CODE_NOT_IN_FEATURE", Template: "Feature element code [{0}] not included in feature[{1}]."},
+	2258: {Code: 2258, Component: "Configuration", Name: "EAS_ERR_ER_FRAGMENT_DOES_NOT_EXIST", Template: "ER fragment code [{0}] does not exist."},
+	2259: {Code: 2259, Component: "Configuration", Name: "EAS_ERR_ER_RULE_DOES_NOT_EXIST", Template: "ER rule code [{0}] does not exist."},
+	2260: {Code: 2260, Component: "Configuration", Name: "EAS_ERR_ERFRAG_ID_ALREADY_EXISTS", Template: "ER fragment ID [{0}] already exists."},
+	2261: {Code: 2261, Component: "Configuration", Name: "EAS_ERR_ERRULE_ID_ALREADY_EXISTS", Template: "ER rule ID [{0}] already exists."},
+	2262: {Code: 2262, Component: "Configuration", Name: "EAS_ERR_ERFRAG_CODE_ALREADY_EXISTS", Template: "ER fragment code [{0}] already exists."},
+	2263: {Code: 2263, Component: "Configuration", Name: "EAS_ERR_ERRULE_CODE_ALREADY_EXISTS", Template: "ER rule code [{0}] already exists."},
+	2264: {Code: 2264, Component: "Configuration", Name: "EAS_ERR_ERFRAG_CODE_DOES_NOT_EXIST", Template: "ER fragment code [{0}] does not exist."},
+	2266: {Code: 2266, Component: "Configuration", Name: "EAS_ERR_ERFRAG_CODE_MUST_BE_UNIQUE_IN_DEPENDENCY_LIST", Template: "ER fragment code [{0}] must be unique in dependency list."},
+	2267: {Code: 2267, Component: "Configuration", Name: "EAS_ERR_SECTION_NAME_ALREADY_EXISTS", Template: "Section name [{0}] already exists."},
+	2268: {Code: 2268, Component: "Configuration", Name: "EAS_ERR_SECTION_NAME_DOES_NOT_EXIST", Template: "Section name [{0}] does not exist."},
+	2269: {Code: 2269, Component: "Configuration", Name: "EAS_ERR_SECTION_FIELD_NAME_ALREADY_EXISTS", Template: "Section field name [{0}] already exists."},
+	2270: {Code: 2270, Component: "Configuration", Name: "EAS_ERR_SFUNC_ID_ALREADY_EXISTS", Template: "Feature standardization function ID [{0}] already exists."},
+	2271: {Code: 2271, Component: "Configuration", Name: "EAS_ERR_SFUNC_CODE_ALREADY_EXISTS", Template: "Feature standardization function code [{0}] already exists."},
+	2272: {Code: 2272, Component: "Configuration", Name: "EAS_ERR_EFUNC_ID_ALREADY_EXISTS", Template: "Feature expression function ID [{0}] already exists."},
+	2273: {Code: 2273, Component: "Configuration", Name: "EAS_ERR_EFUNC_CODE_ALREADY_EXISTS", Template: "Feature expression function code [{0}] already exists."},
+	2274: {Code: 2274, Component: "Configuration", Name: "EAS_ERR_CFUNC_ID_ALREADY_EXISTS", Template: "Feature comparison function ID [{0}] already exists."},
+	2275: {Code: 2275, Component: "Configuration", Name: "EAS_ERR_CFUNC_CODE_ALREADY_EXISTS", Template: "Feature comparison function code [{0}] already exists."},
+	2276: {Code: 2276, Component: "Configuration", Name: "EAS_ERR_DFUNC_ID_ALREADY_EXISTS", Template: "Feature distinct function ID [{0}] already exists."},
+	2277: {Code: 2277, Component: "Configuration", Name: "EAS_ERR_DFUNC_CODE_ALREADY_EXISTS", Template: "Feature distinct function code [{0}] already exists."},
+	2278: {Code: 2278, Component: "Configuration", Name: "EAS_ERR_COMPATIBILITY_VERSION_NOT_FOUND_IN_CONFIG", Template: "Compatibility version not found in document."},
+	2279: {Code: 2279, Component: "Configuration", Name: "EAS_ERR_CFRTN_ID_ALREADY_EXISTS", Template: "Feature comparison function return ID [{0}] already exists."},
+	2280: {Code: 2280, Component: "Configuration", Name: "EAS_ERR_CFUNC_CODE_DOES_NOT_EXIST", Template: "Feature comparison function code [{0}] does not exist."},
+	2281: {Code: 2281, Component: "Configuration", Name: "EAS_ERR_CFRTN_VALUE_ALREADY_EXISTS", Template: "Feature comparison function return value [{0}] already exists for comparison function [{1}] ftype [{2}]."},
+	2282: {Code: 2282, Component: "Configuration", Name: "EAS_ERR_CFUNC_EXEC_ORDER_ALREADY_EXISTS", Template: "Feature comparison function exec order value [{0}] already exists for comparison function [{1}] ftype [{2}]."},
+	2283: {Code: 2283, Component: "Configuration", Name: "EAS_ERR_EFUNC_CODE_DOES_NOT_EXIST", Template: "Feature expression function code [{0}] does not exist."},
+	2285: {Code: 2285, Component: "Engine", Name: "EAS_ERR_INVALID_FORMAT_FOR_ENTITIES", Template: "Invalid format for ENTITIES."},
+	2286: {Code: 2286, Component: "Engine", Name: "EAS_ERR_NO_ENTITY_ID_FOUND_FOR_ENTITY", Template: "No entity ID found for entity."},
+	2287: {Code: 2287, Component: "Engine", Name: "EAS_ERR_NO_DATA_SOURCE_FOUND", Template: "No data source found."},
+	2288: {Code: 2288, Component: "Engine", Name: "EAS_ERR_NO_RECORD_ID_FOUND", Template: "No record ID found."},
+	2289: {Code: 2289, Component: "Configuration", Name: "EAS_ERR_INVALID_FEATURE_CLASS_FOR_FEATURE_TYPE", Template: "Invalid feature class [{0}] for feature type [{1}]."},
+	2290: {Code: 2290, Component: "Configuration", Name: "EAS_ERR_FRAGMENT_IS_CONFIGURED_FOR_USE_IN_RULES", Template: "Rule fragment [{0}] is configured for use in rules(s) [{1}]."},
+	2291: {Code: 2291, Component: "Configuration", Name: "EAS_ERR_FRAGMENT_IS_CONFIGURED_FOR_USE_IN_FRAGMENT", Template: "Rule fragment [{0}] is configured for use in fragments(s) [{1}]."},
+	2292: {Code: 2292, Component: "Engine", Name: "EAS_ERR_CANT_RETRIEVE_OBS_FEATURE_DATA_FOR_OBS_ENT", Template: "Could not retrieve observed feature data for observed entity [{0}]."},
+	2293: {Code: 2293, Component: "Engine", Name: "EAS_ERR_NO_RECORDS_SPECIFIED", Template: "No records specified."},
+	2294: {Code: 2294, Component: "Engine", Name: "EAS_ERR_DATA_SOURCE_ID_DOES_NOT_EXIST", Template: "Data source ID [{0}] does not exist."},
+	3011: {Code: 3011, Component: "API", Name: "EAS_ERR_DELETE_WITH_RESOLVE_ONLY", Template: "Cannot delete an entity with type RESOLVE_ONLY"},
+	3101: {Code: 3101, Component: "API", Name: "EAS_ERR_INVALID_SESSION_HANDLE", Template: "Invalid Session Handle [{0}]"},
+	3102: {Code: 3102, Component: "API", Name: "EAS_ERR_INVALID_REPORT_HANDLE", Template: "Invalid Report Handle [{0}]"},
+	3103: {Code: 3103, Component: "API", Name: "EAS_ERR_INVALID_EXPORT_HANDLE", Template: "Invalid Export Handle [{0}]"},
+	3110: {Code: 3110, Component: "API", Name: "EAS_ERR_RESPONSE_MESSAGE_SIZE_LARGER_THAN_BUFFER_SIZE", Template: "Response message size [{0}] is larger than buffer size [{1}]"},
+	3111: {Code: 3111, Component: "API", Name: "EAS_ERR_RESPONSE_RESIZE_FUNCTION_IS_NOT_PROVIDED", Template: "Resize function is not provided"},
+	3112: {Code: 3112, Component: "API", Name: "EAS_ERR_RESPONSE_RESIZE_FUNCTION_GAVE_INVALID_RESULT", Template: "Resize function returned an invalid result"},
+	3121: {Code: 3121, Component: "API", Name: "EAS_ERR_JSON_PARSING_FAILURE", Template: "JSON Parsing Failure [code={0},offset={1}]"},
+	3122: {Code: 3122, Component: "API", Name: "EAS_ERR_JSON_PARSING_FAILURE_MUST_BE_OBJECT_OR_ARRAY", Template: "JSON Parsing Failure.  JSON must be object or array."},
+	3123: {Code: 3123, Component: "API", Name: "EAS_ERR_JSON_PARSING_FAILURE_OBJECT_HAS_DUPLICATE_KEYS", Template: "Json object has duplicate keys."},
+	3131: {Code: 3131, Component: "API", Name: "EAS_ERR_UNKNOWN_COLUMN_REQUESTED_FOR_CSV_EXPORT", Template: "Invalid column [{0}] requested for CSV export."},
+	7209: {Code: 7209, Component: "Database", Name: "EAS_ERR_DB_BAD_BACKEND_TYPE", Template: "Invalid [SQL] Backend Parameter. Valid values are SQL or HYBRID"},
+	7211: {Code: 7211, Component: "Database", Name: "EAS_ERR_DB_BAD_CLUSTER_SIZE", Template: "Cluster [{0}] is configured with an invalid size. Size must be equal to 1."},
+	7212: {Code: 7212, Component: "Database", Name: "EAS_ERR_DB_BAD_CLUSTER_NODE", Template: "Cluster [{0}] Node [{1}] is not configured."},
+	7216: {Code: 7216, Component: "Database", Name: "EAS_ERR_DB_BAD_CLUSTER_DEFINITION", Template: "Cluster [{0}] is not properly configured"},
+	7217: {Code: 7217, Component: "Database", Name: "EAS_ERR_DB_CONFLICTING_DEFAULT_SHARD_CONFIG", Template: "Cannot specify both default backend database and default backend cluster"},
+	7218: {Code: 7218, Component: "Database", Name: "EAS_ERR_DB_CLUSTER_DOES_NOT_EXIST", Template: "Cluster [{0}] does not exist"},
+	7220: {Code: 7220, Component: "Database", Name: "EAS_ERR_NO_CONFIG_REGISTERED_IN_DATASTORE", Template: "No engine configuration registered in datastore (see https://senzing.zendesk.com/hc/en-us/articles/360036587313)."},
+	7221: {Code: 7221, Component: "Configuration", Name: "EAS_ERR_NO_CONFIG_REGISTERED_FOR_DATA_ID", Template: "No engine configuration registered with data ID [{0}]."},
+	7222: {Code: 7222, Component: "Database", Name: "EAS_ERR_FAILED_TO_SET_SYS_VAR_IN_DATASTORE", Template: "Could not set system variable value in database for Group[{0}],Code[{1}],Value[{2}]."},
+	7223: {Code: 7223, Component: "Database", Name: "EAS_ERR_INVALID_SCHEMA_VERSION_IN_DATASTORE", Template: "Invalid version number for datastore schema [version '{0}']."},
+	7224: {Code: 7224, Component: "Configuration", Name: "EAS_ERR_INVALID_SCHEMA_VERSION_IN_ENGINE", Template: "Invalid version number for engine schema [version '{0}']."},
+	7226: {Code: 7226, Component: "Database", Name: "EAS_ERR_INCOMPATIBLE_DATASTORE_SCHEMA_VERSION", Template: "Incompatible datastore schema version: [Engine version '{0}'.  Datastore version '{1}' is installed, but must be between '{2}' and '{3}'.]"},
+	7227: {Code: 7227, Component: "Database", Name: "EAS_ERR_CONFLICTING_SCHEMA_VERSIONS_IN_DATASTORE", Template: "Conflicting version numbers for datastore schema [{0}]."},
+	7228: {Code: 7228, Component: "Configuration", Name: "EAS_ERR_INVALID_SCHEMA_VERSION", Template: "Invalid schema version number [version '{0}']."},
+	7230: {Code: 7230, Component: "Configuration", Name: "EAS_ERR_ENGINE_CONFIGURATION_FILE_NOT_FOUND", Template: "Engine configuration file not found [{0}]."},
+	7232: {Code: 7232, Component: "Configuration", Name: "EAS_ERR_ENGINE_CONFIGURATION_NOT_FOUND", Template: "No engine configuration found."},
+	7233: {Code: 7233, Component: "Database", Name: "EAS_ERR_DATASTORE_ENCRYPTION_SIGNATURE_IS_INCOMPATIBLE", Template: "Datastore encryption signature is not compatible."},
+	7234: {Code: 7234, Component: "Configuration", Name: "EAS_ERR_FAILED_TO_GET_ENCRYPTION_SIGNATURE", Template: "Failed to get encryption signature: '{0}'"},
+	7235: {Code: 7235, Component: "Configuration", Name: "EAS_ERR_FTYPE_CONFIGURED_AS_REL_BUT_NO_RTYPE", Template: "FTYPE_CODE[{0}] IS CONFIGURED AS A RELATIONSHIP FEATURE TYPE BUT RTYPE_ID IS NOT SET."},
+	7236: {Code: 7236, Component: "Configuration", Name: "EAS_ERR_DUPLICATE_BEHAVIOR_OVERRIDE_KEY_IN_CFG_FBOVR", Template: "Duplicate behavior override keys in CFG_FBOVR -- FTYPE_ID[{0}], UTYPE_CODE[{1}] referenced in CFG_FBOVR."},
+	7237: {Code: 7237, Component: "Configuration", Name: "EAS_ERR_UNKNOWN_FTYPE_IN_TABLE", Template: "Unknown FTYPE_ID[{0}] referenced in {1}."},
+	7238: {Code: 7238, Component: "Database", Name: "EAS_ERR_DATASTORE_ENCRYPTION_CONFIGURATION_DOES_NOT_MATCH_DATASTORE", Template: "Datastore encryption configuration does not match data store:  '{0}'"},
+	7239: {Code: 7239, Component: "Configuration", Name: "EAS_ERR_INVALID_GENERIC_THRESHOLD_CANDIDATE_CAP", Template: "Invalid generic threshold {0} cap [{1}] for [GPLAN_ID[{2}], BEHAVIOR[{3}], FTYPE_ID[{4}]]."},
+	7240: {Code: 7240, Component: "Configuration", Name: "EAS_ERR_INCORRECT_BEHAVIOR_REFERENCED", Template: "Incorrect BEHAVIOR[{0}] referenced in CFG_GENERIC_THRESHOLD for [GPLAN_ID[{1}], FTYPE_ID[{2}]].  FType configured for behavior [{3}]"},
+	7241: {Code: 7241, Component: "Configuration", Name: "EAS_ERR_UNKNOWN_GPLAN_IN_TABLE", Template: "Unknown GPLAN_ID[{0}] referenced in {1}."},
+	7242: {Code: 7242, Component: "Configuration", Name: "EAS_ERR_MULTIPLE_GENERIC_THRESHOLD_DEFINITIONS", Template: "Multiple Generic Threshold definitions for [GPLAN_ID[{0}], BEHAVIOR[{1}], FTYPE_ID[{2}]]."},
+	7243: {Code: 7243, Component: "Configuration", Name: "EAS_ERR_ER_FRAGMENT_HAS_UNDEFINED_DEPENDENT_FRAGMENTS", Template: "ER Fragment [{0}] configured with undefined dependent fragments. Fragment [{1}] undefined."},
+	7244: {Code: 7244, Component: "Configuration", Name: "EAS_ERR_ER_RULE_FRAGMENT_LACKS_REQUIRED_FRAGMENT", Template: "ER Rule Fragment configuration lacks the required {0} fragment."},
+	7245: {Code: 7245, Component: "Configuration", Name: "EAS_ERR_CURRENT_CONFIG_REGISTERED_DOES_NOT_MATCH_DATA_ID", Template: "Current configuration ID does not match specified data ID [{0}]."},
+	7246: {Code: 7246, Component: "Database", Name: "EAS_ERR_INVALID_MAXIMUM_DATASTORE_SCHEMA_VERSION", Template: "Invalid maximum datastore version number for engine schema [version '{0}']."},
+	7247: {Code: 7247, Component: "Database", Name: "EAS_ERR_INVALID_MINIMUM_DATASTORE_SCHEMA_VERSION", Template: "Invalid minimum datastore version number for engine schema [version '{0}']."},
+	7303: {Code: 7303, Component: "Engine", Name: "EAS_ERR_MANDATORY_SEGMENT_WITH_MISSING_REQUIREMENTS", Template: "Mandatory segment with missing requirements:"},
+	7305: {Code: 7305, Component: "Engine", Name: "EAS_ERR_MISSING_JSON_ROOT_ELEMENT", Template: "No root element name in json TEMPLATE"},
+	7313: {Code: 7313, Component: "Engine", Name: "EAS_ERR_REQUIRED_ELEMENT_WITH_EMPTY_FIELD", Template: "A non-empty value for [{0}] must be specified."},
+	7314: {Code: 7314, Component: "Engine", Name: "EAS_ERR_REQUIRED_ELEMENT_NOT_FOUND", Template: "A value for [{0}] must be specified."},
+	7317: {Code: 7317, Component: "Configuration", Name: "EAS_ERR_FAILED_TO_OPEN_FILE", Template: "Failed to open file: {0}"},
+	7344: {Code: 7344, Component: "Configuration", Name: "EAS_ERR_UNKNOWN_MAPPING_DIRECTIVE", Template: "Invalid mapping directive [{0}] for attribute [{1}]."},
+	7426: {Code: 7426, Component: "Engine", Name: "EAS_ERR_XLITERATOR_FAILED", Template: "Transliteration failed"},
+	7511: {Code: 7511, Component: "Engine", Name: "EAS_ERR_ABORT_ER_AND_RETRY", Template: "Detected change in candidate entity[{0}].  Restarting ER evaluation."},
+	8000: {Code: 8000, Component: "Engine", Name: "EAS_ERR_GNRNP", Template: "GNR NameParser Failure"},
+	8410: {Code: 8410, Component: "Engine", Name: "EAS_ERR_UNINITIALIZED_AMBIGUOUS_FEATURE", Template: "Cannot use uninitialized ambiguous feature."},
+	8501: {Code: 8501, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_ALGORITHM_NOT_AVAILABLE", Template: "Failed to get {0} digest algorithm from ICC."},
+	8502: {Code: 8502, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_CONTEXT_CREATE_FAILED", Template: "Failed to create a digest context."},
+	8503: {Code: 8503, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_CONTEXT_INIT_FAILED", Template: "Failed {0} to initialise a digest context."},
+	8504: {Code: 8504, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_FAILED", Template: "Failed {0} to digest block {1}."},
+	8505: {Code: 8505, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_FINAL_FAILED", Template: "Failed {0} to complete digest."},
+	8508: {Code: 8508, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_UNKNOWN_EXCEPTION", Template: "Unrecognized exception thrown generating digest."},
+	8509: {Code: 8509, Component: "Hashing", Name: "EAS_ERR_SALT_DIGEST_ALGORITHM_REQUIRED", Template: "Cannot generate a digest without a valid algorithm."},
+	8514: {Code: 8514, Component: "Hashing", Name: "EAS_ERR_SALT_RANDOM_FAILED", Template: "Failed {0} to get random content"},
+	8516: {Code: 8516, Component: "Hashing", Name: "EAS_ERR_SALT_MUST_BE_SIZE", Template: "A salt value must be {0} bytes long but the provided one is {1} bytes."},
+	8517: {Code: 8517, Component: "Hashing", Name: "EAS_ERR_SALT_DOES_NOT_MATCH_CHECKSUM", Template: "The salt value does not match the recorded checksum."},
+	8520: {Code: 8520, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_INIT_FAILED", Template: "Secure Store initialization failed."},
+	8521: {Code: 8521, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_TOKEN_MUST_BE_INIT", Template: "Hashing with a named salt requires the Secure Store to be initialised."},
+	8522: {Code: 8522, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_SOPIN_NOT_VALID", Template: "The Security Officer (SO) PIN is not correct."},
+	8524: {Code: 8524, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_INIT_UNKNOWN_EXCEPTION", Template: "Secure Store initialization failed with an unrecognised exception"},
+	8525: {Code: 8525, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_LOAD", Template: "Secure Store is required to load salt"},
+	8526: {Code: 8526, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_GENERATE", Template: "Secure Store is required to generate salt"},
+	8527: {Code: 8527, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_IMPORT", Template: "Secure Store is required to import salt"},
+	8528: {Code: 8528, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_EXPORT", Template: "Secure Store is required to export salt"},
+	8529: {Code: 8529, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_DELETE", Template: "Secure Store is required to delete salt"},
+	8530: {Code: 8530, Component: "Hashing", Name: "EAS_ERR_SALT_CANNOT_OVERWRITE", Template: "You cannot overwrite an existing salt called {0}"},
+	8536: {Code: 8536, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_LEGACY", Template: "Secure Store is required to add a legacy salt"},
+	8538: {Code: 8538, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_REQUIRED_FOR_METHOD", Template: "Secure Store is required to change hashing method"},
+	8539: {Code: 8539, Component: "SecureStore", Name: "EAS_ERR_SALT_G2SS_ERROR_CHANGING_METHOD", Template: "Secure Store error changing hashing method"},
+	8540: {Code: 8540, Component: "Hashing", Name: "EAS_ERR_SALT_WRONG_SIZE", Template: "The object called {0} is not a salt"},
+	8541: {Code: 8541, Component: "Hashing", Name: "EAS_ERR_SALT_BASE64_DECODE_ERROR", Template: "Base64 decoding error in salt {0} at character {1}"},
+	8542: {Code: 8542, Component: "Hashing", Name: "EAS_ERR_SALT_UNINITIALISED", Template: "Must load a salt before using it."},
+	8543: {Code: 8543, Component: "Hashing", Name: "EAS_ERR_SALT_NOT_FOUND", Template: "There is no salt called {0} in the Secure Store."},
+	8544: {Code: 8544, Component: "Hashing", Name: "EAS_ERR_SALT_PASSWORD_NOT_STRONG_ENOUGH", Template: "The password must be stronger: {0}"},
+	8545: {Code: 8545, Component: "Hashing", Name: "EAS_ERR_SALT_ADMIN_NAME_REQUIRED", Template: "Specify -name and the name to use for the salt"},
+	8556: {Code: 8556, Component: "Hashing", Name: "EAS_ERR_SALT_ADMIN_METHOD_NOT_RECOGNISED", Template: "Hashing method {0} not supported."},
+	8557: {Code: 8557, Component: "Hashing", Name: "EAS_ERR_SALT_METHOD_DOES_NOT_MATCH", Template: "The hashing method in the configuration ({1}) does not match the method ({2}) of the salt {0}"},
+	8593: {Code: 8593, Component: "Hashing", Name: "EAS_ERR_SALT_HMAC_CONTEXT_INIT_FAILED", Template: "Failed {0} to initialise an HMAC context."},
+	8594: {Code: 8594, Component: "Hashing", Name: "EAS_ERR_SALT_HMAC_FAILED", Template: "Failed {0} to HMAC block {1}."},
+	8595: {Code: 8595, Component: "Hashing", Name: "EAS_ERR_SALT_HMAC_FINAL_FAILED", Template: "Failed {0} to complete HMAC."},
+	8598: {Code: 8598, Component: "Hashing", Name: "EAS_ERR_SALT_HMAC_UNKNOWN_EXCEPTION", Template: "Unrecognized exception thrown generating HMAC."},
+	8599: {Code: 8599, Component: "Hashing", Name: "EAS_ERR_SALT_UNKNOWN_HASHING_METHOD", Template: "Unrecognized hashing method ({0}) requested."},
+	8601: {Code: 8601, Component: "Hashing", Name: "EAS_ERR_HASHER_REQUIRES_SECURE_STORE", Template: "Using a named salt requires the Secure Store configured and running"},
+	8602: {Code: 8602, Component: "Hashing", Name: "EAS_ERR_HASHER_CHECKSUM_DOES_NOT_MATCH", Template: "The hashing checksum configured ({1}) does not match the checksum ({2}) of the salt named {0}"},
+	8603: {Code: 8603, Component: "Hashing", Name: "EAS_ERR_HASHER_UNABLE_TO_RECORD_SALT", Template: "Unable to record the configured salt"},
+	8604: {Code: 8604, Component: "Hashing", Name: "EAS_ERR_HASHER_REQUIRES_FUNCTION", Template: "Using hashing requires a configured hashing function"},
+	8605: {Code: 8605, Component: "Hashing", Name: "EAS_ERR_HASHER_EPHEMERAL_OR_NAMED_SALT", Template: "Specify either a named salt or an ephemeral one. Can not have both"},
+	8606: {Code: 8606, Component: "Hashing", Name: "EAS_ERR_HASHER_SALT_REQUIRED", Template: "Hashing requires a salt to be configured."},
+	8607: {Code: 8607, Component: "Hashing", Name: "EAS_ERR_HASHER_INVALID_ARGS", Template: "Invalid arguments to hashing function. Either a parameter wasn't provided or a buffer was too small: location={0}, dataPtr={1}, dataLength={2}, outputPtr={3}, outputLength={4}, output={5}"},
+	8608: {Code: 8608, Component: "Hashing", Name: "EAS_ERR_NO_SALT_VALUE_CONFIGURED", Template: "No salt value is configured. A salt value must be configured if you wish to export the token library."},
+	8701: {Code: 8701, Component: "Configuration", Name: "EAS_ERR_PARAMETER_NOT_READABLE", Template: "The parameter store does not support a read interface"},
+	8702: {Code: 8702, Component: "Configuration", Name: "EAS_ERR_PARAMETER_NOT_WRITABLE", Template: "The parameter store does not support a write interface"},
+	9000: {Code: 9000, Component: "License", Name: "EAS_LIMIT_MAX_OBS_ENT", Template: "LIMIT: Maximum number of records ingested: {0}"},
+	9107: {Code: 9107, Component: "Configuration", Name: "EAS_ERR_CANT_GET_PARAMETER_FROM_THE_STORE", Template: "Cannot get parameter [{0}] from parameter store"},
+	9110: {Code: 9110, Component: "Configuration", Name: "EAS_ERR_INSUFFICIENT_CONFIG", Template: "Insufficient configuration for the {0} table!"},
+	9111: {Code: 9111, Component: "Configuration", Name: "EAS_ERR_PARSE_FRAGMENT", Template: "ERROR parsing FragmentID[{0}] FragmentName[{1}] : [{2}] is an invalid RuleID dependency"},
+	9112: {Code: 9112, Component: "Configuration", Name: "EAS_ERR_FAILED_TO_OPEN_INI_FILE_FOR_WRITING", Template: "Failed to open ini file for writing [{0}]"},
+	9113: {Code: 9113, Component: "Configuration", Name: "EAS_ERR_FAILED_TO_OPEN_INI_FILE_FOR_READING", Template: "Failed to open ini file for reading [{0}]"},
+	9115: {Code: 9115, Component: "Engine", Name: "EAS_ERR_INPUT_NOT_STANDARDIZED", Template: "Cannot process Observation that has not been standardized"},
+	9116: {Code: 9116, Component: "Configuration", Name: "EAS_ERR_CONFIG_TABLE_NOT_FOUND", Template: "CONFIG information for {0} not found!"},
+	9117: {Code: 9117, Component: "Configuration", Name: "EAS_ERR_CONFIG_TABLE_COLUMN_NOT_FOUND", Template: "CONFIG information for {0} not found in {1}!"},
+	9118: {Code: 9118, Component: "Configuration", Name: "EAS_ERR_CONFIG_TABLE_COLUMN_INDEX_NOT_FOUND", Template: "Invalid column index {0} queried from {1} container!"},
+	9119: {Code: 9119, Component: "Configuration", Name: "EAS_ERR_CONFIG_TABLE_COLUMN_NAME_NOT_FOUND", Template: "Invalid column name {0} queried from {1} container!"},
+	9120: {Code: 9120, Component: "Configuration", Name: "EAS_ERR_CONFIG_TABLE_MALFORMED", Template: "CONFIG information for {0} is malformed!"},
+	9210: {Code: 9210, Component: "Hashing", Name: "EAS_ERR_DIGEST_CONTEXT_INIT_FAILED", Template: "Unable to initialize Digest Context."},
+	9220: {Code: 9220, Component: "Configuration", Name: "EAS_ERR_FTYPE_CANNOT_BE_HASHED", Template: "FType configured to be hashed, but cannot be scored.  FTYPE_ID[{0}] FTYPE_CODE[{1}]"},
+	9222: {Code: 9222, Component: "Hashing", Name: "EAS_ERR_FTYPE_CONFIGURED_TO_BE_HASHED_MISSING_SALT", Template: "A Feature Type is marked for hashing, but a valid salt value was not found.  FTYPE_ID[{0}] FTYPE_CODE[{1}]"},
+	9224: {Code: 9224, Component: "Configuration", Name: "EAS_ERR_FTYPE_CONFIGURED_TO_BE_HASHED", Template: "FType configured to be hashed, but no hashable data found.  FTYPE_ID[{0}] FTYPE_CODE[{1}]"},
+	9228: {Code: 9228, Component: "Hashing", Name: "EAS_ERR_UNEXPECTED_SALT_CHECKUM_LIST", Template: "The SALT checksum on the Observation does not match the EXPECTED SALT checksum: EXPECTED=[{0}] Observation=[{1}]"},
+	9240: {Code: 9240, Component: "Hashing", Name: "EAS_ERR_CIPHER_CONTEXT_INIT_FAILED", Template: "Unable to initialize an ICC Context."},
+	9241: {Code: 9241, Component: "Hashing", Name: "EAS_ERR_CIPHER_OP_FAILED", Template: "Unable to perform a required ICC operation."},
+	9250: {Code: 9250, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_LIB", Template: "Invalid ({1}) Secure Store plug-in library: {0}"},
+	9251: {Code: 9251, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_URL", Template: "Invalid Secure Store URL: {0}"},
+	9252: {Code: 9252, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_PIN", Template: "Invalid Secure Store credential specification: {0}"},
+	9253: {Code: 9253, Component: "SecureStore", Name: "EAS_ERR_G2SS_TOKEN_INIT_FAILED", Template: "Secure Store token initialization failed: {0}."},
+	9254: {Code: 9254, Component: "SecureStore", Name: "EAS_ERR_G2SS_TOKEN_UNINITIALISED", Template: "Cannot open a Secure Store session when the token is uninitialized."},
+	9255: {Code: 9255, Component: "SecureStore", Name: "EAS_ERR_G2SS_USER_PIN_UNINITIALISED", Template: "Secure Store credential is uninitialized."},
+	9256: {Code: 9256, Component: "SecureStore", Name: "EAS_ERR_G2SS_SESSION_OPEN", Template: "Cannot open a Secure Store session when one is already open."},
+	9257: {Code: 9257, Component: "SecureStore", Name: "EAS_ERR_G2SS_NO_SESSION", Template: "Cannot use Secure Store without a session."},
+	9258: {Code: 9258, Component: "SecureStore", Name: "EAS_ERR_G2SS_SESSION_OPEN_FAILED", Template: "Secure Store session could not be opened: {0}."},
+	9259: {Code: 9259, Component: "SecureStore", Name: "EAS_ERR_G2SS_ADMIN_LOGIN_FAILED", Template: "Secure Store admin login failed: {0}."},
+	9260: {Code: 9260, Component: "SecureStore", Name: "EAS_ERR_G2SS_USER_LOGIN_FAILED", Template: "Secure Store user login failed: {0}."},
+	9261: {Code: 9261, Component: "SecureStore", Name: "EAS_ERR_G2SS_PKCS11_ERROR", Template: "Secure Store function failed: {0}"},
+	9264: {Code: 9264, Component: "SecureStore", Name: "EAS_ERR_G2SS_LOGOUT_FAILED", Template: "Secure Store logout failed: {0}."},
+	9265: {Code: 9265, Component: "SecureStore", Name: "EAS_ERR_G2SS_NEED_RW_SESSION", Template: "Secure Store session must be read/write."},
+	9266: {Code: 9266, Component: "SecureStore", Name: "EAS_ERR_G2SS_UNABLE_TO_VERIFY_KEY", Template: "Secure Store key does not meet requirements."},
+	9267: {Code: 9267, Component: "SecureStore", Name: "EAS_ERR_G2SS_UNABLE_TO_CREATE_KEY", Template: "Secure Store key creation failed."},
+	9268: {Code: 9268, Component: "SecureStore", Name: "EAS_ERR_G2SS_UNABLE_TO_CHANGE_PIN", Template: "Secure Store password change failed: {0}."},
+	9269: {Code: 9269, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_OLD_CREDENTIAL", Template: "Secure Store old credential is invalid."},
+	9270: {Code: 9270, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_NEW_CREDENTIAL", Template: "Secure Store new credential is invalid."},
+	9271: {Code: 9271, Component: "SecureStore", Name: "EAS_ERR_G2SS_OUT_OF_MEMORY", Template: "Secure Store out of memory."},
+	9272: {Code: 9272, Component: "SecureStore", Name: "EAS_ERR_G2SS_FIND_INIT_FAILED", Template: "Secure Store object locating failed: {0}."},
+	9273: {Code: 9273, Component: "SecureStore", Name: "EAS_ERR_G2SS_FIND_FAILED", Template: "Secure Store object find failed: {0}."},
+	9274: {Code: 9274, Component: "SecureStore", Name: "EAS_ERR_G2SS_CRYPTO_SETUP_FAILED", Template: "Secure Store setup of encryption failed: {0}."},
+	9275: {Code: 9275, Component: "SecureStore", Name: "EAS_ERR_G2SS_ENCRYPT_START_FAILED", Template: "Secure Store unable to start encryption: {0}."},
+	9276: {Code: 9276, Component: "SecureStore", Name: "EAS_ERR_G2SS_ENCRYPT_SIZE_FAILED", Template: "Secure Store unable to get the size of encrypted data: {0}."},
+	9277: {Code: 9277, Component: "SecureStore", Name: "EAS_ERR_G2SS_ENCRYPT_FAILED", Template: "Secure Store encryption failed: {0}."},
+	9278: {Code: 9278, Component: "SecureStore", Name: "EAS_ERR_G2SS_DECRYPT_START_FAILED", Template: "Secure Store unable to start decryption: {0}."},
+	9279: {Code: 9279, Component: "SecureStore", Name: "EAS_ERR_G2SS_DECRYPT_FAILED", Template: "Secure Store decryption failed: {0}."},
+	9280: {Code: 9280, Component: "SecureStore", Name: "EAS_ERR_G2SS_OBJECT_SAVE_FAILED", Template: "Secure Store unable to save object: {0}."},
+	9281: {Code: 9281, Component: "SecureStore", Name: "EAS_ERR_G2SS_OBJECT_DELETE_FAILED", Template: "Secure Store unable to delete object: {0}."},
+	9282: {Code: 9282, Component: "SecureStore", Name: "EAS_ERR_G2SS_OBJECT_CHANGE_FAILED", Template: "Secure Store unable to modify object: {0}."},
+	9283: {Code: 9283, Component: "SecureStore", Name: "EAS_ERR_G2SS_UNINITIALISED", Template: "Secure Store has not been initialized"},
+	9284: {Code: 9284, Component: "SecureStore", Name: "EAS_ERR_G2SS_INVALID_SLOT_ID", Template: "Can not obtain info on specified slot. Possibly invalid slot ID specified in Secure Store URL: {0}"},
+	9285: {Code: 9285, Component: "SecureStore", Name: "EAS_ERR_G2SS_NO_TOKEN_IN_SLOT", Template: "No security token present in slot specified by Secure Store URL: slot ID = {0}"},
+	9286: {Code: 9286, Component: "SecureStore", Name: "EAS_ERR_G2SS_TOKEN_NOT_FOUND", Template: "Can not obtain info for security token. Possibly invalid token label and/or slot ID specified in Secure Store URL: {0}"},
+	9287: {Code: 9287, Component: "SecureStore", Name: "EAS_ERR_G2SS_TOKEN_IMPL_ERROR", Template: "An internal error occurred in the security token implementation library: Return Code = {0}"},
+	9288: {Code: 9288, Component: "SecureStore", Name: "EAS_ERR_G2SS_USER_PIN_PROMPT_FAILED", Template: "Was unable to prompt user for security token authentication."},
+	9289: {Code: 9289, Component: "SecureStore", Name: "EAS_ERR_G2SS_LABEL_CHANGED_SINCE_CONFIG_INIT", Template: "Secure Store has been reconfigured since loading."},
+	9290: {Code: 9290, Component: "SecureStore", Name: "EAS_ERR_G2SS_OBJECT_NOT_FOUND", Template: "Secure Store does not have an object called {0}."},
+	9292: {Code: 9292, Component: "SecureStore", Name: "EAS_ERR_G2SS_NO_PASSWORD", Template: "No password supplied"},
+	9293: {Code: 9293, Component: "SecureStore", Name: "EAS_ERR_G2SS_NO_SEC_STORE_PREFIX", Template: "Secure Store expects a different format (starting with {0}) when a password is supplied"},
+	9295: {Code: 9295, Component: "SecureStore", Name: "EAS_ERR_G2SS_NO_DATA_OBJECTS", Template: "There are no Secure Store objects stored on the token"},
+	9296: {Code: 9296, Component: "SecureStore", Name: "EAS_ERR_G2SS_SEC_STORE_ARCHIVE_BAD", Template: "The exported archive appears to be corrupted around object {0}"},
+	9297: {Code: 9297, Component: "SecureStore", Name: "EAS_ERR_G2SS_FILE_NOT_FOUND", Template: "Secure Store failed to open {0}"},
+	9298: {Code: 9298, Component: "SecureStore", Name: "EAS_ERR_G2SS_FILE_CONTENTS_BAD", Template: "Secure Store contents of {0} not usable."},
+	9299: {Code: 9299, Component: "SecureStore", Name: "EAS_ERR_G2SS_CLASS_NOT_INIT", Template: "Secure Store internal error."},
+	9300: {Code: 9300, Component: "SecureStore", Name: "EAS_ERR_G2SS_PASSWORD_CHECK_ERROR", Template: "Secure Store internal error ({0}) checking password."},
+	9301: {Code: 9301, Component: "Configuration", Name: "EAS_ERR_MISSING_SEQUENCE_ENTRY", Template: "Missing Sequence Entry[{0}] in the SYS_SEQUENCE table!"},
+	9305: {Code: 9305, Component: "Engine", Name: "EAS_ERR_SEQUENCE_RETRIES_FAILED", Template: "Retries failed to retrieve Sequence Entry[{0}] in the SYS_SEQUENCE table!  This may mean the CACHE_SIZE is too small."},
+	9308: {Code: 9308, Component: "Configuration", Name: "EAS_ERR_MISSING_STATUS_ENTRY", Template: "Could not retrieve status entry[{0}] in the SYS_STATUS table!"},
+	9309: {Code: 9309, Component: "Configuration", Name: "EAS_ERR_SEQUENCE_HAS_BEEN_RESET", Template: "Sequence entry[{0}] has been reset."},
+	9310: {Code: 9310, Component: "Configuration", Name: "EAS_ERR_INVALID_STATUS_ENTRY_VALUE", Template: "Invalid value for status entry[{0}] in the SYS_STATUS table!"},
+	9311: {Code: 9311, Component: "Engine", Name: "EAS_ERR_COULD_NOT_RECORD_USAGE_TYPE", Template: "Could not record usage type [{0}] in the LIB_UTYPE table!"},
+	9406: {Code: 9406, Component: "SecureStore", Name: "EAS_ERR_G2SS_SESSION_MUST_NOT_BE_OPEN", Template: "Secure Store cannot fetch a value with sync if a session is already open."},
+	9408: {Code: 9408, Component: "SecureStore", Name: "EAS_ERR_G2SS_PASSWORD_INADEQUATE", Template: "The provided password is not strong enough: {0}"},
+	9409: {Code: 9409, Component: "SecureStore", Name: "EAS_ERR_G2SS_FUNCTION_LIST_NOT_SET", Template: "The security token interface is not yet set"},
+	9410: {Code: 9410, Component: "SecureStore", Name: "EAS_ERR_G2SS_PKCS_INIT_FAILED", Template: "Initializing token driver failed {0}"},
+	9411: {Code: 9411, Component: "SecureStore", Name: "EAS_ERR_G2SS_PKCS_FINAL_FAILED", Template: "Finalizing token driver failed {0}"},
+	9413: {Code: 9413, Component: "SecureStore", Name: "EAS_ERR_G2SS_INCORRECT_PASSWORD", Template: "The export file password appears to be incorrect."},
+	9414: {Code: 9414, Component: "Engine", Name: "EAS_ERR_STRING_IS_INVALID_UTF8", Template: "Invalid data string. Data must be in UTF-8."},
+	9500: {Code: 9500, Component: "Hashing", Name: "EAS_ERR_TOKEN_LIBRARY_CHECKSUM_MISMATCH", Template: "Cannot load token library. The checksum does not match the configuration of this node. Found: [{0}] Expected: [{1}]"},
+	9501: {Code: 9501, Component: "Hashing", Name: "EAS_TOKEN_LIBRARY_ALREADY_HASHED", Template: "Cannot hash token library. The Token Library contains previous hashed data"},
+	9701: {Code: 9701, Component: "Engine", Name: "EAS_ERR_CANT_RETRIEVE_INDEX_FROM_MEMORY_ROW", Template: "Cannot retrieve index[{0}] from memory row of key[{1}], out of range!"},
+	9802: {Code: 9802, Component: "Configuration", Name: "EAS_ERR_INBOUND_OBS_CONFIG_CHECKSUM_MISMATCH", Template: "Configuration checksum on inbound observation [{0}] does not match this nodes configuration checksum [{1}]. Cannot process."},
+	9803: {Code: 9803, Component: "Configuration", Name: "EAS_ERR_CALC_CONFIGCHKSUM_AND_PARAMSTORE_CONFIGCHKSUM_DONT_MATCH", Template: "The calculated configuration checksum [{0}] does not match the CONFIGURATION_CHECKSUM value in the parameter store [{1}]."},
+}
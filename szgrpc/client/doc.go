@@ -0,0 +1,12 @@
+/*
+The client package implements the senzing interfaces by calling a server of the szgrpc services.
+
+	conn, err := grpc.NewClient("localhost:8261", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	...
+	factory := &client.SzAbstractFactory{GrpcConnection: conn}
+	szEngine, err := factory.CreateSzEngine(ctx)
+
+Errors are rebuilt with szgrpc.FromStatus, so they match the szerror sentinels as the server's errors do.
+Destroy does nothing; the server owns the lifecycle of the objects it serves.
+*/
+package client
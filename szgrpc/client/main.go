@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigmanagerpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szdiagnosticpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szenginepb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szproductpb"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzAbstractFactory creates clients of the services on one connection.
+type SzAbstractFactory struct {
+	GrpcConnection grpc.ClientConnInterface
+}
+
+// ----------------------------------------------------------------------------
+// Methods - SzAbstractFactory
+// ----------------------------------------------------------------------------
+
+// The CreateSzConfig method returns an SzConfig client.
+func (factory *SzAbstractFactory) CreateSzConfig(ctx context.Context) (senzing.SzConfig, error) {
+	return &SzConfig{GrpcClient: szconfigpb.NewSzConfigClient(factory.GrpcConnection)}, ctx.Err()
+}
+
+// The CreateSzConfigManager method returns an SzConfigManager client.
+func (factory *SzAbstractFactory) CreateSzConfigManager(ctx context.Context) (senzing.SzConfigManager, error) {
+	return &SzConfigManager{GrpcClient: szconfigmanagerpb.NewSzConfigManagerClient(factory.GrpcConnection)}, ctx.Err()
+}
+
+// The CreateSzDiagnostic method returns an SzDiagnostic client.
+func (factory *SzAbstractFactory) CreateSzDiagnostic(ctx context.Context) (senzing.SzDiagnostic, error) {
+	return &SzDiagnostic{GrpcClient: szdiagnosticpb.NewSzDiagnosticClient(factory.GrpcConnection)}, ctx.Err()
+}
+
+// The CreateSzEngine method returns an SzEngine client.
+func (factory *SzAbstractFactory) CreateSzEngine(ctx context.Context) (senzing.SzEngine, error) {
+	return &SzEngine{GrpcClient: szenginepb.NewSzEngineClient(factory.GrpcConnection)}, ctx.Err()
+}
+
+// The CreateSzProduct method returns an SzProduct client.
+func (factory *SzAbstractFactory) CreateSzProduct(ctx context.Context) (senzing.SzProduct, error) {
+	return &SzProduct{GrpcClient: szproductpb.NewSzProductClient(factory.GrpcConnection)}, ctx.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// Deliver the values of a server stream as fragments, until io.EOF, an error, or ctx is done.
+func iterate(ctx context.Context, receive func() (string, error)) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment)
+	go func() {
+		defer close(result)
+		for {
+			value, err := receive()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			fragment := senzing.StringFragment{Value: value}
+			if err != nil {
+				fragment = senzing.StringFragment{Error: szgrpc.FromStatus(err)}
+			}
+			select {
+			case result <- fragment:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return result
+}
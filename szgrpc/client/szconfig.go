@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzConfig implements senzing.SzConfig by calling the SzConfig service of a server.
+type SzConfig struct {
+	GrpcClient szconfigpb.SzConfigClient
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *SzConfig) AddDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) (string, error) {
+	response, err := client.GrpcClient.AddDataSource(ctx, &szconfigpb.AddDataSourceRequest{ConfigHandle: uint64(configHandle), DataSourceCode: dataSourceCode})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfig) CloseConfig(ctx context.Context, configHandle uintptr) error {
+	_, err := client.GrpcClient.CloseConfig(ctx, &szconfigpb.CloseConfigRequest{ConfigHandle: uint64(configHandle)})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzConfig) CreateConfig(ctx context.Context) (uintptr, error) {
+	response, err := client.GrpcClient.CreateConfig(ctx, &szconfigpb.CreateConfigRequest{})
+	return uintptr(response.GetResult()), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfig) DeleteDataSource(ctx context.Context, configHandle uintptr, dataSourceCode string) error {
+	_, err := client.GrpcClient.DeleteDataSource(ctx, &szconfigpb.DeleteDataSourceRequest{ConfigHandle: uint64(configHandle), DataSourceCode: dataSourceCode})
+	return szgrpc.FromStatus(err)
+}
+
+// The Destroy method does nothing: the server owns the lifecycle of its SzConfig.
+func (client *SzConfig) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (client *SzConfig) ExportConfig(ctx context.Context, configHandle uintptr) (string, error) {
+	response, err := client.GrpcClient.ExportConfig(ctx, &szconfigpb.ExportConfigRequest{ConfigHandle: uint64(configHandle)})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfig) GetDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	response, err := client.GrpcClient.GetDataSources(ctx, &szconfigpb.GetDataSourcesRequest{ConfigHandle: uint64(configHandle)})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfig) ImportConfig(ctx context.Context, configDefinition string) (uintptr, error) {
+	response, err := client.GrpcClient.ImportConfig(ctx, &szconfigpb.ImportConfigRequest{ConfigDefinition: configDefinition})
+	return uintptr(response.GetResult()), szgrpc.FromStatus(err)
+}
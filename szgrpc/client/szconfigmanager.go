@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigmanagerpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzConfigManager implements senzing.SzConfigManager by calling the SzConfigManager service of a server.
+type SzConfigManager struct {
+	GrpcClient szconfigmanagerpb.SzConfigManagerClient
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *SzConfigManager) AddConfig(ctx context.Context, configDefinition string, configComments string) (int64, error) {
+	response, err := client.GrpcClient.AddConfig(ctx, &szconfigmanagerpb.AddConfigRequest{ConfigDefinition: configDefinition, ConfigComments: configComments})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+// The Destroy method does nothing: the server owns the lifecycle of its SzConfigManager.
+func (client *SzConfigManager) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (client *SzConfigManager) GetConfig(ctx context.Context, configID int64) (string, error) {
+	response, err := client.GrpcClient.GetConfig(ctx, &szconfigmanagerpb.GetConfigRequest{ConfigId: configID})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfigManager) GetConfigs(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetConfigs(ctx, &szconfigmanagerpb.GetConfigsRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	response, err := client.GrpcClient.GetDefaultConfigID(ctx, &szconfigmanagerpb.GetDefaultConfigIDRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzConfigManager) ReplaceDefaultConfigID(ctx context.Context, currentDefaultConfigID int64, newDefaultConfigID int64) error {
+	_, err := client.GrpcClient.ReplaceDefaultConfigID(ctx, &szconfigmanagerpb.ReplaceDefaultConfigIDRequest{CurrentDefaultConfigId: currentDefaultConfigID, NewDefaultConfigId: newDefaultConfigID})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzConfigManager) SetDefaultConfigID(ctx context.Context, configID int64) error {
+	_, err := client.GrpcClient.SetDefaultConfigID(ctx, &szconfigmanagerpb.SetDefaultConfigIDRequest{ConfigId: configID})
+	return szgrpc.FromStatus(err)
+}
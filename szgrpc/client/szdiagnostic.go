@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szdiagnosticpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzDiagnostic implements senzing.SzDiagnostic by calling the SzDiagnostic service of a server.
+type SzDiagnostic struct {
+	GrpcClient szdiagnosticpb.SzDiagnosticClient
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *SzDiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
+	response, err := client.GrpcClient.CheckDatastorePerformance(ctx, &szdiagnosticpb.CheckDatastorePerformanceRequest{SecondsToRun: int64(secondsToRun)})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+// The Destroy method does nothing: the server owns the lifecycle of its SzDiagnostic.
+func (client *SzDiagnostic) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (client *SzDiagnostic) GetDatastoreInfo(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetDatastoreInfo(ctx, &szdiagnosticpb.GetDatastoreInfoRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzDiagnostic) GetFeature(ctx context.Context, featureID int64) (string, error) {
+	response, err := client.GrpcClient.GetFeature(ctx, &szdiagnosticpb.GetFeatureRequest{FeatureId: featureID})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzDiagnostic) PurgeRepository(ctx context.Context) error {
+	_, err := client.GrpcClient.PurgeRepository(ctx, &szdiagnosticpb.PurgeRepositoryRequest{})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzDiagnostic) Reinitialize(ctx context.Context, configID int64) error {
+	_, err := client.GrpcClient.Reinitialize(ctx, &szdiagnosticpb.ReinitializeRequest{ConfigId: configID})
+	return szgrpc.FromStatus(err)
+}
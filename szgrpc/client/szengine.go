@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szenginepb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzEngine implements senzing.SzEngine by calling the SzEngine service of a server.
+type SzEngine struct {
+	GrpcClient szenginepb.SzEngineClient
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (client *SzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	response, err := client.GrpcClient.AddRecord(ctx, &szenginepb.AddRecordRequest{DataSourceCode: dataSourceCode, RecordId: recordID, RecordDefinition: recordDefinition, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) CloseExport(ctx context.Context, exportHandle uintptr) error {
+	_, err := client.GrpcClient.CloseExport(ctx, &szenginepb.CloseExportRequest{ExportHandle: uint64(exportHandle)})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	response, err := client.GrpcClient.CountRedoRecords(ctx, &szenginepb.CountRedoRecordsRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.DeleteRecord(ctx, &szenginepb.DeleteRecordRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+// The Destroy method does nothing: the server owns the lifecycle of its SzEngine.
+func (client *SzEngine) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (client *SzEngine) ExportCsvEntityReport(ctx context.Context, csvColumnList string, flags int64) (uintptr, error) {
+	response, err := client.GrpcClient.ExportCsvEntityReport(ctx, &szenginepb.ExportCsvEntityReportRequest{CsvColumnList: csvColumnList, Flags: flags})
+	return uintptr(response.GetResult()), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	stream, err := client.GrpcClient.ExportCsvEntityReportIterator(ctx, &szenginepb.ExportCsvEntityReportIteratorRequest{CsvColumnList: csvColumnList, Flags: flags})
+	if err != nil {
+		return iterate(ctx, func() (string, error) { return "", err })
+	}
+	return iterate(ctx, func() (string, error) {
+		response, err := stream.Recv()
+		return response.GetResult(), err
+	})
+}
+
+func (client *SzEngine) ExportJSONEntityReport(ctx context.Context, flags int64) (uintptr, error) {
+	response, err := client.GrpcClient.ExportJSONEntityReport(ctx, &szenginepb.ExportJSONEntityReportRequest{Flags: flags})
+	return uintptr(response.GetResult()), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	stream, err := client.GrpcClient.ExportJSONEntityReportIterator(ctx, &szenginepb.ExportJSONEntityReportIteratorRequest{Flags: flags})
+	if err != nil {
+		return iterate(ctx, func() (string, error) { return "", err })
+	}
+	return iterate(ctx, func() (string, error) {
+		response, err := stream.Recv()
+		return response.GetResult(), err
+	})
+}
+
+func (client *SzEngine) FetchNext(ctx context.Context, exportHandle uintptr) (string, error) {
+	response, err := client.GrpcClient.FetchNext(ctx, &szenginepb.FetchNextRequest{ExportHandle: uint64(exportHandle)})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindInterestingEntitiesByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindInterestingEntitiesByEntityID(ctx, &szenginepb.FindInterestingEntitiesByEntityIDRequest{EntityId: entityID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindInterestingEntitiesByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindInterestingEntitiesByRecordID(ctx, &szenginepb.FindInterestingEntitiesByRecordIDRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindNetworkByEntityID(ctx context.Context, entityIDs string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindNetworkByEntityID(ctx, &szenginepb.FindNetworkByEntityIDRequest{EntityIds: entityIDs, MaxDegrees: maxDegrees, BuildOutDegree: buildOutDegree, BuildOutMaxEntities: buildOutMaxEntities, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindNetworkByRecordID(ctx context.Context, recordKeys string, maxDegrees int64, buildOutDegree int64, buildOutMaxEntities int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindNetworkByRecordID(ctx, &szenginepb.FindNetworkByRecordIDRequest{RecordKeys: recordKeys, MaxDegrees: maxDegrees, BuildOutDegree: buildOutDegree, BuildOutMaxEntities: buildOutMaxEntities, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindPathByEntityID(ctx context.Context, startEntityID int64, endEntityID int64, maxDegrees int64, avoidEntityIDs string, requiredDataSources string, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindPathByEntityID(ctx, &szenginepb.FindPathByEntityIDRequest{StartEntityId: startEntityID, EndEntityId: endEntityID, MaxDegrees: maxDegrees, AvoidEntityIds: avoidEntityIDs, RequiredDataSources: requiredDataSources, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) FindPathByRecordID(ctx context.Context, startDataSourceCode string, startRecordID string, endDataSourceCode string, endRecordID string, maxDegrees int64, avoidRecordKeys string, requiredDataSources string, flags int64) (string, error) {
+	response, err := client.GrpcClient.FindPathByRecordID(ctx, &szenginepb.FindPathByRecordIDRequest{StartDataSourceCode: startDataSourceCode, StartRecordId: startRecordID, EndDataSourceCode: endDataSourceCode, EndRecordId: endRecordID, MaxDegrees: maxDegrees, AvoidRecordKeys: avoidRecordKeys, RequiredDataSources: requiredDataSources, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetActiveConfigID(ctx context.Context) (int64, error) {
+	response, err := client.GrpcClient.GetActiveConfigID(ctx, &szenginepb.GetActiveConfigIDRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.GetEntityByEntityID(ctx, &szenginepb.GetEntityByEntityIDRequest{EntityId: entityID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetEntityByRecordID(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.GetEntityByRecordID(ctx, &szenginepb.GetEntityByRecordIDRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.GetRecord(ctx, &szenginepb.GetRecordRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetRedoRecord(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetRedoRecord(ctx, &szenginepb.GetRedoRecordRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetStats(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetStats(ctx, &szenginepb.GetStatsRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) GetVirtualEntityByRecordID(ctx context.Context, recordList string, flags int64) (string, error) {
+	response, err := client.GrpcClient.GetVirtualEntityByRecordID(ctx, &szenginepb.GetVirtualEntityByRecordIDRequest{RecordList: recordList, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) HowEntityByEntityID(ctx context.Context, entityID int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.HowEntityByEntityID(ctx, &szenginepb.HowEntityByEntityIDRequest{EntityId: entityID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) PrimeEngine(ctx context.Context) error {
+	_, err := client.GrpcClient.PrimeEngine(ctx, &szenginepb.PrimeEngineRequest{})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) ProcessRedoRecord(ctx context.Context, redoRecord string, flags int64) (string, error) {
+	response, err := client.GrpcClient.ProcessRedoRecord(ctx, &szenginepb.ProcessRedoRecordRequest{RedoRecord: redoRecord, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) ReevaluateEntity(ctx context.Context, entityID int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.ReevaluateEntity(ctx, &szenginepb.ReevaluateEntityRequest{EntityId: entityID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) ReevaluateRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.ReevaluateRecord(ctx, &szenginepb.ReevaluateRecordRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) Reinitialize(ctx context.Context, configID int64) error {
+	_, err := client.GrpcClient.Reinitialize(ctx, &szenginepb.ReinitializeRequest{ConfigId: configID})
+	return szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) SearchByAttributes(ctx context.Context, attributes string, searchProfile string, flags int64) (string, error) {
+	response, err := client.GrpcClient.SearchByAttributes(ctx, &szenginepb.SearchByAttributesRequest{Attributes: attributes, SearchProfile: searchProfile, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) WhyEntities(ctx context.Context, entityID1 int64, entityID2 int64, flags int64) (string, error) {
+	response, err := client.GrpcClient.WhyEntities(ctx, &szenginepb.WhyEntitiesRequest{EntityId1: entityID1, EntityId2: entityID2, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) WhyRecordInEntity(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	response, err := client.GrpcClient.WhyRecordInEntity(ctx, &szenginepb.WhyRecordInEntityRequest{DataSourceCode: dataSourceCode, RecordId: recordID, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzEngine) WhyRecords(ctx context.Context, dataSourceCode1 string, recordID1 string, dataSourceCode2 string, recordID2 string, flags int64) (string, error) {
+	response, err := client.GrpcClient.WhyRecords(ctx, &szenginepb.WhyRecordsRequest{DataSourceCode1: dataSourceCode1, RecordId1: recordID1, DataSourceCode2: dataSourceCode2, RecordId2: recordID2, Flags: flags})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
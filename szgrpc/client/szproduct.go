@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szproductpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// SzProduct implements senzing.SzProduct by calling the SzProduct service of a server.
+type SzProduct struct {
+	GrpcClient szproductpb.SzProductClient
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+// The Destroy method does nothing: the server owns the lifecycle of its SzProduct.
+func (client *SzProduct) Destroy(ctx context.Context) error {
+	return nil
+}
+
+func (client *SzProduct) GetLicense(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetLicense(ctx, &szproductpb.GetLicenseRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
+
+func (client *SzProduct) GetVersion(ctx context.Context) (string, error) {
+	response, err := client.GrpcClient.GetVersion(ctx, &szproductpb.GetVersionRequest{})
+	return response.GetResult(), szgrpc.FromStatus(err)
+}
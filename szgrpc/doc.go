@@ -0,0 +1,11 @@
+/*
+The szgrpc package serves the Senzing SDK over gRPC.
+
+The service definitions in proto/ mirror the senzing interfaces; the sz*pb packages are generated from them with "make generate-proto".
+The server package serves any senzing.SzAbstractFactory, and the types of the client package implement the senzing interfaces.
+
+Errors cross the wire as gRPC statuses: ToStatus chooses a status code from the szerror classification of an error,
+and FromStatus rebuilds the classification, so that errors.Is(err, szerror.ErrSzNotFound) works on the client as on the server.
+Code and FromCode take the mapping between szerror types and gRPC codes from szerror.GRPCCode and szerror.FromGRPCCode.
+*/
+package szgrpc
@@ -0,0 +1,13 @@
+/*
+The server package serves the szgrpc services from any senzing.SzAbstractFactory.
+
+	server := &server.Server{Factory: factory}
+	grpcServer := grpc.NewServer()
+	err := server.Register(ctx, grpcServer)
+	...
+	err = grpcServer.Serve(listener)
+
+Errors are sent with szgrpc.ToStatus. Register creates one object of each interface, shared by all clients;
+Destroy destroys them once the gRPC server has stopped.
+*/
+package server
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigmanagerpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szdiagnosticpb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szenginepb"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szproductpb"
+	"google.golang.org/grpc"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A Server serves the five Sz services from the objects its Factory creates.
+type Server struct {
+	// Factory creates the objects served. Required.
+	Factory senzing.SzAbstractFactory
+
+	szConfig        senzing.SzConfig
+	szConfigManager senzing.SzConfigManager
+	szDiagnostic    senzing.SzDiagnostic
+	szEngine        senzing.SzEngine
+	szProduct       senzing.SzProduct
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Server
+// ----------------------------------------------------------------------------
+
+/*
+The Destroy method destroys the objects created by Register.
+Call it after the gRPC server has stopped.
+*/
+func (server *Server) Destroy(ctx context.Context) error {
+	errs := []error{}
+	destroy := func(destroyer interface{ Destroy(context.Context) error }) {
+		if destroyer != nil {
+			errs = append(errs, destroyer.Destroy(ctx))
+		}
+	}
+	destroy(server.szConfig)
+	destroy(server.szConfigManager)
+	destroy(server.szDiagnostic)
+	destroy(server.szEngine)
+	destroy(server.szProduct)
+	return errors.Join(errs...)
+}
+
+/*
+The Register method creates an object of each Sz interface with the Factory and registers its service.
+
+Input
+  - registrar: The gRPC server, e.g. from grpc.NewServer().
+*/
+func (server *Server) Register(ctx context.Context, registrar grpc.ServiceRegistrar) error {
+	var err error
+	if server.szConfig, err = server.Factory.CreateSzConfig(ctx); err != nil {
+		return err
+	}
+	if server.szConfigManager, err = server.Factory.CreateSzConfigManager(ctx); err != nil {
+		return err
+	}
+	if server.szDiagnostic, err = server.Factory.CreateSzDiagnostic(ctx); err != nil {
+		return err
+	}
+	if server.szEngine, err = server.Factory.CreateSzEngine(ctx); err != nil {
+		return err
+	}
+	if server.szProduct, err = server.Factory.CreateSzProduct(ctx); err != nil {
+		return err
+	}
+	szconfigpb.RegisterSzConfigServer(registrar, &szConfigServer{szConfig: server.szConfig})
+	szconfigmanagerpb.RegisterSzConfigManagerServer(registrar, &szConfigManagerServer{szConfigManager: server.szConfigManager})
+	szdiagnosticpb.RegisterSzDiagnosticServer(registrar, &szDiagnosticServer{szDiagnostic: server.szDiagnostic})
+	szenginepb.RegisterSzEngineServer(registrar, &szEngineServer{szEngine: server.szEngine})
+	szproductpb.RegisterSzProductServer(registrar, &szProductServer{szProduct: server.szProduct})
+	return nil
+}
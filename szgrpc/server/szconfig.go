@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Serves the SzConfig service from an SzConfig.
+type szConfigServer struct {
+	szconfigpb.UnimplementedSzConfigServer
+	szConfig senzing.SzConfig
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (server *szConfigServer) AddDataSource(ctx context.Context, request *szconfigpb.AddDataSourceRequest) (*szconfigpb.AddDataSourceResponse, error) {
+	result, err := server.szConfig.AddDataSource(ctx, uintptr(request.GetConfigHandle()), request.GetDataSourceCode())
+	return &szconfigpb.AddDataSourceResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) CloseConfig(ctx context.Context, request *szconfigpb.CloseConfigRequest) (*szconfigpb.CloseConfigResponse, error) {
+	err := server.szConfig.CloseConfig(ctx, uintptr(request.GetConfigHandle()))
+	return &szconfigpb.CloseConfigResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) CreateConfig(ctx context.Context, request *szconfigpb.CreateConfigRequest) (*szconfigpb.CreateConfigResponse, error) {
+	result, err := server.szConfig.CreateConfig(ctx)
+	return &szconfigpb.CreateConfigResponse{Result: uint64(result)}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) DeleteDataSource(ctx context.Context, request *szconfigpb.DeleteDataSourceRequest) (*szconfigpb.DeleteDataSourceResponse, error) {
+	err := server.szConfig.DeleteDataSource(ctx, uintptr(request.GetConfigHandle()), request.GetDataSourceCode())
+	return &szconfigpb.DeleteDataSourceResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) ExportConfig(ctx context.Context, request *szconfigpb.ExportConfigRequest) (*szconfigpb.ExportConfigResponse, error) {
+	result, err := server.szConfig.ExportConfig(ctx, uintptr(request.GetConfigHandle()))
+	return &szconfigpb.ExportConfigResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) GetDataSources(ctx context.Context, request *szconfigpb.GetDataSourcesRequest) (*szconfigpb.GetDataSourcesResponse, error) {
+	result, err := server.szConfig.GetDataSources(ctx, uintptr(request.GetConfigHandle()))
+	return &szconfigpb.GetDataSourcesResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigServer) ImportConfig(ctx context.Context, request *szconfigpb.ImportConfigRequest) (*szconfigpb.ImportConfigResponse, error) {
+	result, err := server.szConfig.ImportConfig(ctx, request.GetConfigDefinition())
+	return &szconfigpb.ImportConfigResponse{Result: uint64(result)}, szgrpc.ToStatus(err)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szconfigmanagerpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Serves the SzConfigManager service from an SzConfigManager.
+type szConfigManagerServer struct {
+	szconfigmanagerpb.UnimplementedSzConfigManagerServer
+	szConfigManager senzing.SzConfigManager
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (server *szConfigManagerServer) AddConfig(ctx context.Context, request *szconfigmanagerpb.AddConfigRequest) (*szconfigmanagerpb.AddConfigResponse, error) {
+	result, err := server.szConfigManager.AddConfig(ctx, request.GetConfigDefinition(), request.GetConfigComments())
+	return &szconfigmanagerpb.AddConfigResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigManagerServer) GetConfig(ctx context.Context, request *szconfigmanagerpb.GetConfigRequest) (*szconfigmanagerpb.GetConfigResponse, error) {
+	result, err := server.szConfigManager.GetConfig(ctx, request.GetConfigId())
+	return &szconfigmanagerpb.GetConfigResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigManagerServer) GetConfigs(ctx context.Context, request *szconfigmanagerpb.GetConfigsRequest) (*szconfigmanagerpb.GetConfigsResponse, error) {
+	result, err := server.szConfigManager.GetConfigs(ctx)
+	return &szconfigmanagerpb.GetConfigsResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigManagerServer) GetDefaultConfigID(ctx context.Context, request *szconfigmanagerpb.GetDefaultConfigIDRequest) (*szconfigmanagerpb.GetDefaultConfigIDResponse, error) {
+	result, err := server.szConfigManager.GetDefaultConfigID(ctx)
+	return &szconfigmanagerpb.GetDefaultConfigIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigManagerServer) ReplaceDefaultConfigID(ctx context.Context, request *szconfigmanagerpb.ReplaceDefaultConfigIDRequest) (*szconfigmanagerpb.ReplaceDefaultConfigIDResponse, error) {
+	err := server.szConfigManager.ReplaceDefaultConfigID(ctx, request.GetCurrentDefaultConfigId(), request.GetNewDefaultConfigId())
+	return &szconfigmanagerpb.ReplaceDefaultConfigIDResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szConfigManagerServer) SetDefaultConfigID(ctx context.Context, request *szconfigmanagerpb.SetDefaultConfigIDRequest) (*szconfigmanagerpb.SetDefaultConfigIDResponse, error) {
+	err := server.szConfigManager.SetDefaultConfigID(ctx, request.GetConfigId())
+	return &szconfigmanagerpb.SetDefaultConfigIDResponse{}, szgrpc.ToStatus(err)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szdiagnosticpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Serves the SzDiagnostic service from an SzDiagnostic.
+type szDiagnosticServer struct {
+	szdiagnosticpb.UnimplementedSzDiagnosticServer
+	szDiagnostic senzing.SzDiagnostic
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (server *szDiagnosticServer) CheckDatastorePerformance(ctx context.Context, request *szdiagnosticpb.CheckDatastorePerformanceRequest) (*szdiagnosticpb.CheckDatastorePerformanceResponse, error) {
+	result, err := server.szDiagnostic.CheckDatastorePerformance(ctx, int(request.GetSecondsToRun()))
+	return &szdiagnosticpb.CheckDatastorePerformanceResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szDiagnosticServer) GetDatastoreInfo(ctx context.Context, request *szdiagnosticpb.GetDatastoreInfoRequest) (*szdiagnosticpb.GetDatastoreInfoResponse, error) {
+	result, err := server.szDiagnostic.GetDatastoreInfo(ctx)
+	return &szdiagnosticpb.GetDatastoreInfoResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szDiagnosticServer) GetFeature(ctx context.Context, request *szdiagnosticpb.GetFeatureRequest) (*szdiagnosticpb.GetFeatureResponse, error) {
+	result, err := server.szDiagnostic.GetFeature(ctx, request.GetFeatureId())
+	return &szdiagnosticpb.GetFeatureResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szDiagnosticServer) PurgeRepository(ctx context.Context, request *szdiagnosticpb.PurgeRepositoryRequest) (*szdiagnosticpb.PurgeRepositoryResponse, error) {
+	err := server.szDiagnostic.PurgeRepository(ctx)
+	return &szdiagnosticpb.PurgeRepositoryResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szDiagnosticServer) Reinitialize(ctx context.Context, request *szdiagnosticpb.ReinitializeRequest) (*szdiagnosticpb.ReinitializeResponse, error) {
+	err := server.szDiagnostic.Reinitialize(ctx, request.GetConfigId())
+	return &szdiagnosticpb.ReinitializeResponse{}, szgrpc.ToStatus(err)
+}
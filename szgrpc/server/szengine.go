@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szenginepb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Serves the SzEngine service from an SzEngine.
+type szEngineServer struct {
+	szenginepb.UnimplementedSzEngineServer
+	szEngine senzing.SzEngine
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (server *szEngineServer) AddRecord(ctx context.Context, request *szenginepb.AddRecordRequest) (*szenginepb.AddRecordResponse, error) {
+	result, err := server.szEngine.AddRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetRecordDefinition(), request.GetFlags())
+	return &szenginepb.AddRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) CloseExport(ctx context.Context, request *szenginepb.CloseExportRequest) (*szenginepb.CloseExportResponse, error) {
+	err := server.szEngine.CloseExport(ctx, uintptr(request.GetExportHandle()))
+	return &szenginepb.CloseExportResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) CountRedoRecords(ctx context.Context, request *szenginepb.CountRedoRecordsRequest) (*szenginepb.CountRedoRecordsResponse, error) {
+	result, err := server.szEngine.CountRedoRecords(ctx)
+	return &szenginepb.CountRedoRecordsResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) DeleteRecord(ctx context.Context, request *szenginepb.DeleteRecordRequest) (*szenginepb.DeleteRecordResponse, error) {
+	result, err := server.szEngine.DeleteRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.DeleteRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ExportCsvEntityReport(ctx context.Context, request *szenginepb.ExportCsvEntityReportRequest) (*szenginepb.ExportCsvEntityReportResponse, error) {
+	result, err := server.szEngine.ExportCsvEntityReport(ctx, request.GetCsvColumnList(), request.GetFlags())
+	return &szenginepb.ExportCsvEntityReportResponse{Result: uint64(result)}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ExportCsvEntityReportIterator(request *szenginepb.ExportCsvEntityReportIteratorRequest, stream szenginepb.SzEngine_ExportCsvEntityReportIteratorServer) error {
+	for fragment := range server.szEngine.ExportCsvEntityReportIterator(stream.Context(), request.GetCsvColumnList(), request.GetFlags()) {
+		if fragment.Error != nil {
+			return szgrpc.ToStatus(fragment.Error)
+		}
+		if err := stream.Send(&szenginepb.ExportCsvEntityReportIteratorResponse{Result: fragment.Value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (server *szEngineServer) ExportJSONEntityReport(ctx context.Context, request *szenginepb.ExportJSONEntityReportRequest) (*szenginepb.ExportJSONEntityReportResponse, error) {
+	result, err := server.szEngine.ExportJSONEntityReport(ctx, request.GetFlags())
+	return &szenginepb.ExportJSONEntityReportResponse{Result: uint64(result)}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ExportJSONEntityReportIterator(request *szenginepb.ExportJSONEntityReportIteratorRequest, stream szenginepb.SzEngine_ExportJSONEntityReportIteratorServer) error {
+	for fragment := range server.szEngine.ExportJSONEntityReportIterator(stream.Context(), request.GetFlags()) {
+		if fragment.Error != nil {
+			return szgrpc.ToStatus(fragment.Error)
+		}
+		if err := stream.Send(&szenginepb.ExportJSONEntityReportIteratorResponse{Result: fragment.Value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (server *szEngineServer) FetchNext(ctx context.Context, request *szenginepb.FetchNextRequest) (*szenginepb.FetchNextResponse, error) {
+	result, err := server.szEngine.FetchNext(ctx, uintptr(request.GetExportHandle()))
+	return &szenginepb.FetchNextResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindInterestingEntitiesByEntityID(ctx context.Context, request *szenginepb.FindInterestingEntitiesByEntityIDRequest) (*szenginepb.FindInterestingEntitiesByEntityIDResponse, error) {
+	result, err := server.szEngine.FindInterestingEntitiesByEntityID(ctx, request.GetEntityId(), request.GetFlags())
+	return &szenginepb.FindInterestingEntitiesByEntityIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindInterestingEntitiesByRecordID(ctx context.Context, request *szenginepb.FindInterestingEntitiesByRecordIDRequest) (*szenginepb.FindInterestingEntitiesByRecordIDResponse, error) {
+	result, err := server.szEngine.FindInterestingEntitiesByRecordID(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.FindInterestingEntitiesByRecordIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindNetworkByEntityID(ctx context.Context, request *szenginepb.FindNetworkByEntityIDRequest) (*szenginepb.FindNetworkByEntityIDResponse, error) {
+	result, err := server.szEngine.FindNetworkByEntityID(ctx, request.GetEntityIds(), request.GetMaxDegrees(), request.GetBuildOutDegree(), request.GetBuildOutMaxEntities(), request.GetFlags())
+	return &szenginepb.FindNetworkByEntityIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindNetworkByRecordID(ctx context.Context, request *szenginepb.FindNetworkByRecordIDRequest) (*szenginepb.FindNetworkByRecordIDResponse, error) {
+	result, err := server.szEngine.FindNetworkByRecordID(ctx, request.GetRecordKeys(), request.GetMaxDegrees(), request.GetBuildOutDegree(), request.GetBuildOutMaxEntities(), request.GetFlags())
+	return &szenginepb.FindNetworkByRecordIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindPathByEntityID(ctx context.Context, request *szenginepb.FindPathByEntityIDRequest) (*szenginepb.FindPathByEntityIDResponse, error) {
+	result, err := server.szEngine.FindPathByEntityID(ctx, request.GetStartEntityId(), request.GetEndEntityId(), request.GetMaxDegrees(), request.GetAvoidEntityIds(), request.GetRequiredDataSources(), request.GetFlags())
+	return &szenginepb.FindPathByEntityIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) FindPathByRecordID(ctx context.Context, request *szenginepb.FindPathByRecordIDRequest) (*szenginepb.FindPathByRecordIDResponse, error) {
+	result, err := server.szEngine.FindPathByRecordID(ctx, request.GetStartDataSourceCode(), request.GetStartRecordId(), request.GetEndDataSourceCode(), request.GetEndRecordId(), request.GetMaxDegrees(), request.GetAvoidRecordKeys(), request.GetRequiredDataSources(), request.GetFlags())
+	return &szenginepb.FindPathByRecordIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetActiveConfigID(ctx context.Context, request *szenginepb.GetActiveConfigIDRequest) (*szenginepb.GetActiveConfigIDResponse, error) {
+	result, err := server.szEngine.GetActiveConfigID(ctx)
+	return &szenginepb.GetActiveConfigIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetEntityByEntityID(ctx context.Context, request *szenginepb.GetEntityByEntityIDRequest) (*szenginepb.GetEntityByEntityIDResponse, error) {
+	result, err := server.szEngine.GetEntityByEntityID(ctx, request.GetEntityId(), request.GetFlags())
+	return &szenginepb.GetEntityByEntityIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetEntityByRecordID(ctx context.Context, request *szenginepb.GetEntityByRecordIDRequest) (*szenginepb.GetEntityByRecordIDResponse, error) {
+	result, err := server.szEngine.GetEntityByRecordID(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.GetEntityByRecordIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetRecord(ctx context.Context, request *szenginepb.GetRecordRequest) (*szenginepb.GetRecordResponse, error) {
+	result, err := server.szEngine.GetRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.GetRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetRedoRecord(ctx context.Context, request *szenginepb.GetRedoRecordRequest) (*szenginepb.GetRedoRecordResponse, error) {
+	result, err := server.szEngine.GetRedoRecord(ctx)
+	return &szenginepb.GetRedoRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetStats(ctx context.Context, request *szenginepb.GetStatsRequest) (*szenginepb.GetStatsResponse, error) {
+	result, err := server.szEngine.GetStats(ctx)
+	return &szenginepb.GetStatsResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) GetVirtualEntityByRecordID(ctx context.Context, request *szenginepb.GetVirtualEntityByRecordIDRequest) (*szenginepb.GetVirtualEntityByRecordIDResponse, error) {
+	result, err := server.szEngine.GetVirtualEntityByRecordID(ctx, request.GetRecordList(), request.GetFlags())
+	return &szenginepb.GetVirtualEntityByRecordIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) HowEntityByEntityID(ctx context.Context, request *szenginepb.HowEntityByEntityIDRequest) (*szenginepb.HowEntityByEntityIDResponse, error) {
+	result, err := server.szEngine.HowEntityByEntityID(ctx, request.GetEntityId(), request.GetFlags())
+	return &szenginepb.HowEntityByEntityIDResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) PrimeEngine(ctx context.Context, request *szenginepb.PrimeEngineRequest) (*szenginepb.PrimeEngineResponse, error) {
+	err := server.szEngine.PrimeEngine(ctx)
+	return &szenginepb.PrimeEngineResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ProcessRedoRecord(ctx context.Context, request *szenginepb.ProcessRedoRecordRequest) (*szenginepb.ProcessRedoRecordResponse, error) {
+	result, err := server.szEngine.ProcessRedoRecord(ctx, request.GetRedoRecord(), request.GetFlags())
+	return &szenginepb.ProcessRedoRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ReevaluateEntity(ctx context.Context, request *szenginepb.ReevaluateEntityRequest) (*szenginepb.ReevaluateEntityResponse, error) {
+	result, err := server.szEngine.ReevaluateEntity(ctx, request.GetEntityId(), request.GetFlags())
+	return &szenginepb.ReevaluateEntityResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) ReevaluateRecord(ctx context.Context, request *szenginepb.ReevaluateRecordRequest) (*szenginepb.ReevaluateRecordResponse, error) {
+	result, err := server.szEngine.ReevaluateRecord(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.ReevaluateRecordResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) Reinitialize(ctx context.Context, request *szenginepb.ReinitializeRequest) (*szenginepb.ReinitializeResponse, error) {
+	err := server.szEngine.Reinitialize(ctx, request.GetConfigId())
+	return &szenginepb.ReinitializeResponse{}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) SearchByAttributes(ctx context.Context, request *szenginepb.SearchByAttributesRequest) (*szenginepb.SearchByAttributesResponse, error) {
+	result, err := server.szEngine.SearchByAttributes(ctx, request.GetAttributes(), request.GetSearchProfile(), request.GetFlags())
+	return &szenginepb.SearchByAttributesResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) WhyEntities(ctx context.Context, request *szenginepb.WhyEntitiesRequest) (*szenginepb.WhyEntitiesResponse, error) {
+	result, err := server.szEngine.WhyEntities(ctx, request.GetEntityId1(), request.GetEntityId2(), request.GetFlags())
+	return &szenginepb.WhyEntitiesResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) WhyRecordInEntity(ctx context.Context, request *szenginepb.WhyRecordInEntityRequest) (*szenginepb.WhyRecordInEntityResponse, error) {
+	result, err := server.szEngine.WhyRecordInEntity(ctx, request.GetDataSourceCode(), request.GetRecordId(), request.GetFlags())
+	return &szenginepb.WhyRecordInEntityResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szEngineServer) WhyRecords(ctx context.Context, request *szenginepb.WhyRecordsRequest) (*szenginepb.WhyRecordsResponse, error) {
+	result, err := server.szEngine.WhyRecords(ctx, request.GetDataSourceCode1(), request.GetRecordId1(), request.GetDataSourceCode2(), request.GetRecordId2(), request.GetFlags())
+	return &szenginepb.WhyRecordsResponse{Result: result}, szgrpc.ToStatus(err)
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szproductpb"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Serves the SzProduct service from an SzProduct.
+type szProductServer struct {
+	szproductpb.UnimplementedSzProductServer
+	szProduct senzing.SzProduct
+}
+
+// ----------------------------------------------------------------------------
+// Methods
+// ----------------------------------------------------------------------------
+
+func (server *szProductServer) GetLicense(ctx context.Context, request *szproductpb.GetLicenseRequest) (*szproductpb.GetLicenseResponse, error) {
+	result, err := server.szProduct.GetLicense(ctx)
+	return &szproductpb.GetLicenseResponse{Result: result}, szgrpc.ToStatus(err)
+}
+
+func (server *szProductServer) GetVersion(ctx context.Context, request *szproductpb.GetVersionRequest) (*szproductpb.GetVersionResponse, error) {
+	result, err := server.szProduct.GetVersion(ctx)
+	return &szproductpb.GetVersionResponse{Result: result}, szgrpc.ToStatus(err)
+}
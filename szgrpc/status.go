@@ -0,0 +1,128 @@
+package szgrpc
+
+import (
+	"context"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/szerrorpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An error rebuilt by FromStatus. It unwraps to the szerror classification and keeps its gRPC status.
+type statusError struct {
+	causes []error
+	status *status.Status
+}
+
+// ----------------------------------------------------------------------------
+// Methods - statusError
+// ----------------------------------------------------------------------------
+
+func (err *statusError) Error() string {
+	return err.status.Message()
+}
+
+func (err *statusError) GRPCStatus() *status.Status {
+	return err.status
+}
+
+func (err *statusError) Unwrap() []error {
+	return err.causes
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Code function returns the gRPC status code for an error.
+gRPC statuses keep their code; other errors map as szerror.GRPCCode, e.g. SzNotFound to NotFound,
+and SzRetryTimeoutExceeded to Unavailable.
+
+Input
+  - err: The error returned by a Senzing method.
+*/
+func Code(err error) codes.Code {
+	if statusCarrier, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return statusCarrier.GRPCStatus().Code()
+	}
+	return codes.Code(szerror.GRPCCode(err))
+}
+
+/*
+The FromCode function returns an error with the szerror types a client assumes for a gRPC code, as szerror.FromGRPCCode,
+e.g. SzNotFound and SzBadInput for NotFound. It returns nil for OK.
+
+Input
+  - code: The gRPC status code.
+  - message: The message to be returned by err.Error().
+*/
+func FromCode(code codes.Code, message string) error {
+	return szerror.FromGRPCCode(uint32(code), message)
+}
+
+/*
+The FromStatus function rebuilds the error a server passed to ToStatus.
+The result matches the same szerror sentinels with errors.Is, has the message of the original error,
+and still carries its gRPC status for status.Code.
+Errors that are not gRPC statuses are returned unchanged.
+
+Input
+  - err: The error returned by a gRPC client call.
+*/
+func FromStatus(err error) error {
+	grpcStatus, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+	causes := []error{}
+	for _, detail := range grpcStatus.Details() {
+		if szError, ok := detail.(*szerrorpb.SzError); ok {
+			problem := &szerror.Problem{Detail: grpcStatus.Message(), Types: szError.GetTypes()}
+			causes = append(causes, problem.Err())
+		}
+	}
+	switch {
+	case grpcStatus.Code() == codes.Canceled:
+		causes = append(causes, context.Canceled)
+	case grpcStatus.Code() == codes.DeadlineExceeded && len(causes) == 0:
+		causes = append(causes, context.DeadlineExceeded)
+	case len(causes) == 0:
+		causes = append(causes, FromCode(grpcStatus.Code(), grpcStatus.Message()))
+	}
+	return &statusError{
+		causes: causes,
+		status: grpcStatus,
+	}
+}
+
+/*
+The ToStatus function converts an error returned by a Senzing method into a gRPC status error.
+The status code is Code(err); the szerror types of the error are attached as an SzError detail for FromStatus.
+The message and types are those of szerror.NewProblem.
+Errors that are already gRPC statuses are returned unchanged.
+
+Input
+  - err: The error returned by a Senzing method.
+*/
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+	problem := szerror.NewProblem(err)
+	grpcStatus := status.New(Code(err), problem.Detail)
+	if len(problem.Types) > 0 {
+		if detailed, detailErr := grpcStatus.WithDetails(&szerrorpb.SzError{Types: problem.Types}); detailErr == nil {
+			grpcStatus = detailed
+		}
+	}
+	return grpcStatus.Err()
+}
@@ -0,0 +1,885 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: szconfigmanager.proto
+
+package szconfigmanagerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigDefinition string `protobuf:"bytes,1,opt,name=config_definition,json=configDefinition,proto3" json:"config_definition,omitempty"`
+	ConfigComments   string `protobuf:"bytes,2,opt,name=config_comments,json=configComments,proto3" json:"config_comments,omitempty"`
+}
+
+func (x *AddConfigRequest) Reset() {
+	*x = AddConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddConfigRequest) ProtoMessage() {}
+
+func (x *AddConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddConfigRequest.ProtoReflect.Descriptor instead.
+func (*AddConfigRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddConfigRequest) GetConfigDefinition() string {
+	if x != nil {
+		return x.ConfigDefinition
+	}
+	return ""
+}
+
+func (x *AddConfigRequest) GetConfigComments() string {
+	if x != nil {
+		return x.ConfigComments
+	}
+	return ""
+}
+
+type AddConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result int64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *AddConfigResponse) Reset() {
+	*x = AddConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddConfigResponse) ProtoMessage() {}
+
+func (x *AddConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddConfigResponse.ProtoReflect.Descriptor instead.
+func (*AddConfigResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddConfigResponse) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigId int64 `protobuf:"varint,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConfigRequest) GetConfigId() int64 {
+	if x != nil {
+		return x.ConfigId
+	}
+	return 0
+}
+
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetConfigResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConfigsRequest) Reset() {
+	*x = GetConfigsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigsRequest) ProtoMessage() {}
+
+func (x *GetConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigsRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{4}
+}
+
+type GetConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetConfigsResponse) Reset() {
+	*x = GetConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigsResponse) ProtoMessage() {}
+
+func (x *GetConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigsResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetConfigsResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetDefaultConfigIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDefaultConfigIDRequest) Reset() {
+	*x = GetDefaultConfigIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDefaultConfigIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultConfigIDRequest) ProtoMessage() {}
+
+func (x *GetDefaultConfigIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultConfigIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultConfigIDRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{6}
+}
+
+type GetDefaultConfigIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result int64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetDefaultConfigIDResponse) Reset() {
+	*x = GetDefaultConfigIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDefaultConfigIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultConfigIDResponse) ProtoMessage() {}
+
+func (x *GetDefaultConfigIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultConfigIDResponse.ProtoReflect.Descriptor instead.
+func (*GetDefaultConfigIDResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDefaultConfigIDResponse) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type ReplaceDefaultConfigIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentDefaultConfigId int64 `protobuf:"varint,1,opt,name=current_default_config_id,json=currentDefaultConfigId,proto3" json:"current_default_config_id,omitempty"`
+	NewDefaultConfigId     int64 `protobuf:"varint,2,opt,name=new_default_config_id,json=newDefaultConfigId,proto3" json:"new_default_config_id,omitempty"`
+}
+
+func (x *ReplaceDefaultConfigIDRequest) Reset() {
+	*x = ReplaceDefaultConfigIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceDefaultConfigIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceDefaultConfigIDRequest) ProtoMessage() {}
+
+func (x *ReplaceDefaultConfigIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceDefaultConfigIDRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceDefaultConfigIDRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplaceDefaultConfigIDRequest) GetCurrentDefaultConfigId() int64 {
+	if x != nil {
+		return x.CurrentDefaultConfigId
+	}
+	return 0
+}
+
+func (x *ReplaceDefaultConfigIDRequest) GetNewDefaultConfigId() int64 {
+	if x != nil {
+		return x.NewDefaultConfigId
+	}
+	return 0
+}
+
+type ReplaceDefaultConfigIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplaceDefaultConfigIDResponse) Reset() {
+	*x = ReplaceDefaultConfigIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceDefaultConfigIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceDefaultConfigIDResponse) ProtoMessage() {}
+
+func (x *ReplaceDefaultConfigIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceDefaultConfigIDResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceDefaultConfigIDResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{9}
+}
+
+type SetDefaultConfigIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigId int64 `protobuf:"varint,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+}
+
+func (x *SetDefaultConfigIDRequest) Reset() {
+	*x = SetDefaultConfigIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultConfigIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultConfigIDRequest) ProtoMessage() {}
+
+func (x *SetDefaultConfigIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultConfigIDRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultConfigIDRequest) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetDefaultConfigIDRequest) GetConfigId() int64 {
+	if x != nil {
+		return x.ConfigId
+	}
+	return 0
+}
+
+type SetDefaultConfigIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDefaultConfigIDResponse) Reset() {
+	*x = SetDefaultConfigIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szconfigmanager_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultConfigIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultConfigIDResponse) ProtoMessage() {}
+
+func (x *SetDefaultConfigIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szconfigmanager_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultConfigIDResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultConfigIDResponse) Descriptor() ([]byte, []int) {
+	return file_szconfigmanager_proto_rawDescGZIP(), []int{11}
+}
+
+var File_szconfigmanager_proto protoreflect.FileDescriptor
+
+var file_szconfigmanager_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2b,
+	0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2c,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1b, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x8d, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15,
+	0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x77,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22,
+	0x20, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x38, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfc, 0x05, 0x0a, 0x0f, 0x53, 0x7a,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x6a, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6e,
+	0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x36, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x3a, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x85, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x36, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2d, 0x67,
+	0x61, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x7a, 0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x7a, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x7a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_szconfigmanager_proto_rawDescOnce sync.Once
+	file_szconfigmanager_proto_rawDescData = file_szconfigmanager_proto_rawDesc
+)
+
+func file_szconfigmanager_proto_rawDescGZIP() []byte {
+	file_szconfigmanager_proto_rawDescOnce.Do(func() {
+		file_szconfigmanager_proto_rawDescData = protoimpl.X.CompressGZIP(file_szconfigmanager_proto_rawDescData)
+	})
+	return file_szconfigmanager_proto_rawDescData
+}
+
+var file_szconfigmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_szconfigmanager_proto_goTypes = []any{
+	(*AddConfigRequest)(nil),               // 0: senzing.sdk.szconfigmanager.AddConfigRequest
+	(*AddConfigResponse)(nil),              // 1: senzing.sdk.szconfigmanager.AddConfigResponse
+	(*GetConfigRequest)(nil),               // 2: senzing.sdk.szconfigmanager.GetConfigRequest
+	(*GetConfigResponse)(nil),              // 3: senzing.sdk.szconfigmanager.GetConfigResponse
+	(*GetConfigsRequest)(nil),              // 4: senzing.sdk.szconfigmanager.GetConfigsRequest
+	(*GetConfigsResponse)(nil),             // 5: senzing.sdk.szconfigmanager.GetConfigsResponse
+	(*GetDefaultConfigIDRequest)(nil),      // 6: senzing.sdk.szconfigmanager.GetDefaultConfigIDRequest
+	(*GetDefaultConfigIDResponse)(nil),     // 7: senzing.sdk.szconfigmanager.GetDefaultConfigIDResponse
+	(*ReplaceDefaultConfigIDRequest)(nil),  // 8: senzing.sdk.szconfigmanager.ReplaceDefaultConfigIDRequest
+	(*ReplaceDefaultConfigIDResponse)(nil), // 9: senzing.sdk.szconfigmanager.ReplaceDefaultConfigIDResponse
+	(*SetDefaultConfigIDRequest)(nil),      // 10: senzing.sdk.szconfigmanager.SetDefaultConfigIDRequest
+	(*SetDefaultConfigIDResponse)(nil),     // 11: senzing.sdk.szconfigmanager.SetDefaultConfigIDResponse
+}
+var file_szconfigmanager_proto_depIdxs = []int32{
+	0,  // 0: senzing.sdk.szconfigmanager.SzConfigManager.AddConfig:input_type -> senzing.sdk.szconfigmanager.AddConfigRequest
+	2,  // 1: senzing.sdk.szconfigmanager.SzConfigManager.GetConfig:input_type -> senzing.sdk.szconfigmanager.GetConfigRequest
+	4,  // 2: senzing.sdk.szconfigmanager.SzConfigManager.GetConfigs:input_type -> senzing.sdk.szconfigmanager.GetConfigsRequest
+	6,  // 3: senzing.sdk.szconfigmanager.SzConfigManager.GetDefaultConfigID:input_type -> senzing.sdk.szconfigmanager.GetDefaultConfigIDRequest
+	8,  // 4: senzing.sdk.szconfigmanager.SzConfigManager.ReplaceDefaultConfigID:input_type -> senzing.sdk.szconfigmanager.ReplaceDefaultConfigIDRequest
+	10, // 5: senzing.sdk.szconfigmanager.SzConfigManager.SetDefaultConfigID:input_type -> senzing.sdk.szconfigmanager.SetDefaultConfigIDRequest
+	1,  // 6: senzing.sdk.szconfigmanager.SzConfigManager.AddConfig:output_type -> senzing.sdk.szconfigmanager.AddConfigResponse
+	3,  // 7: senzing.sdk.szconfigmanager.SzConfigManager.GetConfig:output_type -> senzing.sdk.szconfigmanager.GetConfigResponse
+	5,  // 8: senzing.sdk.szconfigmanager.SzConfigManager.GetConfigs:output_type -> senzing.sdk.szconfigmanager.GetConfigsResponse
+	7,  // 9: senzing.sdk.szconfigmanager.SzConfigManager.GetDefaultConfigID:output_type -> senzing.sdk.szconfigmanager.GetDefaultConfigIDResponse
+	9,  // 10: senzing.sdk.szconfigmanager.SzConfigManager.ReplaceDefaultConfigID:output_type -> senzing.sdk.szconfigmanager.ReplaceDefaultConfigIDResponse
+	11, // 11: senzing.sdk.szconfigmanager.SzConfigManager.SetDefaultConfigID:output_type -> senzing.sdk.szconfigmanager.SetDefaultConfigIDResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_szconfigmanager_proto_init() }
+func file_szconfigmanager_proto_init() {
+	if File_szconfigmanager_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_szconfigmanager_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AddConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDefaultConfigIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDefaultConfigIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceDefaultConfigIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceDefaultConfigIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDefaultConfigIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szconfigmanager_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDefaultConfigIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_szconfigmanager_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szconfigmanager_proto_goTypes,
+		DependencyIndexes: file_szconfigmanager_proto_depIdxs,
+		MessageInfos:      file_szconfigmanager_proto_msgTypes,
+	}.Build()
+	File_szconfigmanager_proto = out.File
+	file_szconfigmanager_proto_rawDesc = nil
+	file_szconfigmanager_proto_goTypes = nil
+	file_szconfigmanager_proto_depIdxs = nil
+}
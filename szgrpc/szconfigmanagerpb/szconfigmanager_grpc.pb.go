@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: szconfigmanager.proto
+
+package szconfigmanagerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	SzConfigManager_AddConfig_FullMethodName              = "/senzing.sdk.szconfigmanager.SzConfigManager/AddConfig"
+	SzConfigManager_GetConfig_FullMethodName              = "/senzing.sdk.szconfigmanager.SzConfigManager/GetConfig"
+	SzConfigManager_GetConfigs_FullMethodName             = "/senzing.sdk.szconfigmanager.SzConfigManager/GetConfigs"
+	SzConfigManager_GetDefaultConfigID_FullMethodName     = "/senzing.sdk.szconfigmanager.SzConfigManager/GetDefaultConfigID"
+	SzConfigManager_ReplaceDefaultConfigID_FullMethodName = "/senzing.sdk.szconfigmanager.SzConfigManager/ReplaceDefaultConfigID"
+	SzConfigManager_SetDefaultConfigID_FullMethodName     = "/senzing.sdk.szconfigmanager.SzConfigManager/SetDefaultConfigID"
+)
+
+// SzConfigManagerClient is the client API for SzConfigManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The SzConfigManager service mirrors the senzing.SzConfigManager interface.
+// Destroy is not a remote call: the server owns the lifecycle of its SzConfigManager.
+type SzConfigManagerClient interface {
+	AddConfig(ctx context.Context, in *AddConfigRequest, opts ...grpc.CallOption) (*AddConfigResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	GetConfigs(ctx context.Context, in *GetConfigsRequest, opts ...grpc.CallOption) (*GetConfigsResponse, error)
+	GetDefaultConfigID(ctx context.Context, in *GetDefaultConfigIDRequest, opts ...grpc.CallOption) (*GetDefaultConfigIDResponse, error)
+	ReplaceDefaultConfigID(ctx context.Context, in *ReplaceDefaultConfigIDRequest, opts ...grpc.CallOption) (*ReplaceDefaultConfigIDResponse, error)
+	SetDefaultConfigID(ctx context.Context, in *SetDefaultConfigIDRequest, opts ...grpc.CallOption) (*SetDefaultConfigIDResponse, error)
+}
+
+type szConfigManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzConfigManagerClient(cc grpc.ClientConnInterface) SzConfigManagerClient {
+	return &szConfigManagerClient{cc}
+}
+
+func (c *szConfigManagerClient) AddConfig(ctx context.Context, in *AddConfigRequest, opts ...grpc.CallOption) (*AddConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddConfigResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_AddConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szConfigManagerClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szConfigManagerClient) GetConfigs(ctx context.Context, in *GetConfigsRequest, opts ...grpc.CallOption) (*GetConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigsResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_GetConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szConfigManagerClient) GetDefaultConfigID(ctx context.Context, in *GetDefaultConfigIDRequest, opts ...grpc.CallOption) (*GetDefaultConfigIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDefaultConfigIDResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_GetDefaultConfigID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szConfigManagerClient) ReplaceDefaultConfigID(ctx context.Context, in *ReplaceDefaultConfigIDRequest, opts ...grpc.CallOption) (*ReplaceDefaultConfigIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceDefaultConfigIDResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_ReplaceDefaultConfigID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szConfigManagerClient) SetDefaultConfigID(ctx context.Context, in *SetDefaultConfigIDRequest, opts ...grpc.CallOption) (*SetDefaultConfigIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultConfigIDResponse)
+	err := c.cc.Invoke(ctx, SzConfigManager_SetDefaultConfigID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SzConfigManagerServer is the server API for SzConfigManager service.
+// All implementations must embed UnimplementedSzConfigManagerServer
+// for forward compatibility
+//
+// The SzConfigManager service mirrors the senzing.SzConfigManager interface.
+// Destroy is not a remote call: the server owns the lifecycle of its SzConfigManager.
+type SzConfigManagerServer interface {
+	AddConfig(context.Context, *AddConfigRequest) (*AddConfigResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetConfigs(context.Context, *GetConfigsRequest) (*GetConfigsResponse, error)
+	GetDefaultConfigID(context.Context, *GetDefaultConfigIDRequest) (*GetDefaultConfigIDResponse, error)
+	ReplaceDefaultConfigID(context.Context, *ReplaceDefaultConfigIDRequest) (*ReplaceDefaultConfigIDResponse, error)
+	SetDefaultConfigID(context.Context, *SetDefaultConfigIDRequest) (*SetDefaultConfigIDResponse, error)
+	mustEmbedUnimplementedSzConfigManagerServer()
+}
+
+// UnimplementedSzConfigManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedSzConfigManagerServer struct {
+}
+
+func (UnimplementedSzConfigManagerServer) AddConfig(context.Context, *AddConfigRequest) (*AddConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConfig not implemented")
+}
+func (UnimplementedSzConfigManagerServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedSzConfigManagerServer) GetConfigs(context.Context, *GetConfigsRequest) (*GetConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigs not implemented")
+}
+func (UnimplementedSzConfigManagerServer) GetDefaultConfigID(context.Context, *GetDefaultConfigIDRequest) (*GetDefaultConfigIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultConfigID not implemented")
+}
+func (UnimplementedSzConfigManagerServer) ReplaceDefaultConfigID(context.Context, *ReplaceDefaultConfigIDRequest) (*ReplaceDefaultConfigIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDefaultConfigID not implemented")
+}
+func (UnimplementedSzConfigManagerServer) SetDefaultConfigID(context.Context, *SetDefaultConfigIDRequest) (*SetDefaultConfigIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultConfigID not implemented")
+}
+func (UnimplementedSzConfigManagerServer) mustEmbedUnimplementedSzConfigManagerServer() {}
+
+// UnsafeSzConfigManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzConfigManagerServer will
+// result in compilation errors.
+type UnsafeSzConfigManagerServer interface {
+	mustEmbedUnimplementedSzConfigManagerServer()
+}
+
+func RegisterSzConfigManagerServer(s grpc.ServiceRegistrar, srv SzConfigManagerServer) {
+	s.RegisterService(&SzConfigManager_ServiceDesc, srv)
+}
+
+func _SzConfigManager_AddConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).AddConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_AddConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).AddConfig(ctx, req.(*AddConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzConfigManager_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzConfigManager_GetConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).GetConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_GetConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).GetConfigs(ctx, req.(*GetConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzConfigManager_GetDefaultConfigID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultConfigIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).GetDefaultConfigID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_GetDefaultConfigID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).GetDefaultConfigID(ctx, req.(*GetDefaultConfigIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzConfigManager_ReplaceDefaultConfigID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceDefaultConfigIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).ReplaceDefaultConfigID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_ReplaceDefaultConfigID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).ReplaceDefaultConfigID(ctx, req.(*ReplaceDefaultConfigIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzConfigManager_SetDefaultConfigID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultConfigIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzConfigManagerServer).SetDefaultConfigID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzConfigManager_SetDefaultConfigID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzConfigManagerServer).SetDefaultConfigID(ctx, req.(*SetDefaultConfigIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SzConfigManager_ServiceDesc is the grpc.ServiceDesc for SzConfigManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzConfigManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "senzing.sdk.szconfigmanager.SzConfigManager",
+	HandlerType: (*SzConfigManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddConfig",
+			Handler:    _SzConfigManager_AddConfig_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _SzConfigManager_GetConfig_Handler,
+		},
+		{
+			MethodName: "GetConfigs",
+			Handler:    _SzConfigManager_GetConfigs_Handler,
+		},
+		{
+			MethodName: "GetDefaultConfigID",
+			Handler:    _SzConfigManager_GetDefaultConfigID_Handler,
+		},
+		{
+			MethodName: "ReplaceDefaultConfigID",
+			Handler:    _SzConfigManager_ReplaceDefaultConfigID_Handler,
+		},
+		{
+			MethodName: "SetDefaultConfigID",
+			Handler:    _SzConfigManager_SetDefaultConfigID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "szconfigmanager.proto",
+}
@@ -0,0 +1,4883 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: szengine.proto
+
+package szenginepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode   string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId         string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	RecordDefinition string `protobuf:"bytes,3,opt,name=record_definition,json=recordDefinition,proto3" json:"record_definition,omitempty"`
+	Flags            int64  `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *AddRecordRequest) Reset() {
+	*x = AddRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecordRequest) ProtoMessage() {}
+
+func (x *AddRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecordRequest.ProtoReflect.Descriptor instead.
+func (*AddRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddRecordRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *AddRecordRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *AddRecordRequest) GetRecordDefinition() string {
+	if x != nil {
+		return x.RecordDefinition
+	}
+	return ""
+}
+
+func (x *AddRecordRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type AddRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *AddRecordResponse) Reset() {
+	*x = AddRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecordResponse) ProtoMessage() {}
+
+func (x *AddRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecordResponse.ProtoReflect.Descriptor instead.
+func (*AddRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type CloseExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportHandle uint64 `protobuf:"varint,1,opt,name=export_handle,json=exportHandle,proto3" json:"export_handle,omitempty"`
+}
+
+func (x *CloseExportRequest) Reset() {
+	*x = CloseExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseExportRequest) ProtoMessage() {}
+
+func (x *CloseExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseExportRequest.ProtoReflect.Descriptor instead.
+func (*CloseExportRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CloseExportRequest) GetExportHandle() uint64 {
+	if x != nil {
+		return x.ExportHandle
+	}
+	return 0
+}
+
+type CloseExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseExportResponse) Reset() {
+	*x = CloseExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseExportResponse) ProtoMessage() {}
+
+func (x *CloseExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseExportResponse.ProtoReflect.Descriptor instead.
+func (*CloseExportResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{3}
+}
+
+type CountRedoRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CountRedoRecordsRequest) Reset() {
+	*x = CountRedoRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountRedoRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRedoRecordsRequest) ProtoMessage() {}
+
+func (x *CountRedoRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRedoRecordsRequest.ProtoReflect.Descriptor instead.
+func (*CountRedoRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{4}
+}
+
+type CountRedoRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result int64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CountRedoRecordsResponse) Reset() {
+	*x = CountRedoRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountRedoRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRedoRecordsResponse) ProtoMessage() {}
+
+func (x *CountRedoRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRedoRecordsResponse.ProtoReflect.Descriptor instead.
+func (*CountRedoRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountRedoRecordsResponse) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type DeleteRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *DeleteRecordRequest) Reset() {
+	*x = DeleteRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordRequest) ProtoMessage() {}
+
+func (x *DeleteRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRecordRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *DeleteRecordRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type DeleteRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeleteRecordResponse) Reset() {
+	*x = DeleteRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordResponse) ProtoMessage() {}
+
+func (x *DeleteRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ExportCsvEntityReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CsvColumnList string `protobuf:"bytes,1,opt,name=csv_column_list,json=csvColumnList,proto3" json:"csv_column_list,omitempty"`
+	Flags         int64  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ExportCsvEntityReportRequest) Reset() {
+	*x = ExportCsvEntityReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCsvEntityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCsvEntityReportRequest) ProtoMessage() {}
+
+func (x *ExportCsvEntityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCsvEntityReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportCsvEntityReportRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportCsvEntityReportRequest) GetCsvColumnList() string {
+	if x != nil {
+		return x.CsvColumnList
+	}
+	return ""
+}
+
+func (x *ExportCsvEntityReportRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ExportCsvEntityReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result uint64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExportCsvEntityReportResponse) Reset() {
+	*x = ExportCsvEntityReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCsvEntityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCsvEntityReportResponse) ProtoMessage() {}
+
+func (x *ExportCsvEntityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCsvEntityReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportCsvEntityReportResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportCsvEntityReportResponse) GetResult() uint64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type ExportCsvEntityReportIteratorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CsvColumnList string `protobuf:"bytes,1,opt,name=csv_column_list,json=csvColumnList,proto3" json:"csv_column_list,omitempty"`
+	Flags         int64  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ExportCsvEntityReportIteratorRequest) Reset() {
+	*x = ExportCsvEntityReportIteratorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCsvEntityReportIteratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCsvEntityReportIteratorRequest) ProtoMessage() {}
+
+func (x *ExportCsvEntityReportIteratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCsvEntityReportIteratorRequest.ProtoReflect.Descriptor instead.
+func (*ExportCsvEntityReportIteratorRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportCsvEntityReportIteratorRequest) GetCsvColumnList() string {
+	if x != nil {
+		return x.CsvColumnList
+	}
+	return ""
+}
+
+func (x *ExportCsvEntityReportIteratorRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ExportCsvEntityReportIteratorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExportCsvEntityReportIteratorResponse) Reset() {
+	*x = ExportCsvEntityReportIteratorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCsvEntityReportIteratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCsvEntityReportIteratorResponse) ProtoMessage() {}
+
+func (x *ExportCsvEntityReportIteratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCsvEntityReportIteratorResponse.ProtoReflect.Descriptor instead.
+func (*ExportCsvEntityReportIteratorResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportCsvEntityReportIteratorResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ExportJSONEntityReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags int64 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ExportJSONEntityReportRequest) Reset() {
+	*x = ExportJSONEntityReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJSONEntityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJSONEntityReportRequest) ProtoMessage() {}
+
+func (x *ExportJSONEntityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJSONEntityReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportJSONEntityReportRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportJSONEntityReportRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ExportJSONEntityReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result uint64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExportJSONEntityReportResponse) Reset() {
+	*x = ExportJSONEntityReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJSONEntityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJSONEntityReportResponse) ProtoMessage() {}
+
+func (x *ExportJSONEntityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJSONEntityReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportJSONEntityReportResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportJSONEntityReportResponse) GetResult() uint64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type ExportJSONEntityReportIteratorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags int64 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ExportJSONEntityReportIteratorRequest) Reset() {
+	*x = ExportJSONEntityReportIteratorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJSONEntityReportIteratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJSONEntityReportIteratorRequest) ProtoMessage() {}
+
+func (x *ExportJSONEntityReportIteratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJSONEntityReportIteratorRequest.ProtoReflect.Descriptor instead.
+func (*ExportJSONEntityReportIteratorRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportJSONEntityReportIteratorRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ExportJSONEntityReportIteratorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExportJSONEntityReportIteratorResponse) Reset() {
+	*x = ExportJSONEntityReportIteratorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJSONEntityReportIteratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJSONEntityReportIteratorResponse) ProtoMessage() {}
+
+func (x *ExportJSONEntityReportIteratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJSONEntityReportIteratorResponse.ProtoReflect.Descriptor instead.
+func (*ExportJSONEntityReportIteratorResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportJSONEntityReportIteratorResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FetchNextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportHandle uint64 `protobuf:"varint,1,opt,name=export_handle,json=exportHandle,proto3" json:"export_handle,omitempty"`
+}
+
+func (x *FetchNextRequest) Reset() {
+	*x = FetchNextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchNextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchNextRequest) ProtoMessage() {}
+
+func (x *FetchNextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchNextRequest.ProtoReflect.Descriptor instead.
+func (*FetchNextRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FetchNextRequest) GetExportHandle() uint64 {
+	if x != nil {
+		return x.ExportHandle
+	}
+	return 0
+}
+
+type FetchNextResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FetchNextResponse) Reset() {
+	*x = FetchNextResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchNextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchNextResponse) ProtoMessage() {}
+
+func (x *FetchNextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchNextResponse.ProtoReflect.Descriptor instead.
+func (*FetchNextResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FetchNextResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindInterestingEntitiesByEntityIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int64 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Flags    int64 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindInterestingEntitiesByEntityIDRequest) Reset() {
+	*x = FindInterestingEntitiesByEntityIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInterestingEntitiesByEntityIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInterestingEntitiesByEntityIDRequest) ProtoMessage() {}
+
+func (x *FindInterestingEntitiesByEntityIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInterestingEntitiesByEntityIDRequest.ProtoReflect.Descriptor instead.
+func (*FindInterestingEntitiesByEntityIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FindInterestingEntitiesByEntityIDRequest) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *FindInterestingEntitiesByEntityIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindInterestingEntitiesByEntityIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindInterestingEntitiesByEntityIDResponse) Reset() {
+	*x = FindInterestingEntitiesByEntityIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInterestingEntitiesByEntityIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInterestingEntitiesByEntityIDResponse) ProtoMessage() {}
+
+func (x *FindInterestingEntitiesByEntityIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInterestingEntitiesByEntityIDResponse.ProtoReflect.Descriptor instead.
+func (*FindInterestingEntitiesByEntityIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FindInterestingEntitiesByEntityIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindInterestingEntitiesByRecordIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) Reset() {
+	*x = FindInterestingEntitiesByRecordIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInterestingEntitiesByRecordIDRequest) ProtoMessage() {}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInterestingEntitiesByRecordIDRequest.ProtoReflect.Descriptor instead.
+func (*FindInterestingEntitiesByRecordIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *FindInterestingEntitiesByRecordIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindInterestingEntitiesByRecordIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindInterestingEntitiesByRecordIDResponse) Reset() {
+	*x = FindInterestingEntitiesByRecordIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindInterestingEntitiesByRecordIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindInterestingEntitiesByRecordIDResponse) ProtoMessage() {}
+
+func (x *FindInterestingEntitiesByRecordIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindInterestingEntitiesByRecordIDResponse.ProtoReflect.Descriptor instead.
+func (*FindInterestingEntitiesByRecordIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FindInterestingEntitiesByRecordIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindNetworkByEntityIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityIds           string `protobuf:"bytes,1,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	MaxDegrees          int64  `protobuf:"varint,2,opt,name=max_degrees,json=maxDegrees,proto3" json:"max_degrees,omitempty"`
+	BuildOutDegree      int64  `protobuf:"varint,3,opt,name=build_out_degree,json=buildOutDegree,proto3" json:"build_out_degree,omitempty"`
+	BuildOutMaxEntities int64  `protobuf:"varint,4,opt,name=build_out_max_entities,json=buildOutMaxEntities,proto3" json:"build_out_max_entities,omitempty"`
+	Flags               int64  `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindNetworkByEntityIDRequest) Reset() {
+	*x = FindNetworkByEntityIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNetworkByEntityIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNetworkByEntityIDRequest) ProtoMessage() {}
+
+func (x *FindNetworkByEntityIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNetworkByEntityIDRequest.ProtoReflect.Descriptor instead.
+func (*FindNetworkByEntityIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FindNetworkByEntityIDRequest) GetEntityIds() string {
+	if x != nil {
+		return x.EntityIds
+	}
+	return ""
+}
+
+func (x *FindNetworkByEntityIDRequest) GetMaxDegrees() int64 {
+	if x != nil {
+		return x.MaxDegrees
+	}
+	return 0
+}
+
+func (x *FindNetworkByEntityIDRequest) GetBuildOutDegree() int64 {
+	if x != nil {
+		return x.BuildOutDegree
+	}
+	return 0
+}
+
+func (x *FindNetworkByEntityIDRequest) GetBuildOutMaxEntities() int64 {
+	if x != nil {
+		return x.BuildOutMaxEntities
+	}
+	return 0
+}
+
+func (x *FindNetworkByEntityIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindNetworkByEntityIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindNetworkByEntityIDResponse) Reset() {
+	*x = FindNetworkByEntityIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNetworkByEntityIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNetworkByEntityIDResponse) ProtoMessage() {}
+
+func (x *FindNetworkByEntityIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNetworkByEntityIDResponse.ProtoReflect.Descriptor instead.
+func (*FindNetworkByEntityIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FindNetworkByEntityIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindNetworkByRecordIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordKeys          string `protobuf:"bytes,1,opt,name=record_keys,json=recordKeys,proto3" json:"record_keys,omitempty"`
+	MaxDegrees          int64  `protobuf:"varint,2,opt,name=max_degrees,json=maxDegrees,proto3" json:"max_degrees,omitempty"`
+	BuildOutDegree      int64  `protobuf:"varint,3,opt,name=build_out_degree,json=buildOutDegree,proto3" json:"build_out_degree,omitempty"`
+	BuildOutMaxEntities int64  `protobuf:"varint,4,opt,name=build_out_max_entities,json=buildOutMaxEntities,proto3" json:"build_out_max_entities,omitempty"`
+	Flags               int64  `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindNetworkByRecordIDRequest) Reset() {
+	*x = FindNetworkByRecordIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNetworkByRecordIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNetworkByRecordIDRequest) ProtoMessage() {}
+
+func (x *FindNetworkByRecordIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNetworkByRecordIDRequest.ProtoReflect.Descriptor instead.
+func (*FindNetworkByRecordIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FindNetworkByRecordIDRequest) GetRecordKeys() string {
+	if x != nil {
+		return x.RecordKeys
+	}
+	return ""
+}
+
+func (x *FindNetworkByRecordIDRequest) GetMaxDegrees() int64 {
+	if x != nil {
+		return x.MaxDegrees
+	}
+	return 0
+}
+
+func (x *FindNetworkByRecordIDRequest) GetBuildOutDegree() int64 {
+	if x != nil {
+		return x.BuildOutDegree
+	}
+	return 0
+}
+
+func (x *FindNetworkByRecordIDRequest) GetBuildOutMaxEntities() int64 {
+	if x != nil {
+		return x.BuildOutMaxEntities
+	}
+	return 0
+}
+
+func (x *FindNetworkByRecordIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindNetworkByRecordIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindNetworkByRecordIDResponse) Reset() {
+	*x = FindNetworkByRecordIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNetworkByRecordIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNetworkByRecordIDResponse) ProtoMessage() {}
+
+func (x *FindNetworkByRecordIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNetworkByRecordIDResponse.ProtoReflect.Descriptor instead.
+func (*FindNetworkByRecordIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindNetworkByRecordIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindPathByEntityIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartEntityId       int64  `protobuf:"varint,1,opt,name=start_entity_id,json=startEntityId,proto3" json:"start_entity_id,omitempty"`
+	EndEntityId         int64  `protobuf:"varint,2,opt,name=end_entity_id,json=endEntityId,proto3" json:"end_entity_id,omitempty"`
+	MaxDegrees          int64  `protobuf:"varint,3,opt,name=max_degrees,json=maxDegrees,proto3" json:"max_degrees,omitempty"`
+	AvoidEntityIds      string `protobuf:"bytes,4,opt,name=avoid_entity_ids,json=avoidEntityIds,proto3" json:"avoid_entity_ids,omitempty"`
+	RequiredDataSources string `protobuf:"bytes,5,opt,name=required_data_sources,json=requiredDataSources,proto3" json:"required_data_sources,omitempty"`
+	Flags               int64  `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindPathByEntityIDRequest) Reset() {
+	*x = FindPathByEntityIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathByEntityIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathByEntityIDRequest) ProtoMessage() {}
+
+func (x *FindPathByEntityIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathByEntityIDRequest.ProtoReflect.Descriptor instead.
+func (*FindPathByEntityIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindPathByEntityIDRequest) GetStartEntityId() int64 {
+	if x != nil {
+		return x.StartEntityId
+	}
+	return 0
+}
+
+func (x *FindPathByEntityIDRequest) GetEndEntityId() int64 {
+	if x != nil {
+		return x.EndEntityId
+	}
+	return 0
+}
+
+func (x *FindPathByEntityIDRequest) GetMaxDegrees() int64 {
+	if x != nil {
+		return x.MaxDegrees
+	}
+	return 0
+}
+
+func (x *FindPathByEntityIDRequest) GetAvoidEntityIds() string {
+	if x != nil {
+		return x.AvoidEntityIds
+	}
+	return ""
+}
+
+func (x *FindPathByEntityIDRequest) GetRequiredDataSources() string {
+	if x != nil {
+		return x.RequiredDataSources
+	}
+	return ""
+}
+
+func (x *FindPathByEntityIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindPathByEntityIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindPathByEntityIDResponse) Reset() {
+	*x = FindPathByEntityIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathByEntityIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathByEntityIDResponse) ProtoMessage() {}
+
+func (x *FindPathByEntityIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathByEntityIDResponse.ProtoReflect.Descriptor instead.
+func (*FindPathByEntityIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindPathByEntityIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type FindPathByRecordIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDataSourceCode string `protobuf:"bytes,1,opt,name=start_data_source_code,json=startDataSourceCode,proto3" json:"start_data_source_code,omitempty"`
+	StartRecordId       string `protobuf:"bytes,2,opt,name=start_record_id,json=startRecordId,proto3" json:"start_record_id,omitempty"`
+	EndDataSourceCode   string `protobuf:"bytes,3,opt,name=end_data_source_code,json=endDataSourceCode,proto3" json:"end_data_source_code,omitempty"`
+	EndRecordId         string `protobuf:"bytes,4,opt,name=end_record_id,json=endRecordId,proto3" json:"end_record_id,omitempty"`
+	MaxDegrees          int64  `protobuf:"varint,5,opt,name=max_degrees,json=maxDegrees,proto3" json:"max_degrees,omitempty"`
+	AvoidRecordKeys     string `protobuf:"bytes,6,opt,name=avoid_record_keys,json=avoidRecordKeys,proto3" json:"avoid_record_keys,omitempty"`
+	RequiredDataSources string `protobuf:"bytes,7,opt,name=required_data_sources,json=requiredDataSources,proto3" json:"required_data_sources,omitempty"`
+	Flags               int64  `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FindPathByRecordIDRequest) Reset() {
+	*x = FindPathByRecordIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathByRecordIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathByRecordIDRequest) ProtoMessage() {}
+
+func (x *FindPathByRecordIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathByRecordIDRequest.ProtoReflect.Descriptor instead.
+func (*FindPathByRecordIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FindPathByRecordIDRequest) GetStartDataSourceCode() string {
+	if x != nil {
+		return x.StartDataSourceCode
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetStartRecordId() string {
+	if x != nil {
+		return x.StartRecordId
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetEndDataSourceCode() string {
+	if x != nil {
+		return x.EndDataSourceCode
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetEndRecordId() string {
+	if x != nil {
+		return x.EndRecordId
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetMaxDegrees() int64 {
+	if x != nil {
+		return x.MaxDegrees
+	}
+	return 0
+}
+
+func (x *FindPathByRecordIDRequest) GetAvoidRecordKeys() string {
+	if x != nil {
+		return x.AvoidRecordKeys
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetRequiredDataSources() string {
+	if x != nil {
+		return x.RequiredDataSources
+	}
+	return ""
+}
+
+func (x *FindPathByRecordIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FindPathByRecordIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *FindPathByRecordIDResponse) Reset() {
+	*x = FindPathByRecordIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathByRecordIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathByRecordIDResponse) ProtoMessage() {}
+
+func (x *FindPathByRecordIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathByRecordIDResponse.ProtoReflect.Descriptor instead.
+func (*FindPathByRecordIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FindPathByRecordIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetActiveConfigIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetActiveConfigIDRequest) Reset() {
+	*x = GetActiveConfigIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveConfigIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveConfigIDRequest) ProtoMessage() {}
+
+func (x *GetActiveConfigIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveConfigIDRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveConfigIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{30}
+}
+
+type GetActiveConfigIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result int64 `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetActiveConfigIDResponse) Reset() {
+	*x = GetActiveConfigIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveConfigIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveConfigIDResponse) ProtoMessage() {}
+
+func (x *GetActiveConfigIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveConfigIDResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveConfigIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetActiveConfigIDResponse) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+type GetEntityByEntityIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int64 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Flags    int64 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *GetEntityByEntityIDRequest) Reset() {
+	*x = GetEntityByEntityIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntityByEntityIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityByEntityIDRequest) ProtoMessage() {}
+
+func (x *GetEntityByEntityIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityByEntityIDRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByEntityIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEntityByEntityIDRequest) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *GetEntityByEntityIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type GetEntityByEntityIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetEntityByEntityIDResponse) Reset() {
+	*x = GetEntityByEntityIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntityByEntityIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityByEntityIDResponse) ProtoMessage() {}
+
+func (x *GetEntityByEntityIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityByEntityIDResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByEntityIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetEntityByEntityIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetEntityByRecordIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *GetEntityByRecordIDRequest) Reset() {
+	*x = GetEntityByRecordIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntityByRecordIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityByRecordIDRequest) ProtoMessage() {}
+
+func (x *GetEntityByRecordIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityByRecordIDRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByRecordIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetEntityByRecordIDRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *GetEntityByRecordIDRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *GetEntityByRecordIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type GetEntityByRecordIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetEntityByRecordIDResponse) Reset() {
+	*x = GetEntityByRecordIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntityByRecordIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityByRecordIDResponse) ProtoMessage() {}
+
+func (x *GetEntityByRecordIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityByRecordIDResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByRecordIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetEntityByRecordIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *GetRecordRequest) Reset() {
+	*x = GetRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordRequest) ProtoMessage() {}
+
+func (x *GetRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRecordRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *GetRecordRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *GetRecordRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type GetRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetRecordResponse) Reset() {
+	*x = GetRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordResponse) ProtoMessage() {}
+
+func (x *GetRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordResponse.ProtoReflect.Descriptor instead.
+func (*GetRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetRedoRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRedoRecordRequest) Reset() {
+	*x = GetRedoRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedoRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedoRecordRequest) ProtoMessage() {}
+
+func (x *GetRedoRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedoRecordRequest.ProtoReflect.Descriptor instead.
+func (*GetRedoRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{38}
+}
+
+type GetRedoRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetRedoRecordResponse) Reset() {
+	*x = GetRedoRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRedoRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedoRecordResponse) ProtoMessage() {}
+
+func (x *GetRedoRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedoRecordResponse.ProtoReflect.Descriptor instead.
+func (*GetRedoRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetRedoRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{40}
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetStatsResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetVirtualEntityByRecordIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordList string `protobuf:"bytes,1,opt,name=record_list,json=recordList,proto3" json:"record_list,omitempty"`
+	Flags      int64  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *GetVirtualEntityByRecordIDRequest) Reset() {
+	*x = GetVirtualEntityByRecordIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVirtualEntityByRecordIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualEntityByRecordIDRequest) ProtoMessage() {}
+
+func (x *GetVirtualEntityByRecordIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualEntityByRecordIDRequest.ProtoReflect.Descriptor instead.
+func (*GetVirtualEntityByRecordIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetVirtualEntityByRecordIDRequest) GetRecordList() string {
+	if x != nil {
+		return x.RecordList
+	}
+	return ""
+}
+
+func (x *GetVirtualEntityByRecordIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type GetVirtualEntityByRecordIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetVirtualEntityByRecordIDResponse) Reset() {
+	*x = GetVirtualEntityByRecordIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVirtualEntityByRecordIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualEntityByRecordIDResponse) ProtoMessage() {}
+
+func (x *GetVirtualEntityByRecordIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualEntityByRecordIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVirtualEntityByRecordIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetVirtualEntityByRecordIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type HowEntityByEntityIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int64 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Flags    int64 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *HowEntityByEntityIDRequest) Reset() {
+	*x = HowEntityByEntityIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HowEntityByEntityIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HowEntityByEntityIDRequest) ProtoMessage() {}
+
+func (x *HowEntityByEntityIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HowEntityByEntityIDRequest.ProtoReflect.Descriptor instead.
+func (*HowEntityByEntityIDRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *HowEntityByEntityIDRequest) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *HowEntityByEntityIDRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type HowEntityByEntityIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *HowEntityByEntityIDResponse) Reset() {
+	*x = HowEntityByEntityIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HowEntityByEntityIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HowEntityByEntityIDResponse) ProtoMessage() {}
+
+func (x *HowEntityByEntityIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HowEntityByEntityIDResponse.ProtoReflect.Descriptor instead.
+func (*HowEntityByEntityIDResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HowEntityByEntityIDResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type PrimeEngineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PrimeEngineRequest) Reset() {
+	*x = PrimeEngineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimeEngineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimeEngineRequest) ProtoMessage() {}
+
+func (x *PrimeEngineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimeEngineRequest.ProtoReflect.Descriptor instead.
+func (*PrimeEngineRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{46}
+}
+
+type PrimeEngineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PrimeEngineResponse) Reset() {
+	*x = PrimeEngineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimeEngineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimeEngineResponse) ProtoMessage() {}
+
+func (x *PrimeEngineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimeEngineResponse.ProtoReflect.Descriptor instead.
+func (*PrimeEngineResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{47}
+}
+
+type ProcessRedoRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedoRecord string `protobuf:"bytes,1,opt,name=redo_record,json=redoRecord,proto3" json:"redo_record,omitempty"`
+	Flags      int64  `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ProcessRedoRecordRequest) Reset() {
+	*x = ProcessRedoRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessRedoRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRedoRecordRequest) ProtoMessage() {}
+
+func (x *ProcessRedoRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRedoRecordRequest.ProtoReflect.Descriptor instead.
+func (*ProcessRedoRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ProcessRedoRecordRequest) GetRedoRecord() string {
+	if x != nil {
+		return x.RedoRecord
+	}
+	return ""
+}
+
+func (x *ProcessRedoRecordRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ProcessRedoRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ProcessRedoRecordResponse) Reset() {
+	*x = ProcessRedoRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessRedoRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRedoRecordResponse) ProtoMessage() {}
+
+func (x *ProcessRedoRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRedoRecordResponse.ProtoReflect.Descriptor instead.
+func (*ProcessRedoRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ProcessRedoRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ReevaluateEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int64 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Flags    int64 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ReevaluateEntityRequest) Reset() {
+	*x = ReevaluateEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReevaluateEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateEntityRequest) ProtoMessage() {}
+
+func (x *ReevaluateEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateEntityRequest.ProtoReflect.Descriptor instead.
+func (*ReevaluateEntityRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReevaluateEntityRequest) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ReevaluateEntityRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ReevaluateEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ReevaluateEntityResponse) Reset() {
+	*x = ReevaluateEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReevaluateEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateEntityResponse) ProtoMessage() {}
+
+func (x *ReevaluateEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateEntityResponse.ProtoReflect.Descriptor instead.
+func (*ReevaluateEntityResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReevaluateEntityResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ReevaluateRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *ReevaluateRecordRequest) Reset() {
+	*x = ReevaluateRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReevaluateRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateRecordRequest) ProtoMessage() {}
+
+func (x *ReevaluateRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateRecordRequest.ProtoReflect.Descriptor instead.
+func (*ReevaluateRecordRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReevaluateRecordRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *ReevaluateRecordRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *ReevaluateRecordRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ReevaluateRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ReevaluateRecordResponse) Reset() {
+	*x = ReevaluateRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReevaluateRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateRecordResponse) ProtoMessage() {}
+
+func (x *ReevaluateRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateRecordResponse.ProtoReflect.Descriptor instead.
+func (*ReevaluateRecordResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReevaluateRecordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ReinitializeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigId int64 `protobuf:"varint,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
+}
+
+func (x *ReinitializeRequest) Reset() {
+	*x = ReinitializeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinitializeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinitializeRequest) ProtoMessage() {}
+
+func (x *ReinitializeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinitializeRequest.ProtoReflect.Descriptor instead.
+func (*ReinitializeRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReinitializeRequest) GetConfigId() int64 {
+	if x != nil {
+		return x.ConfigId
+	}
+	return 0
+}
+
+type ReinitializeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReinitializeResponse) Reset() {
+	*x = ReinitializeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReinitializeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinitializeResponse) ProtoMessage() {}
+
+func (x *ReinitializeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinitializeResponse.ProtoReflect.Descriptor instead.
+func (*ReinitializeResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{55}
+}
+
+type SearchByAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes    string `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	SearchProfile string `protobuf:"bytes,2,opt,name=search_profile,json=searchProfile,proto3" json:"search_profile,omitempty"`
+	Flags         int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *SearchByAttributesRequest) Reset() {
+	*x = SearchByAttributesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchByAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchByAttributesRequest) ProtoMessage() {}
+
+func (x *SearchByAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchByAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SearchByAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchByAttributesRequest) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *SearchByAttributesRequest) GetSearchProfile() string {
+	if x != nil {
+		return x.SearchProfile
+	}
+	return ""
+}
+
+func (x *SearchByAttributesRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type SearchByAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SearchByAttributesResponse) Reset() {
+	*x = SearchByAttributesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchByAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchByAttributesResponse) ProtoMessage() {}
+
+func (x *SearchByAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchByAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SearchByAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchByAttributesResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type WhyEntitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId1 int64 `protobuf:"varint,1,opt,name=entity_id1,json=entityId1,proto3" json:"entity_id1,omitempty"`
+	EntityId2 int64 `protobuf:"varint,2,opt,name=entity_id2,json=entityId2,proto3" json:"entity_id2,omitempty"`
+	Flags     int64 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *WhyEntitiesRequest) Reset() {
+	*x = WhyEntitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyEntitiesRequest) ProtoMessage() {}
+
+func (x *WhyEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*WhyEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WhyEntitiesRequest) GetEntityId1() int64 {
+	if x != nil {
+		return x.EntityId1
+	}
+	return 0
+}
+
+func (x *WhyEntitiesRequest) GetEntityId2() int64 {
+	if x != nil {
+		return x.EntityId2
+	}
+	return 0
+}
+
+func (x *WhyEntitiesRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type WhyEntitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WhyEntitiesResponse) Reset() {
+	*x = WhyEntitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyEntitiesResponse) ProtoMessage() {}
+
+func (x *WhyEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*WhyEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WhyEntitiesResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type WhyRecordInEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode string `protobuf:"bytes,1,opt,name=data_source_code,json=dataSourceCode,proto3" json:"data_source_code,omitempty"`
+	RecordId       string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Flags          int64  `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *WhyRecordInEntityRequest) Reset() {
+	*x = WhyRecordInEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyRecordInEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyRecordInEntityRequest) ProtoMessage() {}
+
+func (x *WhyRecordInEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyRecordInEntityRequest.ProtoReflect.Descriptor instead.
+func (*WhyRecordInEntityRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WhyRecordInEntityRequest) GetDataSourceCode() string {
+	if x != nil {
+		return x.DataSourceCode
+	}
+	return ""
+}
+
+func (x *WhyRecordInEntityRequest) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *WhyRecordInEntityRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type WhyRecordInEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WhyRecordInEntityResponse) Reset() {
+	*x = WhyRecordInEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyRecordInEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyRecordInEntityResponse) ProtoMessage() {}
+
+func (x *WhyRecordInEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyRecordInEntityResponse.ProtoReflect.Descriptor instead.
+func (*WhyRecordInEntityResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WhyRecordInEntityResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type WhyRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DataSourceCode1 string `protobuf:"bytes,1,opt,name=data_source_code1,json=dataSourceCode1,proto3" json:"data_source_code1,omitempty"`
+	RecordId1       string `protobuf:"bytes,2,opt,name=record_id1,json=recordId1,proto3" json:"record_id1,omitempty"`
+	DataSourceCode2 string `protobuf:"bytes,3,opt,name=data_source_code2,json=dataSourceCode2,proto3" json:"data_source_code2,omitempty"`
+	RecordId2       string `protobuf:"bytes,4,opt,name=record_id2,json=recordId2,proto3" json:"record_id2,omitempty"`
+	Flags           int64  `protobuf:"varint,5,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *WhyRecordsRequest) Reset() {
+	*x = WhyRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyRecordsRequest) ProtoMessage() {}
+
+func (x *WhyRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyRecordsRequest.ProtoReflect.Descriptor instead.
+func (*WhyRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WhyRecordsRequest) GetDataSourceCode1() string {
+	if x != nil {
+		return x.DataSourceCode1
+	}
+	return ""
+}
+
+func (x *WhyRecordsRequest) GetRecordId1() string {
+	if x != nil {
+		return x.RecordId1
+	}
+	return ""
+}
+
+func (x *WhyRecordsRequest) GetDataSourceCode2() string {
+	if x != nil {
+		return x.DataSourceCode2
+	}
+	return ""
+}
+
+func (x *WhyRecordsRequest) GetRecordId2() string {
+	if x != nil {
+		return x.RecordId2
+	}
+	return ""
+}
+
+func (x *WhyRecordsRequest) GetFlags() int64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type WhyRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WhyRecordsResponse) Reset() {
+	*x = WhyRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szengine_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhyRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhyRecordsResponse) ProtoMessage() {}
+
+func (x *WhyRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_szengine_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhyRecordsResponse.ProtoReflect.Descriptor instead.
+func (*WhyRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_szengine_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WhyRecordsResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+var File_szengine_proto protoreflect.FileDescriptor
+
+var file_szengine_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x39, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x15, 0x0a,
+	0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x64,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x32, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x72, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5c, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x73, 0x76, 0x5f, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x73, 0x76, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x64,
+	0x0a, 0x24, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x73, 0x76, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x73, 0x76, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x3f, 0x0a, 0x25, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73,
+	0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x35, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x1e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3d, 0x0a, 0x25, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x40, 0x0a, 0x26, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x10, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x22, 0x2b, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5d, 0x0a,
+	0x28, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x29,
+	0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x87, 0x01, 0x0a, 0x28, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x29, 0x46,
+	0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0xd3, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x4f, 0x75, 0x74, 0x4d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0xd5, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x67, 0x72, 0x65,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
+	0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x33, 0x0a, 0x16,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x4f, 0x75, 0x74, 0x4d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x37, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0xfc, 0x01, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65,
+	0x6e, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x76, 0x6f, 0x69, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22,
+	0x34, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x14, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x67,
+	0x72, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44,
+	0x65, 0x67, 0x72, 0x65, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x34, 0x0a, 0x1a,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x79, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6f, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2b,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x5a, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22,
+	0x3c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a,
+	0x1a, 0x48, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x35,
+	0x0a, 0x1b, 0x48, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x50,
+	0x72, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x51, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x64,
+	0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x64, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4c, 0x0a, 0x17, 0x52, 0x65,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x18, 0x52, 0x65, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x76, 0x0a, 0x17,
+	0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x18, 0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x34,
+	0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x12, 0x57, 0x68, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x31, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2d,
+	0x0a, 0x13, 0x57, 0x68, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x77, 0x0a,
+	0x18, 0x57, 0x68, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x57, 0x68, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x11,
+	0x57, 0x68, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x31, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x31, 0x12, 0x2a, 0x0a, 0x11,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x0a,
+	0x12, 0x57, 0x68, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x8a, 0x1e, 0x0a, 0x08,
+	0x53, 0x7a, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x5c, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2d,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x32,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b,
+	0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x2e, 0x73, 0x65, 0x6e, 0x7a,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x73, 0x76, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x33, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x53, 0x4f,
+	0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73,
+	0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x1e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x65, 0x6e,
+	0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x09, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4e, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa4, 0x01, 0x0a, 0x21, 0x46, 0x69, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x3e,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0xa4, 0x01, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x3e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x12, 0x32, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73,
+	0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73,
+	0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x46, 0x69,
+	0x6e, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x49, 0x44, 0x12, 0x32, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x12,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b,
+	0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x2f, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73,
+	0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x79, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x44, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64,
+	0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x30, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x65, 0x6e, 0x7a,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x6e, 0x7a,
+	0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e,
+	0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x25, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73,
+	0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8f, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x37,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7a, 0x0a, 0x13, 0x48, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x30, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x48, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x65, 0x6e,
+	0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x48, 0x6f, 0x77, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x2e, 0x73,
+	0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72,
+	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x64, 0x6f,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x65, 0x6e,
+	0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2d,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x0c, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x2e,
+	0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69,
+	0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e,
+	0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x6e,
+	0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x65,
+	0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0b, 0x57, 0x68, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x73,
+	0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68,
+	0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x11, 0x57, 0x68, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67,
+	0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68,
+	0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0a, 0x57, 0x68, 0x79, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e,
+	0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68, 0x79,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x57, 0x68, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2d, 0x67,
+	0x61, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x7a, 0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x7a, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x7a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_szengine_proto_rawDescOnce sync.Once
+	file_szengine_proto_rawDescData = file_szengine_proto_rawDesc
+)
+
+func file_szengine_proto_rawDescGZIP() []byte {
+	file_szengine_proto_rawDescOnce.Do(func() {
+		file_szengine_proto_rawDescData = protoimpl.X.CompressGZIP(file_szengine_proto_rawDescData)
+	})
+	return file_szengine_proto_rawDescData
+}
+
+var file_szengine_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_szengine_proto_goTypes = []any{
+	(*AddRecordRequest)(nil),                          // 0: senzing.sdk.szengine.AddRecordRequest
+	(*AddRecordResponse)(nil),                         // 1: senzing.sdk.szengine.AddRecordResponse
+	(*CloseExportRequest)(nil),                        // 2: senzing.sdk.szengine.CloseExportRequest
+	(*CloseExportResponse)(nil),                       // 3: senzing.sdk.szengine.CloseExportResponse
+	(*CountRedoRecordsRequest)(nil),                   // 4: senzing.sdk.szengine.CountRedoRecordsRequest
+	(*CountRedoRecordsResponse)(nil),                  // 5: senzing.sdk.szengine.CountRedoRecordsResponse
+	(*DeleteRecordRequest)(nil),                       // 6: senzing.sdk.szengine.DeleteRecordRequest
+	(*DeleteRecordResponse)(nil),                      // 7: senzing.sdk.szengine.DeleteRecordResponse
+	(*ExportCsvEntityReportRequest)(nil),              // 8: senzing.sdk.szengine.ExportCsvEntityReportRequest
+	(*ExportCsvEntityReportResponse)(nil),             // 9: senzing.sdk.szengine.ExportCsvEntityReportResponse
+	(*ExportCsvEntityReportIteratorRequest)(nil),      // 10: senzing.sdk.szengine.ExportCsvEntityReportIteratorRequest
+	(*ExportCsvEntityReportIteratorResponse)(nil),     // 11: senzing.sdk.szengine.ExportCsvEntityReportIteratorResponse
+	(*ExportJSONEntityReportRequest)(nil),             // 12: senzing.sdk.szengine.ExportJSONEntityReportRequest
+	(*ExportJSONEntityReportResponse)(nil),            // 13: senzing.sdk.szengine.ExportJSONEntityReportResponse
+	(*ExportJSONEntityReportIteratorRequest)(nil),     // 14: senzing.sdk.szengine.ExportJSONEntityReportIteratorRequest
+	(*ExportJSONEntityReportIteratorResponse)(nil),    // 15: senzing.sdk.szengine.ExportJSONEntityReportIteratorResponse
+	(*FetchNextRequest)(nil),                          // 16: senzing.sdk.szengine.FetchNextRequest
+	(*FetchNextResponse)(nil),                         // 17: senzing.sdk.szengine.FetchNextResponse
+	(*FindInterestingEntitiesByEntityIDRequest)(nil),  // 18: senzing.sdk.szengine.FindInterestingEntitiesByEntityIDRequest
+	(*FindInterestingEntitiesByEntityIDResponse)(nil), // 19: senzing.sdk.szengine.FindInterestingEntitiesByEntityIDResponse
+	(*FindInterestingEntitiesByRecordIDRequest)(nil),  // 20: senzing.sdk.szengine.FindInterestingEntitiesByRecordIDRequest
+	(*FindInterestingEntitiesByRecordIDResponse)(nil), // 21: senzing.sdk.szengine.FindInterestingEntitiesByRecordIDResponse
+	(*FindNetworkByEntityIDRequest)(nil),              // 22: senzing.sdk.szengine.FindNetworkByEntityIDRequest
+	(*FindNetworkByEntityIDResponse)(nil),             // 23: senzing.sdk.szengine.FindNetworkByEntityIDResponse
+	(*FindNetworkByRecordIDRequest)(nil),              // 24: senzing.sdk.szengine.FindNetworkByRecordIDRequest
+	(*FindNetworkByRecordIDResponse)(nil),             // 25: senzing.sdk.szengine.FindNetworkByRecordIDResponse
+	(*FindPathByEntityIDRequest)(nil),                 // 26: senzing.sdk.szengine.FindPathByEntityIDRequest
+	(*FindPathByEntityIDResponse)(nil),                // 27: senzing.sdk.szengine.FindPathByEntityIDResponse
+	(*FindPathByRecordIDRequest)(nil),                 // 28: senzing.sdk.szengine.FindPathByRecordIDRequest
+	(*FindPathByRecordIDResponse)(nil),                // 29: senzing.sdk.szengine.FindPathByRecordIDResponse
+	(*GetActiveConfigIDRequest)(nil),                  // 30: senzing.sdk.szengine.GetActiveConfigIDRequest
+	(*GetActiveConfigIDResponse)(nil),                 // 31: senzing.sdk.szengine.GetActiveConfigIDResponse
+	(*GetEntityByEntityIDRequest)(nil),                // 32: senzing.sdk.szengine.GetEntityByEntityIDRequest
+	(*GetEntityByEntityIDResponse)(nil),               // 33: senzing.sdk.szengine.GetEntityByEntityIDResponse
+	(*GetEntityByRecordIDRequest)(nil),                // 34: senzing.sdk.szengine.GetEntityByRecordIDRequest
+	(*GetEntityByRecordIDResponse)(nil),               // 35: senzing.sdk.szengine.GetEntityByRecordIDResponse
+	(*GetRecordRequest)(nil),                          // 36: senzing.sdk.szengine.GetRecordRequest
+	(*GetRecordResponse)(nil),                         // 37: senzing.sdk.szengine.GetRecordResponse
+	(*GetRedoRecordRequest)(nil),                      // 38: senzing.sdk.szengine.GetRedoRecordRequest
+	(*GetRedoRecordResponse)(nil),                     // 39: senzing.sdk.szengine.GetRedoRecordResponse
+	(*GetStatsRequest)(nil),                           // 40: senzing.sdk.szengine.GetStatsRequest
+	(*GetStatsResponse)(nil),                          // 41: senzing.sdk.szengine.GetStatsResponse
+	(*GetVirtualEntityByRecordIDRequest)(nil),         // 42: senzing.sdk.szengine.GetVirtualEntityByRecordIDRequest
+	(*GetVirtualEntityByRecordIDResponse)(nil),        // 43: senzing.sdk.szengine.GetVirtualEntityByRecordIDResponse
+	(*HowEntityByEntityIDRequest)(nil),                // 44: senzing.sdk.szengine.HowEntityByEntityIDRequest
+	(*HowEntityByEntityIDResponse)(nil),               // 45: senzing.sdk.szengine.HowEntityByEntityIDResponse
+	(*PrimeEngineRequest)(nil),                        // 46: senzing.sdk.szengine.PrimeEngineRequest
+	(*PrimeEngineResponse)(nil),                       // 47: senzing.sdk.szengine.PrimeEngineResponse
+	(*ProcessRedoRecordRequest)(nil),                  // 48: senzing.sdk.szengine.ProcessRedoRecordRequest
+	(*ProcessRedoRecordResponse)(nil),                 // 49: senzing.sdk.szengine.ProcessRedoRecordResponse
+	(*ReevaluateEntityRequest)(nil),                   // 50: senzing.sdk.szengine.ReevaluateEntityRequest
+	(*ReevaluateEntityResponse)(nil),                  // 51: senzing.sdk.szengine.ReevaluateEntityResponse
+	(*ReevaluateRecordRequest)(nil),                   // 52: senzing.sdk.szengine.ReevaluateRecordRequest
+	(*ReevaluateRecordResponse)(nil),                  // 53: senzing.sdk.szengine.ReevaluateRecordResponse
+	(*ReinitializeRequest)(nil),                       // 54: senzing.sdk.szengine.ReinitializeRequest
+	(*ReinitializeResponse)(nil),                      // 55: senzing.sdk.szengine.ReinitializeResponse
+	(*SearchByAttributesRequest)(nil),                 // 56: senzing.sdk.szengine.SearchByAttributesRequest
+	(*SearchByAttributesResponse)(nil),                // 57: senzing.sdk.szengine.SearchByAttributesResponse
+	(*WhyEntitiesRequest)(nil),                        // 58: senzing.sdk.szengine.WhyEntitiesRequest
+	(*WhyEntitiesResponse)(nil),                       // 59: senzing.sdk.szengine.WhyEntitiesResponse
+	(*WhyRecordInEntityRequest)(nil),                  // 60: senzing.sdk.szengine.WhyRecordInEntityRequest
+	(*WhyRecordInEntityResponse)(nil),                 // 61: senzing.sdk.szengine.WhyRecordInEntityResponse
+	(*WhyRecordsRequest)(nil),                         // 62: senzing.sdk.szengine.WhyRecordsRequest
+	(*WhyRecordsResponse)(nil),                        // 63: senzing.sdk.szengine.WhyRecordsResponse
+}
+var file_szengine_proto_depIdxs = []int32{
+	0,  // 0: senzing.sdk.szengine.SzEngine.AddRecord:input_type -> senzing.sdk.szengine.AddRecordRequest
+	2,  // 1: senzing.sdk.szengine.SzEngine.CloseExport:input_type -> senzing.sdk.szengine.CloseExportRequest
+	4,  // 2: senzing.sdk.szengine.SzEngine.CountRedoRecords:input_type -> senzing.sdk.szengine.CountRedoRecordsRequest
+	6,  // 3: senzing.sdk.szengine.SzEngine.DeleteRecord:input_type -> senzing.sdk.szengine.DeleteRecordRequest
+	8,  // 4: senzing.sdk.szengine.SzEngine.ExportCsvEntityReport:input_type -> senzing.sdk.szengine.ExportCsvEntityReportRequest
+	10, // 5: senzing.sdk.szengine.SzEngine.ExportCsvEntityReportIterator:input_type -> senzing.sdk.szengine.ExportCsvEntityReportIteratorRequest
+	12, // 6: senzing.sdk.szengine.SzEngine.ExportJSONEntityReport:input_type -> senzing.sdk.szengine.ExportJSONEntityReportRequest
+	14, // 7: senzing.sdk.szengine.SzEngine.ExportJSONEntityReportIterator:input_type -> senzing.sdk.szengine.ExportJSONEntityReportIteratorRequest
+	16, // 8: senzing.sdk.szengine.SzEngine.FetchNext:input_type -> senzing.sdk.szengine.FetchNextRequest
+	18, // 9: senzing.sdk.szengine.SzEngine.FindInterestingEntitiesByEntityID:input_type -> senzing.sdk.szengine.FindInterestingEntitiesByEntityIDRequest
+	20, // 10: senzing.sdk.szengine.SzEngine.FindInterestingEntitiesByRecordID:input_type -> senzing.sdk.szengine.FindInterestingEntitiesByRecordIDRequest
+	22, // 11: senzing.sdk.szengine.SzEngine.FindNetworkByEntityID:input_type -> senzing.sdk.szengine.FindNetworkByEntityIDRequest
+	24, // 12: senzing.sdk.szengine.SzEngine.FindNetworkByRecordID:input_type -> senzing.sdk.szengine.FindNetworkByRecordIDRequest
+	26, // 13: senzing.sdk.szengine.SzEngine.FindPathByEntityID:input_type -> senzing.sdk.szengine.FindPathByEntityIDRequest
+	28, // 14: senzing.sdk.szengine.SzEngine.FindPathByRecordID:input_type -> senzing.sdk.szengine.FindPathByRecordIDRequest
+	30, // 15: senzing.sdk.szengine.SzEngine.GetActiveConfigID:input_type -> senzing.sdk.szengine.GetActiveConfigIDRequest
+	32, // 16: senzing.sdk.szengine.SzEngine.GetEntityByEntityID:input_type -> senzing.sdk.szengine.GetEntityByEntityIDRequest
+	34, // 17: senzing.sdk.szengine.SzEngine.GetEntityByRecordID:input_type -> senzing.sdk.szengine.GetEntityByRecordIDRequest
+	36, // 18: senzing.sdk.szengine.SzEngine.GetRecord:input_type -> senzing.sdk.szengine.GetRecordRequest
+	38, // 19: senzing.sdk.szengine.SzEngine.GetRedoRecord:input_type -> senzing.sdk.szengine.GetRedoRecordRequest
+	40, // 20: senzing.sdk.szengine.SzEngine.GetStats:input_type -> senzing.sdk.szengine.GetStatsRequest
+	42, // 21: senzing.sdk.szengine.SzEngine.GetVirtualEntityByRecordID:input_type -> senzing.sdk.szengine.GetVirtualEntityByRecordIDRequest
+	44, // 22: senzing.sdk.szengine.SzEngine.HowEntityByEntityID:input_type -> senzing.sdk.szengine.HowEntityByEntityIDRequest
+	46, // 23: senzing.sdk.szengine.SzEngine.PrimeEngine:input_type -> senzing.sdk.szengine.PrimeEngineRequest
+	48, // 24: senzing.sdk.szengine.SzEngine.ProcessRedoRecord:input_type -> senzing.sdk.szengine.ProcessRedoRecordRequest
+	50, // 25: senzing.sdk.szengine.SzEngine.ReevaluateEntity:input_type -> senzing.sdk.szengine.ReevaluateEntityRequest
+	52, // 26: senzing.sdk.szengine.SzEngine.ReevaluateRecord:input_type -> senzing.sdk.szengine.ReevaluateRecordRequest
+	54, // 27: senzing.sdk.szengine.SzEngine.Reinitialize:input_type -> senzing.sdk.szengine.ReinitializeRequest
+	56, // 28: senzing.sdk.szengine.SzEngine.SearchByAttributes:input_type -> senzing.sdk.szengine.SearchByAttributesRequest
+	58, // 29: senzing.sdk.szengine.SzEngine.WhyEntities:input_type -> senzing.sdk.szengine.WhyEntitiesRequest
+	60, // 30: senzing.sdk.szengine.SzEngine.WhyRecordInEntity:input_type -> senzing.sdk.szengine.WhyRecordInEntityRequest
+	62, // 31: senzing.sdk.szengine.SzEngine.WhyRecords:input_type -> senzing.sdk.szengine.WhyRecordsRequest
+	1,  // 32: senzing.sdk.szengine.SzEngine.AddRecord:output_type -> senzing.sdk.szengine.AddRecordResponse
+	3,  // 33: senzing.sdk.szengine.SzEngine.CloseExport:output_type -> senzing.sdk.szengine.CloseExportResponse
+	5,  // 34: senzing.sdk.szengine.SzEngine.CountRedoRecords:output_type -> senzing.sdk.szengine.CountRedoRecordsResponse
+	7,  // 35: senzing.sdk.szengine.SzEngine.DeleteRecord:output_type -> senzing.sdk.szengine.DeleteRecordResponse
+	9,  // 36: senzing.sdk.szengine.SzEngine.ExportCsvEntityReport:output_type -> senzing.sdk.szengine.ExportCsvEntityReportResponse
+	11, // 37: senzing.sdk.szengine.SzEngine.ExportCsvEntityReportIterator:output_type -> senzing.sdk.szengine.ExportCsvEntityReportIteratorResponse
+	13, // 38: senzing.sdk.szengine.SzEngine.ExportJSONEntityReport:output_type -> senzing.sdk.szengine.ExportJSONEntityReportResponse
+	15, // 39: senzing.sdk.szengine.SzEngine.ExportJSONEntityReportIterator:output_type -> senzing.sdk.szengine.ExportJSONEntityReportIteratorResponse
+	17, // 40: senzing.sdk.szengine.SzEngine.FetchNext:output_type -> senzing.sdk.szengine.FetchNextResponse
+	19, // 41: senzing.sdk.szengine.SzEngine.FindInterestingEntitiesByEntityID:output_type -> senzing.sdk.szengine.FindInterestingEntitiesByEntityIDResponse
+	21, // 42: senzing.sdk.szengine.SzEngine.FindInterestingEntitiesByRecordID:output_type -> senzing.sdk.szengine.FindInterestingEntitiesByRecordIDResponse
+	23, // 43: senzing.sdk.szengine.SzEngine.FindNetworkByEntityID:output_type -> senzing.sdk.szengine.FindNetworkByEntityIDResponse
+	25, // 44: senzing.sdk.szengine.SzEngine.FindNetworkByRecordID:output_type -> senzing.sdk.szengine.FindNetworkByRecordIDResponse
+	27, // 45: senzing.sdk.szengine.SzEngine.FindPathByEntityID:output_type -> senzing.sdk.szengine.FindPathByEntityIDResponse
+	29, // 46: senzing.sdk.szengine.SzEngine.FindPathByRecordID:output_type -> senzing.sdk.szengine.FindPathByRecordIDResponse
+	31, // 47: senzing.sdk.szengine.SzEngine.GetActiveConfigID:output_type -> senzing.sdk.szengine.GetActiveConfigIDResponse
+	33, // 48: senzing.sdk.szengine.SzEngine.GetEntityByEntityID:output_type -> senzing.sdk.szengine.GetEntityByEntityIDResponse
+	35, // 49: senzing.sdk.szengine.SzEngine.GetEntityByRecordID:output_type -> senzing.sdk.szengine.GetEntityByRecordIDResponse
+	37, // 50: senzing.sdk.szengine.SzEngine.GetRecord:output_type -> senzing.sdk.szengine.GetRecordResponse
+	39, // 51: senzing.sdk.szengine.SzEngine.GetRedoRecord:output_type -> senzing.sdk.szengine.GetRedoRecordResponse
+	41, // 52: senzing.sdk.szengine.SzEngine.GetStats:output_type -> senzing.sdk.szengine.GetStatsResponse
+	43, // 53: senzing.sdk.szengine.SzEngine.GetVirtualEntityByRecordID:output_type -> senzing.sdk.szengine.GetVirtualEntityByRecordIDResponse
+	45, // 54: senzing.sdk.szengine.SzEngine.HowEntityByEntityID:output_type -> senzing.sdk.szengine.HowEntityByEntityIDResponse
+	47, // 55: senzing.sdk.szengine.SzEngine.PrimeEngine:output_type -> senzing.sdk.szengine.PrimeEngineResponse
+	49, // 56: senzing.sdk.szengine.SzEngine.ProcessRedoRecord:output_type -> senzing.sdk.szengine.ProcessRedoRecordResponse
+	51, // 57: senzing.sdk.szengine.SzEngine.ReevaluateEntity:output_type -> senzing.sdk.szengine.ReevaluateEntityResponse
+	53, // 58: senzing.sdk.szengine.SzEngine.ReevaluateRecord:output_type -> senzing.sdk.szengine.ReevaluateRecordResponse
+	55, // 59: senzing.sdk.szengine.SzEngine.Reinitialize:output_type -> senzing.sdk.szengine.ReinitializeResponse
+	57, // 60: senzing.sdk.szengine.SzEngine.SearchByAttributes:output_type -> senzing.sdk.szengine.SearchByAttributesResponse
+	59, // 61: senzing.sdk.szengine.SzEngine.WhyEntities:output_type -> senzing.sdk.szengine.WhyEntitiesResponse
+	61, // 62: senzing.sdk.szengine.SzEngine.WhyRecordInEntity:output_type -> senzing.sdk.szengine.WhyRecordInEntityResponse
+	63, // 63: senzing.sdk.szengine.SzEngine.WhyRecords:output_type -> senzing.sdk.szengine.WhyRecordsResponse
+	32, // [32:64] is the sub-list for method output_type
+	0,  // [0:32] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_szengine_proto_init() }
+func file_szengine_proto_init() {
+	if File_szengine_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_szengine_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AddRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CountRedoRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CountRedoRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvEntityReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvEntityReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvEntityReportIteratorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportCsvEntityReportIteratorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportJSONEntityReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportJSONEntityReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportJSONEntityReportIteratorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportJSONEntityReportIteratorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*FetchNextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*FetchNextResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInterestingEntitiesByEntityIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInterestingEntitiesByEntityIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInterestingEntitiesByRecordIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInterestingEntitiesByRecordIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*FindNetworkByEntityIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*FindNetworkByEntityIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*FindNetworkByRecordIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*FindNetworkByRecordIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathByEntityIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathByEntityIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathByRecordIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathByRecordIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetActiveConfigIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetActiveConfigIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEntityByEntityIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEntityByEntityIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEntityByRecordIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEntityByRecordIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedoRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRedoRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVirtualEntityByRecordIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVirtualEntityByRecordIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*HowEntityByEntityIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*HowEntityByEntityIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*PrimeEngineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*PrimeEngineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessRedoRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessRedoRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ReevaluateEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ReevaluateEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ReevaluateRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ReevaluateRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ReinitializeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ReinitializeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchByAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchByAttributesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyEntitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyEntitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyRecordInEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyRecordInEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_szengine_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*WhyRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_szengine_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   64,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_szengine_proto_goTypes,
+		DependencyIndexes: file_szengine_proto_depIdxs,
+		MessageInfos:      file_szengine_proto_msgTypes,
+	}.Build()
+	File_szengine_proto = out.File
+	file_szengine_proto_rawDesc = nil
+	file_szengine_proto_goTypes = nil
+	file_szengine_proto_depIdxs = nil
+}
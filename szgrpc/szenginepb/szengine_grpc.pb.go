@@ -0,0 +1,1349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: szengine.proto
+
+package szenginepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	SzEngine_AddRecord_FullMethodName                         = "/senzing.sdk.szengine.SzEngine/AddRecord"
+	SzEngine_CloseExport_FullMethodName                       = "/senzing.sdk.szengine.SzEngine/CloseExport"
+	SzEngine_CountRedoRecords_FullMethodName                  = "/senzing.sdk.szengine.SzEngine/CountRedoRecords"
+	SzEngine_DeleteRecord_FullMethodName                      = "/senzing.sdk.szengine.SzEngine/DeleteRecord"
+	SzEngine_ExportCsvEntityReport_FullMethodName             = "/senzing.sdk.szengine.SzEngine/ExportCsvEntityReport"
+	SzEngine_ExportCsvEntityReportIterator_FullMethodName     = "/senzing.sdk.szengine.SzEngine/ExportCsvEntityReportIterator"
+	SzEngine_ExportJSONEntityReport_FullMethodName            = "/senzing.sdk.szengine.SzEngine/ExportJSONEntityReport"
+	SzEngine_ExportJSONEntityReportIterator_FullMethodName    = "/senzing.sdk.szengine.SzEngine/ExportJSONEntityReportIterator"
+	SzEngine_FetchNext_FullMethodName                         = "/senzing.sdk.szengine.SzEngine/FetchNext"
+	SzEngine_FindInterestingEntitiesByEntityID_FullMethodName = "/senzing.sdk.szengine.SzEngine/FindInterestingEntitiesByEntityID"
+	SzEngine_FindInterestingEntitiesByRecordID_FullMethodName = "/senzing.sdk.szengine.SzEngine/FindInterestingEntitiesByRecordID"
+	SzEngine_FindNetworkByEntityID_FullMethodName             = "/senzing.sdk.szengine.SzEngine/FindNetworkByEntityID"
+	SzEngine_FindNetworkByRecordID_FullMethodName             = "/senzing.sdk.szengine.SzEngine/FindNetworkByRecordID"
+	SzEngine_FindPathByEntityID_FullMethodName                = "/senzing.sdk.szengine.SzEngine/FindPathByEntityID"
+	SzEngine_FindPathByRecordID_FullMethodName                = "/senzing.sdk.szengine.SzEngine/FindPathByRecordID"
+	SzEngine_GetActiveConfigID_FullMethodName                 = "/senzing.sdk.szengine.SzEngine/GetActiveConfigID"
+	SzEngine_GetEntityByEntityID_FullMethodName               = "/senzing.sdk.szengine.SzEngine/GetEntityByEntityID"
+	SzEngine_GetEntityByRecordID_FullMethodName               = "/senzing.sdk.szengine.SzEngine/GetEntityByRecordID"
+	SzEngine_GetRecord_FullMethodName                         = "/senzing.sdk.szengine.SzEngine/GetRecord"
+	SzEngine_GetRedoRecord_FullMethodName                     = "/senzing.sdk.szengine.SzEngine/GetRedoRecord"
+	SzEngine_GetStats_FullMethodName                          = "/senzing.sdk.szengine.SzEngine/GetStats"
+	SzEngine_GetVirtualEntityByRecordID_FullMethodName        = "/senzing.sdk.szengine.SzEngine/GetVirtualEntityByRecordID"
+	SzEngine_HowEntityByEntityID_FullMethodName               = "/senzing.sdk.szengine.SzEngine/HowEntityByEntityID"
+	SzEngine_PrimeEngine_FullMethodName                       = "/senzing.sdk.szengine.SzEngine/PrimeEngine"
+	SzEngine_ProcessRedoRecord_FullMethodName                 = "/senzing.sdk.szengine.SzEngine/ProcessRedoRecord"
+	SzEngine_ReevaluateEntity_FullMethodName                  = "/senzing.sdk.szengine.SzEngine/ReevaluateEntity"
+	SzEngine_ReevaluateRecord_FullMethodName                  = "/senzing.sdk.szengine.SzEngine/ReevaluateRecord"
+	SzEngine_Reinitialize_FullMethodName                      = "/senzing.sdk.szengine.SzEngine/Reinitialize"
+	SzEngine_SearchByAttributes_FullMethodName                = "/senzing.sdk.szengine.SzEngine/SearchByAttributes"
+	SzEngine_WhyEntities_FullMethodName                       = "/senzing.sdk.szengine.SzEngine/WhyEntities"
+	SzEngine_WhyRecordInEntity_FullMethodName                 = "/senzing.sdk.szengine.SzEngine/WhyRecordInEntity"
+	SzEngine_WhyRecords_FullMethodName                        = "/senzing.sdk.szengine.SzEngine/WhyRecords"
+)
+
+// SzEngineClient is the client API for SzEngine service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The SzEngine service mirrors the senzing.SzEngine interface.
+// Destroy is not a remote call: the server owns the lifecycle of its SzEngine.
+type SzEngineClient interface {
+	AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error)
+	CloseExport(ctx context.Context, in *CloseExportRequest, opts ...grpc.CallOption) (*CloseExportResponse, error)
+	CountRedoRecords(ctx context.Context, in *CountRedoRecordsRequest, opts ...grpc.CallOption) (*CountRedoRecordsResponse, error)
+	DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*DeleteRecordResponse, error)
+	ExportCsvEntityReport(ctx context.Context, in *ExportCsvEntityReportRequest, opts ...grpc.CallOption) (*ExportCsvEntityReportResponse, error)
+	ExportCsvEntityReportIterator(ctx context.Context, in *ExportCsvEntityReportIteratorRequest, opts ...grpc.CallOption) (SzEngine_ExportCsvEntityReportIteratorClient, error)
+	ExportJSONEntityReport(ctx context.Context, in *ExportJSONEntityReportRequest, opts ...grpc.CallOption) (*ExportJSONEntityReportResponse, error)
+	ExportJSONEntityReportIterator(ctx context.Context, in *ExportJSONEntityReportIteratorRequest, opts ...grpc.CallOption) (SzEngine_ExportJSONEntityReportIteratorClient, error)
+	FetchNext(ctx context.Context, in *FetchNextRequest, opts ...grpc.CallOption) (*FetchNextResponse, error)
+	FindInterestingEntitiesByEntityID(ctx context.Context, in *FindInterestingEntitiesByEntityIDRequest, opts ...grpc.CallOption) (*FindInterestingEntitiesByEntityIDResponse, error)
+	FindInterestingEntitiesByRecordID(ctx context.Context, in *FindInterestingEntitiesByRecordIDRequest, opts ...grpc.CallOption) (*FindInterestingEntitiesByRecordIDResponse, error)
+	FindNetworkByEntityID(ctx context.Context, in *FindNetworkByEntityIDRequest, opts ...grpc.CallOption) (*FindNetworkByEntityIDResponse, error)
+	FindNetworkByRecordID(ctx context.Context, in *FindNetworkByRecordIDRequest, opts ...grpc.CallOption) (*FindNetworkByRecordIDResponse, error)
+	FindPathByEntityID(ctx context.Context, in *FindPathByEntityIDRequest, opts ...grpc.CallOption) (*FindPathByEntityIDResponse, error)
+	FindPathByRecordID(ctx context.Context, in *FindPathByRecordIDRequest, opts ...grpc.CallOption) (*FindPathByRecordIDResponse, error)
+	GetActiveConfigID(ctx context.Context, in *GetActiveConfigIDRequest, opts ...grpc.CallOption) (*GetActiveConfigIDResponse, error)
+	GetEntityByEntityID(ctx context.Context, in *GetEntityByEntityIDRequest, opts ...grpc.CallOption) (*GetEntityByEntityIDResponse, error)
+	GetEntityByRecordID(ctx context.Context, in *GetEntityByRecordIDRequest, opts ...grpc.CallOption) (*GetEntityByRecordIDResponse, error)
+	GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*GetRecordResponse, error)
+	GetRedoRecord(ctx context.Context, in *GetRedoRecordRequest, opts ...grpc.CallOption) (*GetRedoRecordResponse, error)
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	GetVirtualEntityByRecordID(ctx context.Context, in *GetVirtualEntityByRecordIDRequest, opts ...grpc.CallOption) (*GetVirtualEntityByRecordIDResponse, error)
+	HowEntityByEntityID(ctx context.Context, in *HowEntityByEntityIDRequest, opts ...grpc.CallOption) (*HowEntityByEntityIDResponse, error)
+	PrimeEngine(ctx context.Context, in *PrimeEngineRequest, opts ...grpc.CallOption) (*PrimeEngineResponse, error)
+	ProcessRedoRecord(ctx context.Context, in *ProcessRedoRecordRequest, opts ...grpc.CallOption) (*ProcessRedoRecordResponse, error)
+	ReevaluateEntity(ctx context.Context, in *ReevaluateEntityRequest, opts ...grpc.CallOption) (*ReevaluateEntityResponse, error)
+	ReevaluateRecord(ctx context.Context, in *ReevaluateRecordRequest, opts ...grpc.CallOption) (*ReevaluateRecordResponse, error)
+	Reinitialize(ctx context.Context, in *ReinitializeRequest, opts ...grpc.CallOption) (*ReinitializeResponse, error)
+	SearchByAttributes(ctx context.Context, in *SearchByAttributesRequest, opts ...grpc.CallOption) (*SearchByAttributesResponse, error)
+	WhyEntities(ctx context.Context, in *WhyEntitiesRequest, opts ...grpc.CallOption) (*WhyEntitiesResponse, error)
+	WhyRecordInEntity(ctx context.Context, in *WhyRecordInEntityRequest, opts ...grpc.CallOption) (*WhyRecordInEntityResponse, error)
+	WhyRecords(ctx context.Context, in *WhyRecordsRequest, opts ...grpc.CallOption) (*WhyRecordsResponse, error)
+}
+
+type szEngineClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSzEngineClient(cc grpc.ClientConnInterface) SzEngineClient {
+	return &szEngineClient{cc}
+}
+
+func (c *szEngineClient) AddRecord(ctx context.Context, in *AddRecordRequest, opts ...grpc.CallOption) (*AddRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_AddRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) CloseExport(ctx context.Context, in *CloseExportRequest, opts ...grpc.CallOption) (*CloseExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseExportResponse)
+	err := c.cc.Invoke(ctx, SzEngine_CloseExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) CountRedoRecords(ctx context.Context, in *CountRedoRecordsRequest, opts ...grpc.CallOption) (*CountRedoRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountRedoRecordsResponse)
+	err := c.cc.Invoke(ctx, SzEngine_CountRedoRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) DeleteRecord(ctx context.Context, in *DeleteRecordRequest, opts ...grpc.CallOption) (*DeleteRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_DeleteRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ExportCsvEntityReport(ctx context.Context, in *ExportCsvEntityReportRequest, opts ...grpc.CallOption) (*ExportCsvEntityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCsvEntityReportResponse)
+	err := c.cc.Invoke(ctx, SzEngine_ExportCsvEntityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ExportCsvEntityReportIterator(ctx context.Context, in *ExportCsvEntityReportIteratorRequest, opts ...grpc.CallOption) (SzEngine_ExportCsvEntityReportIteratorClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzEngine_ServiceDesc.Streams[0], SzEngine_ExportCsvEntityReportIterator_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &szEngineExportCsvEntityReportIteratorClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SzEngine_ExportCsvEntityReportIteratorClient interface {
+	Recv() (*ExportCsvEntityReportIteratorResponse, error)
+	grpc.ClientStream
+}
+
+type szEngineExportCsvEntityReportIteratorClient struct {
+	grpc.ClientStream
+}
+
+func (x *szEngineExportCsvEntityReportIteratorClient) Recv() (*ExportCsvEntityReportIteratorResponse, error) {
+	m := new(ExportCsvEntityReportIteratorResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *szEngineClient) ExportJSONEntityReport(ctx context.Context, in *ExportJSONEntityReportRequest, opts ...grpc.CallOption) (*ExportJSONEntityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportJSONEntityReportResponse)
+	err := c.cc.Invoke(ctx, SzEngine_ExportJSONEntityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ExportJSONEntityReportIterator(ctx context.Context, in *ExportJSONEntityReportIteratorRequest, opts ...grpc.CallOption) (SzEngine_ExportJSONEntityReportIteratorClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SzEngine_ServiceDesc.Streams[1], SzEngine_ExportJSONEntityReportIterator_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &szEngineExportJSONEntityReportIteratorClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SzEngine_ExportJSONEntityReportIteratorClient interface {
+	Recv() (*ExportJSONEntityReportIteratorResponse, error)
+	grpc.ClientStream
+}
+
+type szEngineExportJSONEntityReportIteratorClient struct {
+	grpc.ClientStream
+}
+
+func (x *szEngineExportJSONEntityReportIteratorClient) Recv() (*ExportJSONEntityReportIteratorResponse, error) {
+	m := new(ExportJSONEntityReportIteratorResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *szEngineClient) FetchNext(ctx context.Context, in *FetchNextRequest, opts ...grpc.CallOption) (*FetchNextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchNextResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FetchNext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindInterestingEntitiesByEntityID(ctx context.Context, in *FindInterestingEntitiesByEntityIDRequest, opts ...grpc.CallOption) (*FindInterestingEntitiesByEntityIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindInterestingEntitiesByEntityIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindInterestingEntitiesByEntityID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindInterestingEntitiesByRecordID(ctx context.Context, in *FindInterestingEntitiesByRecordIDRequest, opts ...grpc.CallOption) (*FindInterestingEntitiesByRecordIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindInterestingEntitiesByRecordIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindInterestingEntitiesByRecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindNetworkByEntityID(ctx context.Context, in *FindNetworkByEntityIDRequest, opts ...grpc.CallOption) (*FindNetworkByEntityIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNetworkByEntityIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindNetworkByEntityID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindNetworkByRecordID(ctx context.Context, in *FindNetworkByRecordIDRequest, opts ...grpc.CallOption) (*FindNetworkByRecordIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNetworkByRecordIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindNetworkByRecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindPathByEntityID(ctx context.Context, in *FindPathByEntityIDRequest, opts ...grpc.CallOption) (*FindPathByEntityIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPathByEntityIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindPathByEntityID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) FindPathByRecordID(ctx context.Context, in *FindPathByRecordIDRequest, opts ...grpc.CallOption) (*FindPathByRecordIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPathByRecordIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_FindPathByRecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetActiveConfigID(ctx context.Context, in *GetActiveConfigIDRequest, opts ...grpc.CallOption) (*GetActiveConfigIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveConfigIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetActiveConfigID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetEntityByEntityID(ctx context.Context, in *GetEntityByEntityIDRequest, opts ...grpc.CallOption) (*GetEntityByEntityIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntityByEntityIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetEntityByEntityID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetEntityByRecordID(ctx context.Context, in *GetEntityByRecordIDRequest, opts ...grpc.CallOption) (*GetEntityByRecordIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntityByRecordIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetEntityByRecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetRecord(ctx context.Context, in *GetRecordRequest, opts ...grpc.CallOption) (*GetRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetRedoRecord(ctx context.Context, in *GetRedoRecordRequest, opts ...grpc.CallOption) (*GetRedoRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRedoRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetRedoRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) GetVirtualEntityByRecordID(ctx context.Context, in *GetVirtualEntityByRecordIDRequest, opts ...grpc.CallOption) (*GetVirtualEntityByRecordIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVirtualEntityByRecordIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_GetVirtualEntityByRecordID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) HowEntityByEntityID(ctx context.Context, in *HowEntityByEntityIDRequest, opts ...grpc.CallOption) (*HowEntityByEntityIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HowEntityByEntityIDResponse)
+	err := c.cc.Invoke(ctx, SzEngine_HowEntityByEntityID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) PrimeEngine(ctx context.Context, in *PrimeEngineRequest, opts ...grpc.CallOption) (*PrimeEngineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrimeEngineResponse)
+	err := c.cc.Invoke(ctx, SzEngine_PrimeEngine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ProcessRedoRecord(ctx context.Context, in *ProcessRedoRecordRequest, opts ...grpc.CallOption) (*ProcessRedoRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessRedoRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_ProcessRedoRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ReevaluateEntity(ctx context.Context, in *ReevaluateEntityRequest, opts ...grpc.CallOption) (*ReevaluateEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReevaluateEntityResponse)
+	err := c.cc.Invoke(ctx, SzEngine_ReevaluateEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) ReevaluateRecord(ctx context.Context, in *ReevaluateRecordRequest, opts ...grpc.CallOption) (*ReevaluateRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReevaluateRecordResponse)
+	err := c.cc.Invoke(ctx, SzEngine_ReevaluateRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) Reinitialize(ctx context.Context, in *ReinitializeRequest, opts ...grpc.CallOption) (*ReinitializeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReinitializeResponse)
+	err := c.cc.Invoke(ctx, SzEngine_Reinitialize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) SearchByAttributes(ctx context.Context, in *SearchByAttributesRequest, opts ...grpc.CallOption) (*SearchByAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchByAttributesResponse)
+	err := c.cc.Invoke(ctx, SzEngine_SearchByAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) WhyEntities(ctx context.Context, in *WhyEntitiesRequest, opts ...grpc.CallOption) (*WhyEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhyEntitiesResponse)
+	err := c.cc.Invoke(ctx, SzEngine_WhyEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) WhyRecordInEntity(ctx context.Context, in *WhyRecordInEntityRequest, opts ...grpc.CallOption) (*WhyRecordInEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhyRecordInEntityResponse)
+	err := c.cc.Invoke(ctx, SzEngine_WhyRecordInEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *szEngineClient) WhyRecords(ctx context.Context, in *WhyRecordsRequest, opts ...grpc.CallOption) (*WhyRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhyRecordsResponse)
+	err := c.cc.Invoke(ctx, SzEngine_WhyRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SzEngineServer is the server API for SzEngine service.
+// All implementations must embed UnimplementedSzEngineServer
+// for forward compatibility
+//
+// The SzEngine service mirrors the senzing.SzEngine interface.
+// Destroy is not a remote call: the server owns the lifecycle of its SzEngine.
+type SzEngineServer interface {
+	AddRecord(context.Context, *AddRecordRequest) (*AddRecordResponse, error)
+	CloseExport(context.Context, *CloseExportRequest) (*CloseExportResponse, error)
+	CountRedoRecords(context.Context, *CountRedoRecordsRequest) (*CountRedoRecordsResponse, error)
+	DeleteRecord(context.Context, *DeleteRecordRequest) (*DeleteRecordResponse, error)
+	ExportCsvEntityReport(context.Context, *ExportCsvEntityReportRequest) (*ExportCsvEntityReportResponse, error)
+	ExportCsvEntityReportIterator(*ExportCsvEntityReportIteratorRequest, SzEngine_ExportCsvEntityReportIteratorServer) error
+	ExportJSONEntityReport(context.Context, *ExportJSONEntityReportRequest) (*ExportJSONEntityReportResponse, error)
+	ExportJSONEntityReportIterator(*ExportJSONEntityReportIteratorRequest, SzEngine_ExportJSONEntityReportIteratorServer) error
+	FetchNext(context.Context, *FetchNextRequest) (*FetchNextResponse, error)
+	FindInterestingEntitiesByEntityID(context.Context, *FindInterestingEntitiesByEntityIDRequest) (*FindInterestingEntitiesByEntityIDResponse, error)
+	FindInterestingEntitiesByRecordID(context.Context, *FindInterestingEntitiesByRecordIDRequest) (*FindInterestingEntitiesByRecordIDResponse, error)
+	FindNetworkByEntityID(context.Context, *FindNetworkByEntityIDRequest) (*FindNetworkByEntityIDResponse, error)
+	FindNetworkByRecordID(context.Context, *FindNetworkByRecordIDRequest) (*FindNetworkByRecordIDResponse, error)
+	FindPathByEntityID(context.Context, *FindPathByEntityIDRequest) (*FindPathByEntityIDResponse, error)
+	FindPathByRecordID(context.Context, *FindPathByRecordIDRequest) (*FindPathByRecordIDResponse, error)
+	GetActiveConfigID(context.Context, *GetActiveConfigIDRequest) (*GetActiveConfigIDResponse, error)
+	GetEntityByEntityID(context.Context, *GetEntityByEntityIDRequest) (*GetEntityByEntityIDResponse, error)
+	GetEntityByRecordID(context.Context, *GetEntityByRecordIDRequest) (*GetEntityByRecordIDResponse, error)
+	GetRecord(context.Context, *GetRecordRequest) (*GetRecordResponse, error)
+	GetRedoRecord(context.Context, *GetRedoRecordRequest) (*GetRedoRecordResponse, error)
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	GetVirtualEntityByRecordID(context.Context, *GetVirtualEntityByRecordIDRequest) (*GetVirtualEntityByRecordIDResponse, error)
+	HowEntityByEntityID(context.Context, *HowEntityByEntityIDRequest) (*HowEntityByEntityIDResponse, error)
+	PrimeEngine(context.Context, *PrimeEngineRequest) (*PrimeEngineResponse, error)
+	ProcessRedoRecord(context.Context, *ProcessRedoRecordRequest) (*ProcessRedoRecordResponse, error)
+	ReevaluateEntity(context.Context, *ReevaluateEntityRequest) (*ReevaluateEntityResponse, error)
+	ReevaluateRecord(context.Context, *ReevaluateRecordRequest) (*ReevaluateRecordResponse, error)
+	Reinitialize(context.Context, *ReinitializeRequest) (*ReinitializeResponse, error)
+	SearchByAttributes(context.Context, *SearchByAttributesRequest) (*SearchByAttributesResponse, error)
+	WhyEntities(context.Context, *WhyEntitiesRequest) (*WhyEntitiesResponse, error)
+	WhyRecordInEntity(context.Context, *WhyRecordInEntityRequest) (*WhyRecordInEntityResponse, error)
+	WhyRecords(context.Context, *WhyRecordsRequest) (*WhyRecordsResponse, error)
+	mustEmbedUnimplementedSzEngineServer()
+}
+
+// UnimplementedSzEngineServer must be embedded to have forward compatible implementations.
+type UnimplementedSzEngineServer struct {
+}
+
+func (UnimplementedSzEngineServer) AddRecord(context.Context, *AddRecordRequest) (*AddRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecord not implemented")
+}
+func (UnimplementedSzEngineServer) CloseExport(context.Context, *CloseExportRequest) (*CloseExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseExport not implemented")
+}
+func (UnimplementedSzEngineServer) CountRedoRecords(context.Context, *CountRedoRecordsRequest) (*CountRedoRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountRedoRecords not implemented")
+}
+func (UnimplementedSzEngineServer) DeleteRecord(context.Context, *DeleteRecordRequest) (*DeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (UnimplementedSzEngineServer) ExportCsvEntityReport(context.Context, *ExportCsvEntityReportRequest) (*ExportCsvEntityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCsvEntityReport not implemented")
+}
+func (UnimplementedSzEngineServer) ExportCsvEntityReportIterator(*ExportCsvEntityReportIteratorRequest, SzEngine_ExportCsvEntityReportIteratorServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCsvEntityReportIterator not implemented")
+}
+func (UnimplementedSzEngineServer) ExportJSONEntityReport(context.Context, *ExportJSONEntityReportRequest) (*ExportJSONEntityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportJSONEntityReport not implemented")
+}
+func (UnimplementedSzEngineServer) ExportJSONEntityReportIterator(*ExportJSONEntityReportIteratorRequest, SzEngine_ExportJSONEntityReportIteratorServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportJSONEntityReportIterator not implemented")
+}
+func (UnimplementedSzEngineServer) FetchNext(context.Context, *FetchNextRequest) (*FetchNextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchNext not implemented")
+}
+func (UnimplementedSzEngineServer) FindInterestingEntitiesByEntityID(context.Context, *FindInterestingEntitiesByEntityIDRequest) (*FindInterestingEntitiesByEntityIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInterestingEntitiesByEntityID not implemented")
+}
+func (UnimplementedSzEngineServer) FindInterestingEntitiesByRecordID(context.Context, *FindInterestingEntitiesByRecordIDRequest) (*FindInterestingEntitiesByRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindInterestingEntitiesByRecordID not implemented")
+}
+func (UnimplementedSzEngineServer) FindNetworkByEntityID(context.Context, *FindNetworkByEntityIDRequest) (*FindNetworkByEntityIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNetworkByEntityID not implemented")
+}
+func (UnimplementedSzEngineServer) FindNetworkByRecordID(context.Context, *FindNetworkByRecordIDRequest) (*FindNetworkByRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNetworkByRecordID not implemented")
+}
+func (UnimplementedSzEngineServer) FindPathByEntityID(context.Context, *FindPathByEntityIDRequest) (*FindPathByEntityIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPathByEntityID not implemented")
+}
+func (UnimplementedSzEngineServer) FindPathByRecordID(context.Context, *FindPathByRecordIDRequest) (*FindPathByRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPathByRecordID not implemented")
+}
+func (UnimplementedSzEngineServer) GetActiveConfigID(context.Context, *GetActiveConfigIDRequest) (*GetActiveConfigIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveConfigID not implemented")
+}
+func (UnimplementedSzEngineServer) GetEntityByEntityID(context.Context, *GetEntityByEntityIDRequest) (*GetEntityByEntityIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntityByEntityID not implemented")
+}
+func (UnimplementedSzEngineServer) GetEntityByRecordID(context.Context, *GetEntityByRecordIDRequest) (*GetEntityByRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntityByRecordID not implemented")
+}
+func (UnimplementedSzEngineServer) GetRecord(context.Context, *GetRecordRequest) (*GetRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
+}
+func (UnimplementedSzEngineServer) GetRedoRecord(context.Context, *GetRedoRecordRequest) (*GetRedoRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedoRecord not implemented")
+}
+func (UnimplementedSzEngineServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedSzEngineServer) GetVirtualEntityByRecordID(context.Context, *GetVirtualEntityByRecordIDRequest) (*GetVirtualEntityByRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVirtualEntityByRecordID not implemented")
+}
+func (UnimplementedSzEngineServer) HowEntityByEntityID(context.Context, *HowEntityByEntityIDRequest) (*HowEntityByEntityIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HowEntityByEntityID not implemented")
+}
+func (UnimplementedSzEngineServer) PrimeEngine(context.Context, *PrimeEngineRequest) (*PrimeEngineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrimeEngine not implemented")
+}
+func (UnimplementedSzEngineServer) ProcessRedoRecord(context.Context, *ProcessRedoRecordRequest) (*ProcessRedoRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRedoRecord not implemented")
+}
+func (UnimplementedSzEngineServer) ReevaluateEntity(context.Context, *ReevaluateEntityRequest) (*ReevaluateEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReevaluateEntity not implemented")
+}
+func (UnimplementedSzEngineServer) ReevaluateRecord(context.Context, *ReevaluateRecordRequest) (*ReevaluateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReevaluateRecord not implemented")
+}
+func (UnimplementedSzEngineServer) Reinitialize(context.Context, *ReinitializeRequest) (*ReinitializeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reinitialize not implemented")
+}
+func (UnimplementedSzEngineServer) SearchByAttributes(context.Context, *SearchByAttributesRequest) (*SearchByAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchByAttributes not implemented")
+}
+func (UnimplementedSzEngineServer) WhyEntities(context.Context, *WhyEntitiesRequest) (*WhyEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhyEntities not implemented")
+}
+func (UnimplementedSzEngineServer) WhyRecordInEntity(context.Context, *WhyRecordInEntityRequest) (*WhyRecordInEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhyRecordInEntity not implemented")
+}
+func (UnimplementedSzEngineServer) WhyRecords(context.Context, *WhyRecordsRequest) (*WhyRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhyRecords not implemented")
+}
+func (UnimplementedSzEngineServer) mustEmbedUnimplementedSzEngineServer() {}
+
+// UnsafeSzEngineServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SzEngineServer will
+// result in compilation errors.
+type UnsafeSzEngineServer interface {
+	mustEmbedUnimplementedSzEngineServer()
+}
+
+func RegisterSzEngineServer(s grpc.ServiceRegistrar, srv SzEngineServer) {
+	s.RegisterService(&SzEngine_ServiceDesc, srv)
+}
+
+func _SzEngine_AddRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).AddRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_AddRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).AddRecord(ctx, req.(*AddRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_CloseExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).CloseExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_CloseExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).CloseExport(ctx, req.(*CloseExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_CountRedoRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRedoRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).CountRedoRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_CountRedoRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).CountRedoRecords(ctx, req.(*CountRedoRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_DeleteRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).DeleteRecord(ctx, req.(*DeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ExportCsvEntityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCsvEntityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).ExportCsvEntityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_ExportCsvEntityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).ExportCsvEntityReport(ctx, req.(*ExportCsvEntityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ExportCsvEntityReportIterator_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCsvEntityReportIteratorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SzEngineServer).ExportCsvEntityReportIterator(m, &szEngineExportCsvEntityReportIteratorServer{ServerStream: stream})
+}
+
+type SzEngine_ExportCsvEntityReportIteratorServer interface {
+	Send(*ExportCsvEntityReportIteratorResponse) error
+	grpc.ServerStream
+}
+
+type szEngineExportCsvEntityReportIteratorServer struct {
+	grpc.ServerStream
+}
+
+func (x *szEngineExportCsvEntityReportIteratorServer) Send(m *ExportCsvEntityReportIteratorResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SzEngine_ExportJSONEntityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportJSONEntityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).ExportJSONEntityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_ExportJSONEntityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).ExportJSONEntityReport(ctx, req.(*ExportJSONEntityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ExportJSONEntityReportIterator_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportJSONEntityReportIteratorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SzEngineServer).ExportJSONEntityReportIterator(m, &szEngineExportJSONEntityReportIteratorServer{ServerStream: stream})
+}
+
+type SzEngine_ExportJSONEntityReportIteratorServer interface {
+	Send(*ExportJSONEntityReportIteratorResponse) error
+	grpc.ServerStream
+}
+
+type szEngineExportJSONEntityReportIteratorServer struct {
+	grpc.ServerStream
+}
+
+func (x *szEngineExportJSONEntityReportIteratorServer) Send(m *ExportJSONEntityReportIteratorResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SzEngine_FetchNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchNextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FetchNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FetchNext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FetchNext(ctx, req.(*FetchNextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindInterestingEntitiesByEntityID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInterestingEntitiesByEntityIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindInterestingEntitiesByEntityID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindInterestingEntitiesByEntityID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindInterestingEntitiesByEntityID(ctx, req.(*FindInterestingEntitiesByEntityIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindInterestingEntitiesByRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindInterestingEntitiesByRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindInterestingEntitiesByRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindInterestingEntitiesByRecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindInterestingEntitiesByRecordID(ctx, req.(*FindInterestingEntitiesByRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindNetworkByEntityID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNetworkByEntityIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindNetworkByEntityID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindNetworkByEntityID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindNetworkByEntityID(ctx, req.(*FindNetworkByEntityIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindNetworkByRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNetworkByRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindNetworkByRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindNetworkByRecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindNetworkByRecordID(ctx, req.(*FindNetworkByRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindPathByEntityID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPathByEntityIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindPathByEntityID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindPathByEntityID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindPathByEntityID(ctx, req.(*FindPathByEntityIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_FindPathByRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPathByRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).FindPathByRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_FindPathByRecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).FindPathByRecordID(ctx, req.(*FindPathByRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetActiveConfigID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveConfigIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetActiveConfigID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetActiveConfigID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetActiveConfigID(ctx, req.(*GetActiveConfigIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetEntityByEntityID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntityByEntityIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetEntityByEntityID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetEntityByEntityID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetEntityByEntityID(ctx, req.(*GetEntityByEntityIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetEntityByRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntityByRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetEntityByRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetEntityByRecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetEntityByRecordID(ctx, req.(*GetEntityByRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetRecord(ctx, req.(*GetRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetRedoRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedoRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetRedoRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetRedoRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetRedoRecord(ctx, req.(*GetRedoRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_GetVirtualEntityByRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVirtualEntityByRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).GetVirtualEntityByRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_GetVirtualEntityByRecordID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).GetVirtualEntityByRecordID(ctx, req.(*GetVirtualEntityByRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_HowEntityByEntityID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HowEntityByEntityIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).HowEntityByEntityID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_HowEntityByEntityID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).HowEntityByEntityID(ctx, req.(*HowEntityByEntityIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_PrimeEngine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrimeEngineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).PrimeEngine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_PrimeEngine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).PrimeEngine(ctx, req.(*PrimeEngineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ProcessRedoRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRedoRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).ProcessRedoRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_ProcessRedoRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).ProcessRedoRecord(ctx, req.(*ProcessRedoRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ReevaluateEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReevaluateEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).ReevaluateEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_ReevaluateEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).ReevaluateEntity(ctx, req.(*ReevaluateEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_ReevaluateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReevaluateRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).ReevaluateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_ReevaluateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).ReevaluateRecord(ctx, req.(*ReevaluateRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_Reinitialize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinitializeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).Reinitialize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_Reinitialize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).Reinitialize(ctx, req.(*ReinitializeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_SearchByAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchByAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).SearchByAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_SearchByAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).SearchByAttributes(ctx, req.(*SearchByAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_WhyEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhyEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).WhyEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_WhyEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).WhyEntities(ctx, req.(*WhyEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_WhyRecordInEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhyRecordInEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).WhyRecordInEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_WhyRecordInEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).WhyRecordInEntity(ctx, req.(*WhyRecordInEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SzEngine_WhyRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhyRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SzEngineServer).WhyRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SzEngine_WhyRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SzEngineServer).WhyRecords(ctx, req.(*WhyRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SzEngine_ServiceDesc is the grpc.ServiceDesc for SzEngine service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SzEngine_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "senzing.sdk.szengine.SzEngine",
+	HandlerType: (*SzEngineServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddRecord",
+			Handler:    _SzEngine_AddRecord_Handler,
+		},
+		{
+			MethodName: "CloseExport",
+			Handler:    _SzEngine_CloseExport_Handler,
+		},
+		{
+			MethodName: "CountRedoRecords",
+			Handler:    _SzEngine_CountRedoRecords_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _SzEngine_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "ExportCsvEntityReport",
+			Handler:    _SzEngine_ExportCsvEntityReport_Handler,
+		},
+		{
+			MethodName: "ExportJSONEntityReport",
+			Handler:    _SzEngine_ExportJSONEntityReport_Handler,
+		},
+		{
+			MethodName: "FetchNext",
+			Handler:    _SzEngine_FetchNext_Handler,
+		},
+		{
+			MethodName: "FindInterestingEntitiesByEntityID",
+			Handler:    _SzEngine_FindInterestingEntitiesByEntityID_Handler,
+		},
+		{
+			MethodName: "FindInterestingEntitiesByRecordID",
+			Handler:    _SzEngine_FindInterestingEntitiesByRecordID_Handler,
+		},
+		{
+			MethodName: "FindNetworkByEntityID",
+			Handler:    _SzEngine_FindNetworkByEntityID_Handler,
+		},
+		{
+			MethodName: "FindNetworkByRecordID",
+			Handler:    _SzEngine_FindNetworkByRecordID_Handler,
+		},
+		{
+			MethodName: "FindPathByEntityID",
+			Handler:    _SzEngine_FindPathByEntityID_Handler,
+		},
+		{
+			MethodName: "FindPathByRecordID",
+			Handler:    _SzEngine_FindPathByRecordID_Handler,
+		},
+		{
+			MethodName: "GetActiveConfigID",
+			Handler:    _SzEngine_GetActiveConfigID_Handler,
+		},
+		{
+			MethodName: "GetEntityByEntityID",
+			Handler:    _SzEngine_GetEntityByEntityID_Handler,
+		},
+		{
+			MethodName: "GetEntityByRecordID",
+			Handler:    _SzEngine_GetEntityByRecordID_Handler,
+		},
+		{
+			MethodName: "GetRecord",
+			Handler:    _SzEngine_GetRecord_Handler,
+		},
+		{
+			MethodName: "GetRedoRecord",
+			Handler:    _SzEngine_GetRedoRecord_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _SzEngine_GetStats_Handler,
+		},
+		{
+			MethodName: "GetVirtualEntityByRecordID",
+			Handler:    _SzEngine_GetVirtualEntityByRecordID_Handler,
+		},
+		{
+			MethodName: "HowEntityByEntityID",
+			Handler:    _SzEngine_HowEntityByEntityID_Handler,
+		},
+		{
+			MethodName: "PrimeEngine",
+			Handler:    _SzEngine_PrimeEngine_Handler,
+		},
+		{
+			MethodName: "ProcessRedoRecord",
+			Handler:    _SzEngine_ProcessRedoRecord_Handler,
+		},
+		{
+			MethodName: "ReevaluateEntity",
+			Handler:    _SzEngine_ReevaluateEntity_Handler,
+		},
+		{
+			MethodName: "ReevaluateRecord",
+			Handler:    _SzEngine_ReevaluateRecord_Handler,
+		},
+		{
+			MethodName: "Reinitialize",
+			Handler:    _SzEngine_Reinitialize_Handler,
+		},
+		{
+			MethodName: "SearchByAttributes",
+			Handler:    _SzEngine_SearchByAttributes_Handler,
+		},
+		{
+			MethodName: "WhyEntities",
+			Handler:    _SzEngine_WhyEntities_Handler,
+		},
+		{
+			MethodName: "WhyRecordInEntity",
+			Handler:    _SzEngine_WhyRecordInEntity_Handler,
+		},
+		{
+			MethodName: "WhyRecords",
+			Handler:    _SzEngine_WhyRecords_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCsvEntityReportIterator",
+			Handler:       _SzEngine_ExportCsvEntityReportIterator_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportJSONEntityReportIterator",
+			Handler:       _SzEngine_ExportJSONEntityReportIterator_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "szengine.proto",
+}
@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: szerror.proto
+
+package szerrorpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SzError is attached to the status of a failed call, so clients can rebuild the szerror classification.
+type SzError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types are the names of the szerror types of the error, e.g. "SzNotFound", "SzBadInput".
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *SzError) Reset() {
+	*x = SzError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_szerror_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SzError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SzError) ProtoMessage() {}
+
+func (x *SzError) ProtoReflect() protoreflect.Message {
+	mi := &file_szerror_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SzError.ProtoReflect.Descriptor instead.
+func (*SzError) Descriptor() ([]byte, []int) {
+	return file_szerror_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SzError) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+var File_szerror_proto protoreflect.FileDescriptor
+
+var file_szerror_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x64, 0x6b, 0x2e, 0x73, 0x7a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x07, 0x53, 0x7a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x6e, 0x7a, 0x69, 0x6e, 0x67, 0x2d, 0x67, 0x61, 0x72, 0x61,
+	0x67, 0x65, 0x2f, 0x73, 0x7a, 0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x7a, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x73, 0x7a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_szerror_proto_rawDescOnce sync.Once
+	file_szerror_proto_rawDescData = file_szerror_proto_rawDesc
+)
+
+func file_szerror_proto_rawDescGZIP() []byte {
+	file_szerror_proto_rawDescOnce.Do(func() {
+		file_szerror_proto_rawDescData = protoimpl.X.CompressGZIP(file_szerror_proto_rawDescData)
+	})
+	return file_szerror_proto_rawDescData
+}
+
+var file_szerror_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_szerror_proto_goTypes = []any{
+	(*SzError)(nil), // 0: senzing.sdk.szerror.SzError
+}
+var file_szerror_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_szerror_proto_init() }
+func file_szerror_proto_init() {
+	if File_szerror_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_szerror_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SzError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_szerror_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_szerror_proto_goTypes,
+		DependencyIndexes: file_szerror_proto_depIdxs,
+		MessageInfos:      file_szerror_proto_msgTypes,
+	}.Build()
+	File_szerror_proto = out.File
+	file_szerror_proto_rawDesc = nil
+	file_szerror_proto_goTypes = nil
+	file_szerror_proto_depIdxs = nil
+}
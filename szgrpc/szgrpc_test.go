@@ -0,0 +1,401 @@
+package szgrpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/client"
+	"github.com/senzing-garage/sz-sdk-go/szgrpc/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// An in-memory factory. Its objects implement only the methods the tests call.
+type memoryFactory struct {
+	destroyed int
+	szEngine  *memorySzEngine
+}
+
+type memorySzConfig struct {
+	senzing.SzConfig
+	factory *memoryFactory
+}
+
+type memorySzConfigManager struct {
+	senzing.SzConfigManager
+	factory *memoryFactory
+}
+
+type memorySzDiagnostic struct {
+	senzing.SzDiagnostic
+	factory *memoryFactory
+}
+
+type memorySzEngine struct {
+	senzing.SzEngine
+	factory *memoryFactory
+	mutex   sync.Mutex
+	records map[string]string
+}
+
+type memorySzProduct struct {
+	senzing.SzProduct
+	factory *memoryFactory
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestSzgrpc_Code(test *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{name: "nil", err: nil, expected: codes.OK},
+		{name: "not-found", err: szerror.New(33, "0033E|Unknown record"), expected: codes.NotFound},
+		{name: "bad-input", err: szerror.New(2, "0002E|Invalid message"), expected: codes.InvalidArgument},
+		{name: "configuration", err: szerror.New(14, "0014E|Invalid datastore configuration"), expected: codes.FailedPrecondition},
+		{name: "not-initialized", err: szerror.New(48, "0048E|G2 is not initialized"), expected: codes.FailedPrecondition},
+		{name: "retry-timeout", err: szerror.New(10, "0010E|Retry timeout exceeded"), expected: codes.Unavailable},
+		{name: "license", err: szerror.New(9000, "9000E|Maximum number of records ingested"), expected: codes.PermissionDenied},
+		{name: "connection-lost", err: szerror.New(1006, "1006E|Database Connection Failure"), expected: codes.Unavailable},
+		{name: "database", err: szerror.New(1000, "1000E|Unhandled Database Error"), expected: codes.Internal},
+		{name: "base", err: szerror.New(5, "0005E|Exceeded the maximum number of retries"), expected: codes.Unknown},
+		{name: "unclassified", err: errors.New("plain"), expected: codes.Unknown},
+		{name: "canceled", err: fmt.Errorf("call: %w", context.Canceled), expected: codes.Canceled},
+		{name: "deadline", err: context.DeadlineExceeded, expected: codes.DeadlineExceeded},
+		{name: "status", err: status.Error(codes.Aborted, "aborted"), expected: codes.Aborted},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			assert.Equal(test, testCase.expected, szgrpc.Code(testCase.err))
+		})
+	}
+}
+
+func TestSzgrpc_GRPCCodes(test *testing.T) {
+	for code, expected := range map[uint32]codes.Code{
+		szerror.GRPCCodeOK:                 codes.OK,
+		szerror.GRPCCodeCanceled:           codes.Canceled,
+		szerror.GRPCCodeUnknown:            codes.Unknown,
+		szerror.GRPCCodeInvalidArgument:    codes.InvalidArgument,
+		szerror.GRPCCodeDeadlineExceeded:   codes.DeadlineExceeded,
+		szerror.GRPCCodeNotFound:           codes.NotFound,
+		szerror.GRPCCodePermissionDenied:   codes.PermissionDenied,
+		szerror.GRPCCodeResourceExhausted:  codes.ResourceExhausted,
+		szerror.GRPCCodeFailedPrecondition: codes.FailedPrecondition,
+		szerror.GRPCCodeInternal:           codes.Internal,
+		szerror.GRPCCodeUnavailable:        codes.Unavailable,
+	} {
+		assert.Equal(test, expected, codes.Code(code), expected.String())
+	}
+}
+
+func TestSzgrpc_FromCode(test *testing.T) {
+	assert.NoError(test, szgrpc.FromCode(codes.OK, "ok"))
+	for _, code := range []codes.Code{codes.FailedPrecondition, codes.Internal, codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.Unavailable} {
+		err := szgrpc.FromCode(code, "message")
+		assert.Contains(test, err.Error(), "message")
+		assert.Equal(test, code, szgrpc.Code(err), "inverse of %s", code)
+	}
+	require.ErrorIs(test, szgrpc.FromCode(codes.ResourceExhausted, "exhausted"), szerror.ErrSzRetryable)
+	require.ErrorIs(test, szgrpc.FromCode(codes.Unavailable, "unavailable"), szerror.ErrSzRetryable)
+	unknown := szgrpc.FromCode(codes.Unknown, "unknown")
+	assert.Equal(test, codes.Unknown, szgrpc.Code(unknown))
+	assert.Empty(test, szerror.Types(unknown))
+}
+
+func TestSzgrpc_ToStatus_FromStatus(test *testing.T) {
+	assert.NoError(test, szgrpc.ToStatus(nil))
+	assert.NoError(test, szgrpc.FromStatus(nil))
+	plain := errors.New("not a status")
+	assert.Equal(test, plain, szgrpc.FromStatus(plain))
+
+	for senzingErrorCode := range szerror.SzErrorTypes {
+		original := szerror.New(senzingErrorCode, fmt.Sprintf("%04dE|Test message", senzingErrorCode))
+		converted := szgrpc.ToStatus(original)
+		assert.Equal(test, szgrpc.Code(original), status.Code(converted), senzingErrorCode)
+		rebuilt := szgrpc.FromStatus(converted)
+		for _, typeID := range szerror.SzErrorTypesList {
+			sentinel := szerror.SzErrorMap[typeID]
+			assert.Equal(test, errors.Is(original, sentinel), errors.Is(rebuilt, sentinel), "code %d type %d", senzingErrorCode, typeID)
+		}
+		assert.Equal(test, fmt.Sprintf("%04dE|Test message", senzingErrorCode), rebuilt.Error())
+		assert.Equal(test, status.Code(converted), status.Code(rebuilt))
+	}
+
+	statusErr := status.Error(codes.Unavailable, "connection refused")
+	assert.Equal(test, statusErr, szgrpc.ToStatus(statusErr))
+	rebuilt := szgrpc.FromStatus(statusErr)
+	require.ErrorIs(test, rebuilt, szerror.ErrSzRetryable, "classified by code without details")
+	require.ErrorIs(test, szgrpc.FromStatus(status.Error(codes.DeadlineExceeded, "deadline")), context.DeadlineExceeded)
+	require.ErrorIs(test, szgrpc.FromStatus(szgrpc.ToStatus(context.Canceled)), context.Canceled)
+}
+
+func TestSzgrpc_EndToEnd(test *testing.T) {
+	ctx := context.TODO()
+	factory, memory := newTestFactory(test)
+
+	szEngine, err := factory.CreateSzEngine(ctx)
+	require.NoError(test, err)
+	_, err = szEngine.AddRecord(ctx, "CUSTOMERS", "1001", `{"NAME_FULL": "Robert Smith"}`, senzing.SzNoFlags)
+	require.NoError(test, err)
+	_, err = szEngine.AddRecord(ctx, "CUSTOMERS", "1002", `{"NAME_FULL": "Bob Smith"}`, senzing.SzNoFlags)
+	require.NoError(test, err)
+	record, err := szEngine.GetRecord(ctx, "CUSTOMERS", "1001", senzing.SzNoFlags)
+	require.NoError(test, err)
+	assert.Equal(test, `{"NAME_FULL": "Robert Smith"}`, record)
+
+	_, err = szEngine.GetRecord(ctx, "CUSTOMERS", "9999", senzing.SzNoFlags)
+	require.ErrorIs(test, err, szerror.ErrSzNotFound)
+	require.ErrorIs(test, err, szerror.ErrSzBadInput)
+	assert.NotErrorIs(test, err, szerror.ErrSzRetryable)
+	assert.Equal(test, codes.NotFound, status.Code(err))
+	assert.Contains(test, err.Error(), "0033E|Unknown record")
+
+	count, err := szEngine.CountRedoRecords(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(2), count)
+	require.NoError(test, szEngine.PrimeEngine(ctx))
+
+	fragments := []string{}
+	for fragment := range szEngine.ExportJSONEntityReportIterator(ctx, senzing.SzNoFlags) {
+		require.NoError(test, fragment.Error)
+		fragments = append(fragments, fragment.Value)
+	}
+	assert.Equal(test, []string{`{"NAME_FULL": "Bob Smith"}`, `{"NAME_FULL": "Robert Smith"}`}, fragments)
+	for fragment := range szEngine.ExportCsvEntityReportIterator(ctx, "RESOLVED_ENTITY_ID", senzing.SzNoFlags) {
+		require.ErrorIs(test, fragment.Error, szerror.ErrSzBadInput)
+	}
+
+	szConfig, err := factory.CreateSzConfig(ctx)
+	require.NoError(test, err)
+	configHandle, err := szConfig.CreateConfig(ctx)
+	require.NoError(test, err)
+	dataSources, err := szConfig.GetDataSources(ctx, configHandle)
+	require.NoError(test, err)
+	assert.Equal(test, `{"handle":4096}`, dataSources)
+
+	szConfigManager, err := factory.CreateSzConfigManager(ctx)
+	require.NoError(test, err)
+	configID, err := szConfigManager.GetDefaultConfigID(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, int64(41), configID)
+
+	szDiagnostic, err := factory.CreateSzDiagnostic(ctx)
+	require.NoError(test, err)
+	performance, err := szDiagnostic.CheckDatastorePerformance(ctx, 3)
+	require.NoError(test, err)
+	assert.Equal(test, `{"numRecordsInserted":3}`, performance)
+
+	szProduct, err := factory.CreateSzProduct(ctx)
+	require.NoError(test, err)
+	version, err := szProduct.GetVersion(ctx)
+	require.NoError(test, err)
+	assert.Equal(test, `{"VERSION":"4.0.0"}`, version)
+	require.NoError(test, szProduct.Destroy(ctx))
+	assert.Zero(test, memory.destroyed, "client Destroy does not destroy the server's objects")
+}
+
+func TestSzgrpc_EndToEnd_canceled(test *testing.T) {
+	factory, _ := newTestFactory(test)
+	szEngine, err := factory.CreateSzEngine(context.TODO())
+	require.NoError(test, err)
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, err = szEngine.GetStats(ctx)
+	require.ErrorIs(test, err, context.Canceled)
+}
+
+func TestSzgrpc_Server_Destroy(test *testing.T) {
+	memory := &memoryFactory{}
+	grpcServer := grpc.NewServer()
+	szServer := &server.Server{Factory: memory}
+	require.NoError(test, szServer.Register(context.TODO(), grpcServer))
+	require.NoError(test, szServer.Destroy(context.TODO()))
+	assert.Equal(test, 5, memory.destroyed)
+}
+
+// ----------------------------------------------------------------------------
+// Internal functions
+// ----------------------------------------------------------------------------
+
+// Serve a memoryFactory over bufconn and return a client factory connected to it.
+func newTestFactory(test *testing.T) (senzing.SzAbstractFactory, *memoryFactory) {
+	test.Helper()
+	memory := &memoryFactory{}
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	szServer := &server.Server{Factory: memory}
+	require.NoError(test, szServer.Register(context.TODO(), grpcServer))
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(test, err)
+	test.Cleanup(func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+		_ = szServer.Destroy(context.TODO())
+	})
+	return &client.SzAbstractFactory{GrpcConnection: conn}, memory
+}
+
+// ----------------------------------------------------------------------------
+// Methods - memoryFactory
+// ----------------------------------------------------------------------------
+
+func (factory *memoryFactory) CreateSzConfig(ctx context.Context) (senzing.SzConfig, error) {
+	return &memorySzConfig{factory: factory}, nil
+}
+
+func (factory *memoryFactory) CreateSzConfigManager(ctx context.Context) (senzing.SzConfigManager, error) {
+	return &memorySzConfigManager{factory: factory}, nil
+}
+
+func (factory *memoryFactory) CreateSzDiagnostic(ctx context.Context) (senzing.SzDiagnostic, error) {
+	return &memorySzDiagnostic{factory: factory}, nil
+}
+
+func (factory *memoryFactory) CreateSzEngine(ctx context.Context) (senzing.SzEngine, error) {
+	factory.szEngine = &memorySzEngine{factory: factory, records: map[string]string{}}
+	return factory.szEngine, nil
+}
+
+func (factory *memoryFactory) CreateSzProduct(ctx context.Context) (senzing.SzProduct, error) {
+	return &memorySzProduct{factory: factory}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods - in-memory Sz objects
+// ----------------------------------------------------------------------------
+
+func (szConfig *memorySzConfig) CreateConfig(ctx context.Context) (uintptr, error) {
+	return 4096, nil
+}
+
+func (szConfig *memorySzConfig) Destroy(ctx context.Context) error {
+	szConfig.factory.destroyed++
+	return nil
+}
+
+func (szConfig *memorySzConfig) GetDataSources(ctx context.Context, configHandle uintptr) (string, error) {
+	return fmt.Sprintf(`{"handle":%d}`, configHandle), nil
+}
+
+func (szConfigManager *memorySzConfigManager) Destroy(ctx context.Context) error {
+	szConfigManager.factory.destroyed++
+	return nil
+}
+
+func (szConfigManager *memorySzConfigManager) GetDefaultConfigID(ctx context.Context) (int64, error) {
+	return 41, nil
+}
+
+func (szDiagnostic *memorySzDiagnostic) CheckDatastorePerformance(ctx context.Context, secondsToRun int) (string, error) {
+	return fmt.Sprintf(`{"numRecordsInserted":%d}`, secondsToRun), nil
+}
+
+func (szDiagnostic *memorySzDiagnostic) Destroy(ctx context.Context) error {
+	szDiagnostic.factory.destroyed++
+	return nil
+}
+
+func (szEngine *memorySzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	szEngine.mutex.Lock()
+	defer szEngine.mutex.Unlock()
+	szEngine.records[dataSourceCode+"/"+recordID] = recordDefinition
+	return "", nil
+}
+
+func (szEngine *memorySzEngine) CountRedoRecords(ctx context.Context) (int64, error) {
+	szEngine.mutex.Lock()
+	defer szEngine.mutex.Unlock()
+	return int64(len(szEngine.records)), nil
+}
+
+func (szEngine *memorySzEngine) Destroy(ctx context.Context) error {
+	szEngine.factory.destroyed++
+	return nil
+}
+
+func (szEngine *memorySzEngine) ExportCsvEntityReportIterator(ctx context.Context, csvColumnList string, flags int64) chan senzing.StringFragment {
+	result := make(chan senzing.StringFragment, 1)
+	result <- senzing.StringFragment{Error: szerror.New(2, "0002E|Invalid message")}
+	close(result)
+	return result
+}
+
+func (szEngine *memorySzEngine) ExportJSONEntityReportIterator(ctx context.Context, flags int64) chan senzing.StringFragment {
+	szEngine.mutex.Lock()
+	values := []string{}
+	for _, value := range szEngine.records {
+		values = append(values, value)
+	}
+	szEngine.mutex.Unlock()
+	sort.Strings(values)
+	result := make(chan senzing.StringFragment)
+	go func() {
+		defer close(result)
+		for _, value := range values {
+			select {
+			case result <- senzing.StringFragment{Value: value}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return result
+}
+
+func (szEngine *memorySzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	szEngine.mutex.Lock()
+	defer szEngine.mutex.Unlock()
+	result, ok := szEngine.records[dataSourceCode+"/"+recordID]
+	if !ok {
+		return "", szerror.New(33, "0033E|Unknown record: dsrc["+dataSourceCode+"], record["+recordID+"]")
+	}
+	return result, nil
+}
+
+func (szEngine *memorySzEngine) GetStats(ctx context.Context) (string, error) {
+	return "{}", ctx.Err()
+}
+
+func (szEngine *memorySzEngine) PrimeEngine(ctx context.Context) error {
+	return nil
+}
+
+func (szProduct *memorySzProduct) Destroy(ctx context.Context) error {
+	szProduct.factory.destroyed++
+	return nil
+}
+
+func (szProduct *memorySzProduct) GetVersion(ctx context.Context) (string, error) {
+	return `{"VERSION":"4.0.0"}`, nil
+}
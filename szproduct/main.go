@@ -13,14 +13,8 @@ const Prefix = "szproduct."
 
 // Message templates for szproduct implementations.
 var IDMessages = map[int]string{
-	1:    "Enter " + Prefix + "ClearLastException().",
-	2:    "Exit  " + Prefix + "ClearLastException() returned (%v).",
 	3:    "Enter " + Prefix + "Destroy().",
 	4:    "Exit  " + Prefix + "Destroy() returned (%v).",
-	5:    "Enter " + Prefix + "GetLastException().",
-	6:    "Exit  " + Prefix + "GetLastException() returned (%s, %v).",
-	7:    "Enter " + Prefix + "GetLastExceptionCode().",
-	8:    "Exit  " + Prefix + "GetLastExceptionCode() returned (%d, %v).",
 	9:    "Enter " + Prefix + "GetLicense().",
 	10:   "Exit  " + Prefix + "GetLicense() returned (%s, %v).",
 	11:   "Enter " + Prefix + "GetVersion().",
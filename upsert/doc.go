@@ -0,0 +1,15 @@
+/*
+The upsert package adds records to Senzing only when their content has changed.
+
+An Upserter computes a Fingerprint of each record definition: a SHA-256 hash of the JSON with keys sorted
+and insignificant whitespace removed, excluding the top-level DATA_SOURCE and RECORD_ID, which are part of the record key.
+It compares the fingerprint with the one held for the record in a Store, or, without a Store,
+with the fingerprint of the record's JSON_DATA returned by GetRecord, and calls AddRecord only if they differ.
+
+MemoryStore holds fingerprints for the life of the process; BoltStore keeps them in a BoltDB file between loads.
+A Store must be cleared whenever the repository is purged, as it is trusted to reflect the records loaded.
+
+A FullLoad upserts every record of one data source and, when Upserter.DeleteMissing is set,
+deletes the records held in the Store that the load did not include.
+*/
+package upsert
@@ -0,0 +1,62 @@
+package upsert
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Fingerprint function returns the hex-encoded SHA-256 hash of the canonical form of a record definition.
+Definitions differing only in key order, whitespace, or the top-level DATA_SOURCE and RECORD_ID have the same fingerprint.
+Numbers are compared as written, so 1 and 1.0 differ.
+
+Input
+  - recordDefinition: The JSON object defining a record.
+*/
+func Fingerprint(recordDefinition string) (string, error) {
+	canonical, err := canonicalize([]byte(recordDefinition))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------------
+
+// Re-encode a JSON object with sorted keys and no whitespace, without its record key.
+func canonicalize(recordDefinition []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(recordDefinition))
+	decoder.UseNumber()
+	var record map[string]any
+	if err := decoder.Decode(&record); err != nil {
+		return nil, errors.Join(szerror.ErrSzBadInput, fmt.Errorf("record definition is not a JSON object: %w", err))
+	}
+	if record == nil {
+		return nil, errors.Join(szerror.ErrSzBadInput, errors.New("record definition is not a JSON object"))
+	}
+	if decoder.More() {
+		return nil, errors.Join(szerror.ErrSzBadInput, errors.New("record definition has data after the JSON object"))
+	}
+	delete(record, "DATA_SOURCE")
+	delete(record, "RECORD_ID")
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(record); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,89 @@
+package upsert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A FullLoad upserts every record of one data source, tracking the records seen.
+type FullLoad struct {
+	dataSourceCode string
+	mutex          sync.Mutex
+	seen           map[string]struct{}
+	upserter       *Upserter
+}
+
+// An Outcome is the result of upserting one record.
+type Outcome int
+
+// A RecordKey identifies a record.
+type RecordKey struct {
+	DataSourceCode string
+	RecordID       string
+}
+
+// Stats counts the outcomes of an Upserter.
+type Stats struct {
+	Added     uint64 `json:"added"`
+	Deleted   uint64 `json:"deleted"`
+	Unchanged uint64 `json:"unchanged"`
+	Updated   uint64 `json:"updated"`
+}
+
+// A Store holds the fingerprint of each record loaded. Implementations must be safe for concurrent use.
+type Store interface {
+	// Delete removes the fingerprint of a record. Deleting a record that is not held is not an error.
+	Delete(ctx context.Context, key RecordKey) error
+	// Get returns the fingerprint of a record, and false if none is held.
+	Get(ctx context.Context, key RecordKey) (string, bool, error)
+	// Put sets the fingerprint of a record.
+	Put(ctx context.Context, key RecordKey, fingerprint string) error
+	// RecordIDs returns the IDs of the records held for a data source.
+	RecordIDs(ctx context.Context, dataSourceCode string) ([]string, error)
+}
+
+// An Upserter adds records through an SzEngine only when their content has changed.
+// It is safe for concurrent use once its fields are set.
+type Upserter struct {
+	// DeleteMissing makes FullLoad.Finish delete the records not included in the load. It requires a Store.
+	DeleteMissing bool
+	Engine        senzing.SzEngine
+	// Flags passed to AddRecord and DeleteRecord.
+	Flags int64
+	// Store of fingerprints. If nil, fingerprints are computed from GetRecord.
+	Store Store
+
+	mutex sync.Mutex
+	stats Stats
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// BoltOpenTimeout is how long OpenBoltStore waits for another process to close the file.
+const BoltOpenTimeout = time.Second
+
+// Outcomes of upserting a record.
+const (
+	OutcomeUnchanged Outcome = iota
+	OutcomeAdded
+	OutcomeUpdated
+)
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+var outcomeNames = map[Outcome]string{
+	OutcomeAdded:     "added",
+	OutcomeUnchanged: "unchanged",
+	OutcomeUpdated:   "updated",
+}
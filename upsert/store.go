@@ -0,0 +1,165 @@
+package upsert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// A BoltStore holds fingerprints in a BoltDB file, with a bucket for each data source.
+// Concurrent calls to Put and Delete are committed together, with one sync to disk per batch,
+// so loads should upsert from several goroutines; a lone caller waits up to bolt.DefaultMaxBatchDelay per call.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// A MemoryStore holds fingerprints in memory. The zero value is ready to use.
+type MemoryStore struct {
+	fingerprints map[RecordKey]string
+	mutex        sync.RWMutex
+}
+
+// ----------------------------------------------------------------------------
+// Methods - BoltStore
+// ----------------------------------------------------------------------------
+
+/*
+The Close method closes the BoltDB file.
+*/
+func (store *BoltStore) Close() error {
+	return store.db.Close()
+}
+
+func (store *BoltStore) Delete(ctx context.Context, key RecordKey) error {
+	_ = ctx
+	return store.db.Batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(key.DataSourceCode))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete([]byte(key.RecordID))
+	})
+}
+
+func (store *BoltStore) Get(ctx context.Context, key RecordKey) (string, bool, error) {
+	_ = ctx
+	var result []byte
+	err := store.db.View(func(tx *bolt.Tx) error {
+		if bucket := tx.Bucket([]byte(key.DataSourceCode)); bucket != nil {
+			// The value is only valid for the life of the transaction.
+			if value := bucket.Get([]byte(key.RecordID)); value != nil {
+				result = append([]byte{}, value...)
+			}
+		}
+		return nil
+	})
+	return string(result), result != nil, err
+}
+
+// The Put method returns an szerror.ErrSzBadInput error if the DataSourceCode or RecordID of key is empty.
+func (store *BoltStore) Put(ctx context.Context, key RecordKey, fingerprint string) error {
+	_ = ctx
+	if len(key.DataSourceCode) == 0 || len(key.RecordID) == 0 {
+		return errors.Join(szerror.ErrSzBadInput, fmt.Errorf("record key %+v has an empty DataSourceCode or RecordID", key))
+	}
+	return store.db.Batch(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(key.DataSourceCode))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(key.RecordID), []byte(fingerprint))
+	})
+}
+
+func (store *BoltStore) RecordIDs(ctx context.Context, dataSourceCode string) ([]string, error) {
+	_ = ctx
+	var result []string
+	err := store.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(dataSourceCode))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(recordID []byte, _ []byte) error {
+			result = append(result, string(recordID))
+			return nil
+		})
+	})
+	return result, err
+}
+
+// ----------------------------------------------------------------------------
+// Methods - MemoryStore
+// ----------------------------------------------------------------------------
+
+func (store *MemoryStore) Delete(ctx context.Context, key RecordKey) error {
+	_ = ctx
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.fingerprints, key)
+	return nil
+}
+
+func (store *MemoryStore) Get(ctx context.Context, key RecordKey) (string, bool, error) {
+	_ = ctx
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	result, ok := store.fingerprints[key]
+	return result, ok, nil
+}
+
+func (store *MemoryStore) Put(ctx context.Context, key RecordKey, fingerprint string) error {
+	_ = ctx
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.fingerprints == nil {
+		store.fingerprints = map[RecordKey]string{}
+	}
+	store.fingerprints[key] = fingerprint
+	return nil
+}
+
+func (store *MemoryStore) RecordIDs(ctx context.Context, dataSourceCode string) ([]string, error) {
+	_ = ctx
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	var result []string
+	for key := range store.fingerprints {
+		if key.DataSourceCode == dataSourceCode {
+			result = append(result, key.RecordID)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The OpenBoltStore function opens a BoltDB file of fingerprints, creating it if needed.
+Only one process may have the file open at a time: if another has it open after BoltOpenTimeout,
+an szerror.ErrSzRetryable error is returned.
+
+Input
+  - path: The path of the file.
+*/
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: BoltOpenTimeout})
+	if errors.Is(err, bolt.ErrTimeout) {
+		return nil, errors.Join(szerror.ErrSzRetryable, fmt.Errorf("%s is open in another process: %w", path, err))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
@@ -0,0 +1,199 @@
+package upsert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - FullLoad
+// ----------------------------------------------------------------------------
+
+/*
+The Finish method ends the load. If Upserter.DeleteMissing is set, it deletes the records of the data source
+held in the Store that were not upserted during the load, and returns how many were deleted.
+
+Input
+  - ctx: A context to control lifecycle.
+*/
+func (load *FullLoad) Finish(ctx context.Context) (int, error) {
+	upserter := load.upserter
+	if !upserter.DeleteMissing {
+		return 0, nil
+	}
+	if upserter.Store == nil {
+		return 0, errors.New("deleting missing records requires a Store")
+	}
+	recordIDs, err := upserter.Store.RecordIDs(ctx, load.dataSourceCode)
+	if err != nil {
+		return 0, err
+	}
+	load.mutex.Lock()
+	var missing []string
+	for _, recordID := range recordIDs {
+		if _, ok := load.seen[recordID]; !ok {
+			missing = append(missing, recordID)
+		}
+	}
+	load.mutex.Unlock()
+	deleted := 0
+	for _, recordID := range missing {
+		if err := upserter.delete(ctx, RecordKey{DataSourceCode: load.dataSourceCode, RecordID: recordID}); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+/*
+The Upsert method upserts one record of the load's data source, as Upserter.Upsert does.
+
+Input
+  - ctx: A context to control lifecycle.
+  - recordID: The identifier of the record within the data source.
+  - recordDefinition: The JSON object defining the record.
+*/
+func (load *FullLoad) Upsert(ctx context.Context, recordID string, recordDefinition string) (Outcome, error) {
+	load.mutex.Lock()
+	load.seen[recordID] = struct{}{}
+	load.mutex.Unlock()
+	return load.upserter.Upsert(ctx, load.dataSourceCode, recordID, recordDefinition)
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Outcome
+// ----------------------------------------------------------------------------
+
+func (outcome Outcome) String() string {
+	if name, ok := outcomeNames[outcome]; ok {
+		return name
+	}
+	return fmt.Sprintf("Outcome(%d)", int(outcome))
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Upserter
+// ----------------------------------------------------------------------------
+
+/*
+The NewFullLoad method starts a load of every record of a data source.
+
+Input
+  - dataSourceCode: The data source being loaded.
+*/
+func (upserter *Upserter) NewFullLoad(dataSourceCode string) *FullLoad {
+	return &FullLoad{
+		dataSourceCode: dataSourceCode,
+		seen:           map[string]struct{}{},
+		upserter:       upserter,
+	}
+}
+
+/*
+The Stats method returns the counts of outcomes since the Upserter was first used.
+*/
+func (upserter *Upserter) Stats() Stats {
+	upserter.mutex.Lock()
+	defer upserter.mutex.Unlock()
+	return upserter.stats
+}
+
+/*
+The Upsert method calls AddRecord if the record is new or its fingerprint has changed.
+On error, the returned Outcome is OutcomeUnchanged.
+
+Input
+  - ctx: A context to control lifecycle.
+  - dataSourceCode: The data source of the record.
+  - recordID: The identifier of the record within the data source.
+  - recordDefinition: The JSON object defining the record.
+*/
+func (upserter *Upserter) Upsert(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string) (Outcome, error) {
+	fingerprint, err := Fingerprint(recordDefinition)
+	if err != nil {
+		return OutcomeUnchanged, err
+	}
+	key := RecordKey{DataSourceCode: dataSourceCode, RecordID: recordID}
+	previous, found, err := upserter.previous(ctx, key)
+	if err != nil {
+		return OutcomeUnchanged, err
+	}
+	outcome := OutcomeAdded
+	switch {
+	case found && previous == fingerprint:
+		upserter.count(OutcomeUnchanged)
+		return OutcomeUnchanged, nil
+	case found:
+		outcome = OutcomeUpdated
+	}
+	if _, err := upserter.Engine.AddRecord(ctx, dataSourceCode, recordID, recordDefinition, upserter.Flags); err != nil {
+		return OutcomeUnchanged, err
+	}
+	if upserter.Store != nil {
+		if err := upserter.Store.Put(ctx, key, fingerprint); err != nil {
+			return OutcomeUnchanged, err
+		}
+	}
+	upserter.count(outcome)
+	return outcome, nil
+}
+
+func (upserter *Upserter) count(outcome Outcome) {
+	upserter.mutex.Lock()
+	defer upserter.mutex.Unlock()
+	switch outcome {
+	case OutcomeAdded:
+		upserter.stats.Added++
+	case OutcomeUnchanged:
+		upserter.stats.Unchanged++
+	case OutcomeUpdated:
+		upserter.stats.Updated++
+	}
+}
+
+// Delete a record from the engine, where it may already be absent, and then from the Store.
+func (upserter *Upserter) delete(ctx context.Context, key RecordKey) error {
+	_, err := upserter.Engine.DeleteRecord(ctx, key.DataSourceCode, key.RecordID, upserter.Flags)
+	if err != nil && !errors.Is(err, szerror.ErrSzNotFound) {
+		return err
+	}
+	if err := upserter.Store.Delete(ctx, key); err != nil {
+		return err
+	}
+	upserter.mutex.Lock()
+	defer upserter.mutex.Unlock()
+	upserter.stats.Deleted++
+	return nil
+}
+
+// The fingerprint of the record as last loaded, from the Store or from GetRecord.
+func (upserter *Upserter) previous(ctx context.Context, key RecordKey) (string, bool, error) {
+	if upserter.Store != nil {
+		return upserter.Store.Get(ctx, key)
+	}
+	result, err := upserter.Engine.GetRecord(ctx, key.DataSourceCode, key.RecordID, senzing.SzEntityIncludeRecordJSONData)
+	if errors.Is(err, szerror.ErrSzNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	// JSON_DATA is kept raw, as decoding it to map[string]any would rewrite its numbers.
+	var record struct {
+		JSONData json.RawMessage `json:"JSON_DATA"`
+	}
+	if err := json.Unmarshal([]byte(result), &record); err != nil {
+		return "", false, err
+	}
+	fingerprint, err := Fingerprint(string(record.JSONData))
+	if err != nil {
+		return "", false, err
+	}
+	return fingerprint, true, nil
+}
@@ -0,0 +1,240 @@
+package upsert
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSzEngine struct {
+	senzing.SzEngine
+	adds    int
+	deletes int
+	records map[RecordKey]string
+}
+
+func (engine *mockSzEngine) AddRecord(ctx context.Context, dataSourceCode string, recordID string, recordDefinition string, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	engine.adds++
+	if engine.records == nil {
+		engine.records = map[RecordKey]string{}
+	}
+	engine.records[RecordKey{DataSourceCode: dataSourceCode, RecordID: recordID}] = recordDefinition
+	return "", nil
+}
+
+func (engine *mockSzEngine) DeleteRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	engine.deletes++
+	key := RecordKey{DataSourceCode: dataSourceCode, RecordID: recordID}
+	if _, ok := engine.records[key]; !ok {
+		return "", szerror.New(33, "SENZ0033E|Unknown record")
+	}
+	delete(engine.records, key)
+	return "", nil
+}
+
+func (engine *mockSzEngine) GetRecord(ctx context.Context, dataSourceCode string, recordID string, flags int64) (string, error) {
+	_ = ctx
+	_ = flags
+	recordDefinition, ok := engine.records[RecordKey{DataSourceCode: dataSourceCode, RecordID: recordID}]
+	if !ok {
+		return "", szerror.New(33, "SENZ0033E|Unknown record")
+	}
+	return fmt.Sprintf(`{"DATA_SOURCE":%q,"RECORD_ID":%q,"JSON_DATA":%s}`, dataSourceCode, recordID, recordDefinition), nil
+}
+
+// ----------------------------------------------------------------------------
+// Test harness
+// ----------------------------------------------------------------------------
+
+func newStores(test *testing.T) map[string]Store {
+	test.Helper()
+	boltStore, err := OpenBoltStore(filepath.Join(test.TempDir(), "fingerprints.db"))
+	require.NoError(test, err)
+	test.Cleanup(func() { require.NoError(test, boltStore.Close()) })
+	return map[string]Store{
+		"bolt":      boltStore,
+		"getrecord": nil,
+		"memory":    &MemoryStore{},
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestUpsert_Fingerprint(test *testing.T) {
+	reference, err := Fingerprint(`{"NAME_FULL":"Robert Smith","PHONE_NUMBER":"555-1212","ADDRESSES":[{"CITY":"Las Vegas"}],"AGE":42}`)
+	require.NoError(test, err)
+	assert.Len(test, reference, 64)
+
+	testCases := []struct {
+		name             string
+		recordDefinition string
+		same             bool
+	}{
+		{name: "key order", recordDefinition: `{"AGE":42,"PHONE_NUMBER":"555-1212","NAME_FULL":"Robert Smith","ADDRESSES":[{"CITY":"Las Vegas"}]}`, same: true},
+		{name: "whitespace", recordDefinition: "{\n  \"NAME_FULL\": \"Robert Smith\",\n  \"PHONE_NUMBER\": \"555-1212\",\n  \"ADDRESSES\": [ { \"CITY\": \"Las Vegas\" } ],\n  \"AGE\": 42\n}", same: true},
+		{name: "record key", recordDefinition: `{"DATA_SOURCE":"TEST","RECORD_ID":"1","NAME_FULL":"Robert Smith","PHONE_NUMBER":"555-1212","ADDRESSES":[{"CITY":"Las Vegas"}],"AGE":42}`, same: true},
+		{name: "value", recordDefinition: `{"NAME_FULL":"Bob Smith","PHONE_NUMBER":"555-1212","ADDRESSES":[{"CITY":"Las Vegas"}],"AGE":42}`},
+		{name: "number", recordDefinition: `{"NAME_FULL":"Robert Smith","PHONE_NUMBER":"555-1212","ADDRESSES":[{"CITY":"Las Vegas"}],"AGE":42.0}`},
+		{name: "nested key", recordDefinition: `{"NAME_FULL":"Robert Smith","PHONE_NUMBER":"555-1212","ADDRESSES":[{"CITY":"Las Vegas","RECORD_ID":"1"}],"AGE":42}`},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func(test *testing.T) {
+			fingerprint, err := Fingerprint(testCase.recordDefinition)
+			require.NoError(test, err)
+			assert.Equal(test, testCase.same, fingerprint == reference)
+		})
+	}
+
+	for _, invalid := range []string{``, `null`, `[1]`, `{"A":1}{}`, `{"A":`} {
+		_, err := Fingerprint(invalid)
+		require.ErrorIs(test, err, szerror.ErrSzBadInput, invalid)
+	}
+}
+
+func TestUpsert_Upsert(test *testing.T) {
+	ctx := context.TODO()
+	for name, store := range newStores(test) {
+		test.Run(name, func(test *testing.T) {
+			engine := &mockSzEngine{}
+			upserter := &Upserter{Engine: engine, Store: store}
+			steps := []struct {
+				recordDefinition string
+				outcome          Outcome
+			}{
+				{recordDefinition: `{"NAME_FULL":"Robert Smith"}`, outcome: OutcomeAdded},
+				{recordDefinition: `{ "NAME_FULL": "Robert Smith" }`, outcome: OutcomeUnchanged},
+				{recordDefinition: `{"NAME_FULL":"Bob Smith"}`, outcome: OutcomeUpdated},
+				{recordDefinition: `{"NAME_FULL":"Bob Smith"}`, outcome: OutcomeUnchanged},
+			}
+			for _, step := range steps {
+				outcome, err := upserter.Upsert(ctx, "TEST", "1", step.recordDefinition)
+				require.NoError(test, err)
+				assert.Equal(test, step.outcome, outcome, step.recordDefinition)
+			}
+			assert.Equal(test, 2, engine.adds)
+			assert.Equal(test, Stats{Added: 1, Unchanged: 2, Updated: 1}, upserter.Stats())
+
+			_, err := upserter.Upsert(ctx, "TEST", "1", `not json`)
+			require.ErrorIs(test, err, szerror.ErrSzBadInput)
+		})
+	}
+}
+
+func TestUpsert_FullLoad(test *testing.T) {
+	ctx := context.TODO()
+	for name, store := range newStores(test) {
+		if store == nil {
+			continue
+		}
+		test.Run(name, func(test *testing.T) {
+			engine := &mockSzEngine{}
+			upserter := &Upserter{DeleteMissing: true, Engine: engine, Store: store}
+			first := upserter.NewFullLoad("TEST")
+			for _, recordID := range []string{"1", "2", "3"} {
+				_, err := first.Upsert(ctx, recordID, `{"NAME_FULL":"Robert Smith"}`)
+				require.NoError(test, err)
+			}
+			_, err := upserter.Upsert(ctx, "OTHER", "9", `{"NAME_FULL":"Robert Smith"}`)
+			require.NoError(test, err)
+			deleted, err := first.Finish(ctx)
+			require.NoError(test, err)
+			assert.Zero(test, deleted)
+
+			delete(engine.records, RecordKey{DataSourceCode: "TEST", RecordID: "3"})
+			second := upserter.NewFullLoad("TEST")
+			_, err = second.Upsert(ctx, "1", `{"NAME_FULL":"Robert Smith"}`)
+			require.NoError(test, err)
+			deleted, err = second.Finish(ctx)
+			require.NoError(test, err)
+			assert.Equal(test, 2, deleted)
+			assert.Equal(test, map[RecordKey]string{
+				{DataSourceCode: "OTHER", RecordID: "9"}: `{"NAME_FULL":"Robert Smith"}`,
+				{DataSourceCode: "TEST", RecordID: "1"}:  `{"NAME_FULL":"Robert Smith"}`,
+			}, engine.records)
+
+			recordIDs, err := store.RecordIDs(ctx, "TEST")
+			require.NoError(test, err)
+			assert.Equal(test, []string{"1"}, recordIDs)
+			assert.Equal(test, Stats{Added: 4, Deleted: 2, Unchanged: 1}, upserter.Stats())
+		})
+	}
+}
+
+func TestUpsert_FullLoad_options(test *testing.T) {
+	ctx := context.TODO()
+	upserter := &Upserter{Engine: &mockSzEngine{}, Store: &MemoryStore{}}
+	deleted, err := upserter.NewFullLoad("TEST").Finish(ctx)
+	require.NoError(test, err)
+	assert.Zero(test, deleted)
+
+	upserter = &Upserter{DeleteMissing: true, Engine: &mockSzEngine{}}
+	_, err = upserter.NewFullLoad("TEST").Finish(ctx)
+	require.Error(test, err)
+}
+
+func TestUpsert_BoltStore_concurrent(test *testing.T) {
+	ctx := context.TODO()
+	store, err := OpenBoltStore(filepath.Join(test.TempDir(), "fingerprints.db"))
+	require.NoError(test, err)
+	defer func() { require.NoError(test, store.Close()) }()
+	var waitGroup sync.WaitGroup
+	for index := 0; index < 200; index++ {
+		waitGroup.Add(1)
+		go func(index int) {
+			defer waitGroup.Done()
+			key := RecordKey{DataSourceCode: "TEST", RecordID: strconv.Itoa(index)}
+			assert.NoError(test, store.Put(ctx, key, fmt.Sprintf("fingerprint-%d", index)))
+			if index%2 == 0 {
+				assert.NoError(test, store.Delete(ctx, key))
+			}
+		}(index)
+	}
+	waitGroup.Wait()
+	recordIDs, err := store.RecordIDs(ctx, "TEST")
+	require.NoError(test, err)
+	assert.Len(test, recordIDs, 100)
+	fingerprint, ok, err := store.Get(ctx, RecordKey{DataSourceCode: "TEST", RecordID: "7"})
+	require.NoError(test, err)
+	assert.True(test, ok)
+	assert.Equal(test, "fingerprint-7", fingerprint)
+}
+
+func TestUpsert_Outcome_String(test *testing.T) {
+	assert.Equal(test, "added", OutcomeAdded.String())
+	assert.Equal(test, "unchanged", OutcomeUnchanged.String())
+	assert.Equal(test, "updated", OutcomeUpdated.String())
+	assert.Equal(test, "Outcome(9)", Outcome(9).String())
+}
+
+func TestUpsert_BoltStore_locked(test *testing.T) {
+	path := filepath.Join(test.TempDir(), "fingerprints.db")
+	store, err := OpenBoltStore(path)
+	require.NoError(test, err)
+	defer func() { require.NoError(test, store.Close()) }()
+	_, err = OpenBoltStore(path)
+	require.ErrorIs(test, err, szerror.ErrSzRetryable)
+}
+
+func TestUpsert_BoltStore_emptyKey(test *testing.T) {
+	ctx := context.TODO()
+	store, err := OpenBoltStore(filepath.Join(test.TempDir(), "fingerprints.db"))
+	require.NoError(test, err)
+	defer func() { require.NoError(test, store.Close()) }()
+	for _, key := range []RecordKey{{DataSourceCode: "TEST"}, {RecordID: "1"}} {
+		require.ErrorIs(test, store.Put(ctx, key, "fingerprint"), szerror.ErrSzBadInput, "%+v", key)
+	}
+}
@@ -0,0 +1,4 @@
+/*
+The version package parses the result of SzProduct.GetVersion into comparable versions and checks compatibility.
+*/
+package version
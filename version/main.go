@@ -0,0 +1,59 @@
+package version
+
+import "sync"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// Feature names a capability that is only present in some Senzing builds.
+type Feature string
+
+// SemanticVersion is a Senzing version such as "4.0.0.24162", where the fourth component is the build.
+type SemanticVersion struct {
+	Build int64
+	Major int
+	Minor int
+	Patch int
+}
+
+// Version is the parsed result of SzProduct.GetVersion.
+type Version struct {
+	BuildDate                  string
+	BuildNumber                string
+	ConfigCompatibilityVersion string
+	MaximumSchemaVersion       SemanticVersion
+	MinimumSchemaVersion       SemanticVersion
+	ProductName                string
+	SchemaVersion              SemanticVersion
+	// Version is BUILD_VERSION when present, so that it includes the build; otherwise VERSION.
+	Version SemanticVersion
+}
+
+// ----------------------------------------------------------------------------
+// Constants
+// ----------------------------------------------------------------------------
+
+// Features with a known minimum version.
+const (
+	FeatureSearchProfile     Feature = "SearchProfile"
+	FeatureSzAPI             Feature = "SzAPI"
+	FeatureWhyRecordInEntity Feature = "WhyRecordInEntity"
+)
+
+// Senzing error code for a configuration compatibility mismatch.
+const errorCodeConfigCompatibilityMismatch = 40
+
+// ----------------------------------------------------------------------------
+// Variables
+// ----------------------------------------------------------------------------
+
+// Minimum Senzing version that provides each Feature. Extended with RegisterFeature().
+var features = map[Feature]string{
+	FeatureSearchProfile:     "3.5.0",
+	FeatureSzAPI:             "4.0.0",
+	FeatureWhyRecordInEntity: "4.0.0",
+}
+
+// Guards features.
+var featuresMutex sync.RWMutex
@@ -0,0 +1,276 @@
+package version
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/senzing-garage/sz-sdk-go/response"
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+)
+
+// ----------------------------------------------------------------------------
+// Methods - SemanticVersion
+// ----------------------------------------------------------------------------
+
+/*
+The Compare method returns -1, 0 or +1 as semanticVersion is less than, equal to, or greater than other.
+A zero Build in either version is ignored, so "4.0.0" equals "4.0.0.24162".
+
+Input
+  - other: The version to compare with.
+*/
+func (semanticVersion SemanticVersion) Compare(other SemanticVersion) int {
+	pairs := [][2]int64{
+		{int64(semanticVersion.Major), int64(other.Major)},
+		{int64(semanticVersion.Minor), int64(other.Minor)},
+		{int64(semanticVersion.Patch), int64(other.Patch)},
+	}
+	if semanticVersion.Build != 0 && other.Build != 0 {
+		pairs = append(pairs, [2]int64{semanticVersion.Build, other.Build})
+	}
+	for _, pair := range pairs {
+		switch {
+		case pair[0] < pair[1]:
+			return -1
+		case pair[0] > pair[1]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// The String method formats the version as "major.minor.patch", followed by ".build" if there is one.
+func (semanticVersion SemanticVersion) String() string {
+	result := fmt.Sprintf("%d.%d.%d", semanticVersion.Major, semanticVersion.Minor, semanticVersion.Patch)
+	if semanticVersion.Build != 0 {
+		result = fmt.Sprintf("%s.%d", result, semanticVersion.Build)
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Methods - Version
+// ----------------------------------------------------------------------------
+
+/*
+The CheckConfigCompatibility method verifies that a configuration was built for this engine.
+On mismatch, it returns the same SzConfiguration error the engine would report (code 40).
+
+Input
+  - configDefinition: A JSON configuration document, as given to SzConfigManager.AddConfig().
+*/
+func (version *Version) CheckConfigCompatibility(configDefinition string) error {
+	config := struct {
+		G2Config struct {
+			ConfigBaseVersion struct {
+				CompatibilityVersion struct {
+					ConfigVersion string `json:"CONFIG_VERSION"`
+				} `json:"COMPATIBILITY_VERSION"`
+			} `json:"CONFIG_BASE_VERSION"`
+		} `json:"G2_CONFIG"`
+	}{}
+	if err := json.Unmarshal([]byte(configDefinition), &config); err != nil {
+		return errors.Join(szerror.ErrSzConfiguration, fmt.Errorf("cannot parse configuration: %w", err))
+	}
+	configVersion := config.G2Config.ConfigBaseVersion.CompatibilityVersion.ConfigVersion
+	if configVersion != version.ConfigCompatibilityVersion {
+		return szerror.New(errorCodeConfigCompatibilityMismatch, fmt.Sprintf("%04dE|The engine configuration compatibility version [%s] does not match the version of the provided config[%s].", errorCodeConfigCompatibilityMismatch, version.ConfigCompatibilityVersion, configVersion))
+	}
+	return nil
+}
+
+/*
+The CheckSchemaVersion method verifies that a datastore schema version is within the range the engine supports.
+On mismatch, it returns an SzConfiguration error.
+
+Input
+  - schemaVersion: The datastore's schema version (e.g. "4.0").
+*/
+func (version *Version) CheckSchemaVersion(schemaVersion string) error {
+	parsed, err := ParseSemanticVersion(schemaVersion)
+	if err != nil {
+		return errors.Join(szerror.ErrSzConfiguration, err)
+	}
+	if parsed.Compare(version.MinimumSchemaVersion) < 0 || parsed.Compare(version.MaximumSchemaVersion) > 0 {
+		return errors.Join(szerror.ErrSzConfiguration, fmt.Errorf("schema version %s is outside the supported range %s to %s", parsed, version.MinimumSchemaVersion, version.MaximumSchemaVersion))
+	}
+	return nil
+}
+
+/*
+The RequireAtLeast method verifies that the engine is at least the given version.
+Otherwise, it returns an SzConfiguration error.
+
+Input
+  - minimum: The minimum version (e.g. "4.0.0" or "4.0.0.24162").
+*/
+func (version *Version) RequireAtLeast(minimum string) error {
+	parsed, err := ParseSemanticVersion(minimum)
+	if err != nil {
+		return errors.Join(szerror.ErrSzConfiguration, err)
+	}
+	if version.Version.Compare(parsed) < 0 {
+		return errors.Join(szerror.ErrSzConfiguration, fmt.Errorf("requires Senzing version %s; found %s", parsed, version.Version))
+	}
+	return nil
+}
+
+/*
+The RequireFeatures method verifies that the engine supports every feature.
+Otherwise, it returns an SzConfiguration error naming the unsupported features.
+
+Input
+  - features: The features required.
+*/
+func (version *Version) RequireFeatures(features ...Feature) error {
+	unsupported := []string{}
+	for _, feature := range features {
+		if !version.Supports(feature) {
+			unsupported = append(unsupported, string(feature))
+		}
+	}
+	if len(unsupported) > 0 {
+		return errors.Join(szerror.ErrSzConfiguration, fmt.Errorf("unsupported by Senzing version %s: %s", version.Version, strings.Join(unsupported, ", ")))
+	}
+	return nil
+}
+
+/*
+The Supports method reports whether the engine provides a feature.
+Features that have not been registered are not supported.
+
+Input
+  - feature: The feature to look up.
+*/
+func (version *Version) Supports(feature Feature) bool {
+	minimum, ok := MinimumVersion(feature)
+	if !ok {
+		return false
+	}
+	return version.RequireAtLeast(minimum) == nil
+}
+
+// ----------------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------------
+
+/*
+The Get function calls SzProduct.GetVersion and parses the result.
+
+Input
+  - ctx: A context to control lifecycle.
+  - szProduct: An initialized SzProduct.
+*/
+func Get(ctx context.Context, szProduct senzing.SzProduct) (*Version, error) {
+	versionJSON, err := szProduct.GetVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(ctx, versionJSON)
+}
+
+/*
+The Parse function parses the JSON returned by SzProduct.GetVersion.
+
+Input
+  - ctx: A context to control lifecycle.
+  - jsonString: The JSON returned by SzProduct.GetVersion().
+*/
+func Parse(ctx context.Context, jsonString string) (*Version, error) {
+	productVersion, err := response.SzProductGetVersion(ctx, jsonString)
+	if err != nil {
+		return nil, err
+	}
+	result := &Version{
+		BuildDate:                  productVersion.BuildDate,
+		BuildNumber:                productVersion.BuildNumber,
+		ConfigCompatibilityVersion: productVersion.CompatibilityVersion.ConfigVersion,
+		ProductName:                productVersion.ProductName,
+	}
+	versionString := productVersion.BuildVersion
+	if len(versionString) == 0 {
+		versionString = productVersion.Version
+	}
+	if result.Version, err = ParseSemanticVersion(versionString); err != nil {
+		return nil, err
+	}
+	schemaVersions := []struct {
+		target *SemanticVersion
+		value  string
+	}{
+		{&result.SchemaVersion, productVersion.SchemaVersion.EngineSchemaVersion},
+		{&result.MinimumSchemaVersion, productVersion.SchemaVersion.MinimumRequiredSchemaVersion},
+		{&result.MaximumSchemaVersion, productVersion.SchemaVersion.MaximumRequiredSchemaVersion},
+	}
+	for _, schemaVersion := range schemaVersions {
+		if len(schemaVersion.value) == 0 {
+			continue
+		}
+		if *schemaVersion.target, err = ParseSemanticVersion(schemaVersion.value); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+/*
+The MinimumVersion function returns the minimum Senzing version that provides a feature.
+
+Input
+  - feature: The name of the feature.
+*/
+func MinimumVersion(feature Feature) (string, bool) {
+	featuresMutex.RLock()
+	defer featuresMutex.RUnlock()
+	result, ok := features[feature]
+	return result, ok
+}
+
+/*
+The ParseSemanticVersion function parses a dotted version of one to four numeric components.
+
+Input
+  - versionString: A version such as "4", "4.0", "4.0.0" or "4.0.0.24162".
+*/
+func ParseSemanticVersion(versionString string) (SemanticVersion, error) {
+	result := SemanticVersion{}
+	parts := strings.Split(strings.TrimSpace(versionString), ".")
+	if len(parts) > 4 {
+		return result, fmt.Errorf("invalid version %q", versionString)
+	}
+	values := make([]int64, 4)
+	for index, part := range parts {
+		value, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || value < 0 {
+			return result, fmt.Errorf("invalid version %q", versionString)
+		}
+		values[index] = value
+	}
+	result.Major = int(values[0])
+	result.Minor = int(values[1])
+	result.Patch = int(values[2])
+	result.Build = values[3]
+	return result, nil
+}
+
+/*
+The RegisterFeature function records the minimum Senzing version that provides a feature.
+
+Input
+  - feature: The name of the feature.
+  - minimum: The minimum version (e.g. "4.0.0").
+*/
+func RegisterFeature(feature Feature, minimum string) error {
+	if _, err := ParseSemanticVersion(minimum); err != nil {
+		return err
+	}
+	featuresMutex.Lock()
+	defer featuresMutex.Unlock()
+	features[feature] = minimum
+	return nil
+}
@@ -0,0 +1,156 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/senzing-garage/sz-sdk-go/senzing"
+	"github.com/senzing-garage/sz-sdk-go/szerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const productVersion = `{"PRODUCT_NAME":"Senzing API","VERSION":"4.0.0","BUILD_VERSION":"4.0.0.24162","BUILD_DATE":"2024-06-10","BUILD_NUMBER":"2024_06_10__14_29","COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"},"SCHEMA_VERSION":{"ENGINE_SCHEMA_VERSION":"4.0","MINIMUM_REQUIRED_SCHEMA_VERSION":"4.0","MAXIMUM_REQUIRED_SCHEMA_VERSION":"4.99"}}`
+
+type mockSzProduct struct {
+	senzing.SzProduct
+	err error
+}
+
+func (product *mockSzProduct) GetVersion(ctx context.Context) (string, error) {
+	_ = ctx
+	return productVersion, product.err
+}
+
+func testVersion(test *testing.T) *Version {
+	result, err := Parse(context.TODO(), productVersion)
+	require.NoError(test, err)
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Test interface functions
+// ----------------------------------------------------------------------------
+
+func TestVersion_ParseSemanticVersion(test *testing.T) {
+	testCases := []struct {
+		input    string
+		expected SemanticVersion
+		isError  bool
+	}{
+		{input: "4", expected: SemanticVersion{Major: 4}},
+		{input: "4.0", expected: SemanticVersion{Major: 4}},
+		{input: "4.99", expected: SemanticVersion{Major: 4, Minor: 99}},
+		{input: "3.12.8", expected: SemanticVersion{Major: 3, Minor: 12, Patch: 8}},
+		{input: "4.0.0.24162", expected: SemanticVersion{Major: 4, Build: 24162}},
+		{input: "", isError: true},
+		{input: "4.x", isError: true},
+		{input: "1.2.3.4.5", isError: true},
+		{input: "-1", isError: true},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.input, func(test *testing.T) {
+			actual, err := ParseSemanticVersion(testCase.input)
+			if testCase.isError {
+				require.Error(test, err)
+				return
+			}
+			require.NoError(test, err)
+			assert.Equal(test, testCase.expected, actual)
+		})
+	}
+}
+
+func TestVersion_SemanticVersion_Compare(test *testing.T) {
+	testCases := []struct {
+		version1 string
+		version2 string
+		expected int
+	}{
+		{"4.0.0", "4.0.0", 0},
+		{"4.0.0", "4.0.0.24162", 0},
+		{"4.0.0.24162", "4.0.0.24163", -1},
+		{"3.12.8", "4.0.0", -1},
+		{"4.1.0", "4.0.9", 1},
+		{"4.0.10", "4.0.9", 1},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.version1+"-"+testCase.version2, func(test *testing.T) {
+			version1, err := ParseSemanticVersion(testCase.version1)
+			require.NoError(test, err)
+			version2, err := ParseSemanticVersion(testCase.version2)
+			require.NoError(test, err)
+			assert.Equal(test, testCase.expected, version1.Compare(version2))
+			assert.Equal(test, -testCase.expected, version2.Compare(version1))
+		})
+	}
+}
+
+func TestVersion_Parse(test *testing.T) {
+	actual := testVersion(test)
+	assert.Equal(test, "4.0.0.24162", actual.Version.String())
+	assert.Equal(test, "11", actual.ConfigCompatibilityVersion)
+	assert.Equal(test, "2024_06_10__14_29", actual.BuildNumber)
+	assert.Equal(test, SemanticVersion{Major: 4, Minor: 99}, actual.MaximumSchemaVersion)
+
+	_, err := Parse(context.TODO(), `{"VERSION":"four"}`)
+	require.Error(test, err)
+}
+
+func TestVersion_Get(test *testing.T) {
+	ctx := context.TODO()
+	actual, err := Get(ctx, &mockSzProduct{})
+	require.NoError(test, err)
+	assert.Equal(test, "Senzing API", actual.ProductName)
+
+	_, err = Get(ctx, &mockSzProduct{err: errors.New("not initialized")})
+	require.Error(test, err)
+}
+
+func TestVersion_RequireAtLeast(test *testing.T) {
+	version := testVersion(test)
+	require.NoError(test, version.RequireAtLeast("3.12.8"))
+	require.NoError(test, version.RequireAtLeast("4.0.0.24162"))
+	err := version.RequireAtLeast("4.0.1")
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	require.ErrorIs(test, version.RequireAtLeast("bad"), szerror.ErrSzConfiguration)
+}
+
+func TestVersion_Supports(test *testing.T) {
+	version := testVersion(test)
+	assert.True(test, version.Supports(FeatureSzAPI))
+	assert.False(test, version.Supports(Feature("Unregistered")))
+
+	require.NoError(test, RegisterFeature("FutureFeature", "4.2.0"))
+	assert.False(test, version.Supports("FutureFeature"))
+	minimum, ok := MinimumVersion("FutureFeature")
+	assert.True(test, ok)
+	assert.Equal(test, "4.2.0", minimum)
+	require.Error(test, RegisterFeature("BadFeature", "x"))
+
+	require.NoError(test, version.RequireFeatures(FeatureSzAPI, FeatureWhyRecordInEntity))
+	err := version.RequireFeatures(FeatureSzAPI, "FutureFeature")
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	assert.Contains(test, err.Error(), "FutureFeature")
+}
+
+func TestVersion_CheckConfigCompatibility(test *testing.T) {
+	version := testVersion(test)
+	require.NoError(test, version.CheckConfigCompatibility(`{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"11"}}}}`))
+
+	err := version.CheckConfigCompatibility(`{"G2_CONFIG":{"CONFIG_BASE_VERSION":{"COMPATIBILITY_VERSION":{"CONFIG_VERSION":"10"}}}}`)
+	require.ErrorIs(test, err, szerror.ErrSzConfiguration)
+	assert.Equal(test, 40, szerror.Code(err.Error()))
+
+	require.ErrorIs(test, version.CheckConfigCompatibility("{"), szerror.ErrSzConfiguration)
+}
+
+func TestVersion_CheckSchemaVersion(test *testing.T) {
+	version := testVersion(test)
+	require.NoError(test, version.CheckSchemaVersion("4.0"))
+	require.NoError(test, version.CheckSchemaVersion("4.5"))
+	require.ErrorIs(test, version.CheckSchemaVersion("3.0"), szerror.ErrSzConfiguration)
+	require.ErrorIs(test, version.CheckSchemaVersion("5.0"), szerror.ErrSzConfiguration)
+	require.ErrorIs(test, version.CheckSchemaVersion(""), szerror.ErrSzConfiguration)
+}